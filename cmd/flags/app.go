@@ -11,13 +11,20 @@ func NewAppConfig(vipr *viper.Viper) (*app.Config, error) {
 		return nil, err
 	}
 
+	web3SignerCfg, err := NewWeb3SignerConfig(vipr)
+	if err != nil {
+		return nil, err
+	}
+
 	return &app.Config{
-		Logger:   NewLoggerConfig(vipr),
-		HTTP:     httpCfg,
-		Manifest: NewManifestConfig(vipr),
-		OIDC:     NewOIDCConfig(vipr),
-		APIKey:   NewAPIKeyConfig(vipr),
-		TLS:      NewTLSConfig(vipr),
-		Postgres: NewPostgresConfig(vipr),
+		Logger:     NewLoggerConfig(vipr),
+		HTTP:       httpCfg,
+		Manifest:   NewManifestConfig(vipr),
+		OIDC:       NewOIDCConfig(vipr),
+		APIKey:     NewAPIKeyConfig(vipr),
+		TLS:        NewTLSConfig(vipr),
+		Postgres:   NewPostgresConfig(vipr),
+		Web3Signer: web3SignerCfg,
+		TxTracker:  NewTxTrackerConfig(vipr),
 	}, nil
 }
@@ -0,0 +1,86 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	nodesapp "github.com/consensys/quorum-key-manager/src/nodes/app"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(txTrackerIntervalViperKey, txTrackerIntervalDefault)
+	_ = viper.BindEnv(txTrackerIntervalViperKey, txTrackerIntervalEnv)
+	viper.SetDefault(txTrackerMaxAgeViperKey, txTrackerMaxAgeDefault)
+	_ = viper.BindEnv(txTrackerMaxAgeViperKey, txTrackerMaxAgeEnv)
+	viper.SetDefault(txTrackerBatchSizeViperKey, txTrackerBatchSizeDefault)
+	_ = viper.BindEnv(txTrackerBatchSizeViperKey, txTrackerBatchSizeEnv)
+}
+
+const (
+	txTrackerIntervalFlag     = "tx-tracker-interval"
+	txTrackerIntervalViperKey = "tx.tracker.interval"
+	txTrackerIntervalDefault  = 5 * time.Second
+	txTrackerIntervalEnv      = "TX_TRACKER_INTERVAL"
+)
+
+const (
+	txTrackerMaxAgeFlag     = "tx-tracker-max-age"
+	txTrackerMaxAgeViperKey = "tx.tracker.max.age"
+	txTrackerMaxAgeDefault  = 3 * time.Hour
+	txTrackerMaxAgeEnv      = "TX_TRACKER_MAX_AGE"
+)
+
+const (
+	txTrackerBatchSizeFlag     = "tx-tracker-batch-size"
+	txTrackerBatchSizeViperKey = "tx.tracker.batch.size"
+	txTrackerBatchSizeDefault  = uint64(100)
+	txTrackerBatchSizeEnv      = "TX_TRACKER_BATCH_SIZE"
+)
+
+// NodesFlags register flags for the nodes
+func NodesFlags(f *pflag.FlagSet) {
+	txTrackerInterval(f)
+	txTrackerMaxAge(f)
+	txTrackerBatchSize(f)
+}
+
+func txTrackerInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which the receipts of the transactions sent through the nodes are polled. Tracking is disabled if set to 0.
+Environment variable: %q`, txTrackerIntervalEnv)
+	f.Duration(txTrackerIntervalFlag, txTrackerIntervalDefault, desc)
+	_ = viper.BindPFlag(txTrackerIntervalViperKey, f.Lookup(txTrackerIntervalFlag))
+}
+
+func txTrackerMaxAge(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Age after which the transactions sent through the nodes without receipt, evicted from the mempool, are dropped and no longer tracked.
+Environment variable: %q`, txTrackerMaxAgeEnv)
+	f.Duration(txTrackerMaxAgeFlag, txTrackerMaxAgeDefault, desc)
+	_ = viper.BindPFlag(txTrackerMaxAgeViperKey, f.Lookup(txTrackerMaxAgeFlag))
+}
+
+func txTrackerBatchSize(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of tracked transactions loaded at once when polling their receipts.
+Environment variable: %q`, txTrackerBatchSizeEnv)
+	f.Uint64(txTrackerBatchSizeFlag, txTrackerBatchSizeDefault, desc)
+	_ = viper.BindPFlag(txTrackerBatchSizeViperKey, f.Lookup(txTrackerBatchSizeFlag))
+}
+
+func NewTxTrackerConfig(vipr *viper.Viper) *nodesapp.TxTrackerConfig {
+	interval := vipr.GetDuration(txTrackerIntervalViperKey)
+	batchSize := vipr.GetUint64(txTrackerBatchSizeViperKey)
+	if batchSize == 0 {
+		batchSize = txTrackerBatchSizeDefault
+	}
+
+	if interval > 0 {
+		return nodesapp.NewTxTrackerConfig(
+			interval,
+			vipr.GetDuration(txTrackerMaxAgeViperKey),
+			batchSize,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/eth2"
+	storesapp "github.com/consensys/quorum-key-manager/src/stores/app"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	_ = viper.BindEnv(web3SignerEth1StoreViperKey, web3SignerEth1StoreEnv)
+	_ = viper.BindEnv(web3SignerEth2StoreViperKey, web3SignerEth2StoreEnv)
+	_ = viper.BindEnv(web3SignerGenesisForkVersionViperKey, web3SignerGenesisForkVersionEnv)
+}
+
+const (
+	web3SignerEth1StoreFlag     = "web3signer-eth1-store"
+	web3SignerEth1StoreViperKey = "web3signer.eth1.store"
+	web3SignerEth1StoreDefault  = ""
+	web3SignerEth1StoreEnv      = "WEB3SIGNER_ETH1_STORE"
+)
+
+const (
+	web3SignerEth2StoreFlag     = "web3signer-eth2-store"
+	web3SignerEth2StoreViperKey = "web3signer.eth2.store"
+	web3SignerEth2StoreDefault  = ""
+	web3SignerEth2StoreEnv      = "WEB3SIGNER_ETH2_STORE"
+)
+
+const (
+	web3SignerGenesisForkVersionFlag     = "web3signer-genesis-fork-version"
+	web3SignerGenesisForkVersionViperKey = "web3signer.genesis.fork.version"
+	web3SignerGenesisForkVersionDefault  = "0x00000000"
+	web3SignerGenesisForkVersionEnv      = "WEB3SIGNER_GENESIS_FORK_VERSION"
+)
+
+func Web3SignerFlags(f *pflag.FlagSet) {
+	web3SignerEth1Store(f)
+	web3SignerEth2Store(f)
+	web3SignerGenesisForkVersion(f)
+}
+
+func web3SignerEth1Store(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Ethereum store exposed through the Web3Signer eth1 API. The Web3Signer API is disabled if neither eth1 nor eth2 store is set.
+Environment variable: %q`, web3SignerEth1StoreEnv)
+	f.String(web3SignerEth1StoreFlag, web3SignerEth1StoreDefault, desc)
+	_ = viper.BindPFlag(web3SignerEth1StoreViperKey, f.Lookup(web3SignerEth1StoreFlag))
+}
+
+func web3SignerEth2Store(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Key store holding the BLS12-381 validator keys exposed through the Web3Signer eth2 API. The Web3Signer API is disabled if neither eth1 nor eth2 store is set.
+Environment variable: %q`, web3SignerEth2StoreEnv)
+	f.String(web3SignerEth2StoreFlag, web3SignerEth2StoreDefault, desc)
+	_ = viper.BindPFlag(web3SignerEth2StoreViperKey, f.Lookup(web3SignerEth2StoreFlag))
+}
+
+func web3SignerGenesisForkVersion(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Genesis fork version of the network of the eth2 validators, used to sign builder registrations (default mainnet).
+Environment variable: %q`, web3SignerGenesisForkVersionEnv)
+	f.String(web3SignerGenesisForkVersionFlag, web3SignerGenesisForkVersionDefault, desc)
+	_ = viper.BindPFlag(web3SignerGenesisForkVersionViperKey, f.Lookup(web3SignerGenesisForkVersionFlag))
+}
+
+func NewWeb3SignerConfig(vipr *viper.Viper) (*storesapp.Web3SignerConfig, error) {
+	eth1Store := vipr.GetString(web3SignerEth1StoreViperKey)
+	eth2Store := vipr.GetString(web3SignerEth2StoreViperKey)
+
+	if eth1Store == "" && eth2Store == "" {
+		return nil, nil
+	}
+
+	genesisForkVersion, err := hexutil.Decode(vipr.GetString(web3SignerGenesisForkVersionViperKey))
+	if err != nil || len(genesisForkVersion) != len(eth2.Version{}) {
+		return nil, fmt.Errorf("invalid web3signer genesis fork version, expected 4 bytes in hex")
+	}
+
+	var version eth2.Version
+	copy(version[:], genesisForkVersion)
+	return storesapp.NewWeb3SignerConfig(eth1Store, eth2Store, version), nil
+}
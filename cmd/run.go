@@ -34,6 +34,8 @@ func newRunCommand() *cobra.Command {
 	flags.OIDCFlags(runCmd.Flags())
 	flags.APIKeyFlags(runCmd.Flags())
 	flags.TLSFlags(runCmd.Flags())
+	flags.Web3SignerFlags(runCmd.Flags())
+	flags.NodesFlags(runCmd.Flags())
 
 	return runCmd
 }
@@ -60,7 +60,8 @@ func newSyncCommand() *cobra.Command {
 			}
 
 			// Instantiate register stores
-			storesService = stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, logger)
+			// Accounts are only synced, not used for signing, so that no signing policy is needed
+			storesService = stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, nil, logger)
 			if err := manifeststores.NewStoresHandler(storesService).Register(ctx, mnfs[entities.StoreKind]); err != nil {
 				return err
 			}
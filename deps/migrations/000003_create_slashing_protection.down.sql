@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS signed_blocks;
+DROP TABLE IF EXISTS signed_attestations;
+DROP TABLE IF EXISTS slashing_protection_metadata;
+
+COMMIT;
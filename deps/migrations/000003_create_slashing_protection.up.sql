@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS signed_blocks (
+    pk SERIAL PRIMARY KEY,
+    store_id TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    slot BIGINT NOT NULL,
+    signing_root BYTEA,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    UNIQUE(store_id, public_key, slot)
+);
+
+CREATE TABLE IF NOT EXISTS signed_attestations (
+    pk SERIAL PRIMARY KEY,
+    store_id TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    source_epoch BIGINT NOT NULL,
+    target_epoch BIGINT NOT NULL,
+    signing_root BYTEA,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    UNIQUE(store_id, public_key, target_epoch)
+);
+
+CREATE TABLE IF NOT EXISTS slashing_protection_metadata (
+    store_id TEXT PRIMARY KEY,
+    genesis_validators_root BYTEA NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+COMMIT;
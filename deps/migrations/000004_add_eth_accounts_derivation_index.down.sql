@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE eth_accounts DROP COLUMN IF EXISTS derivation_index;
+
+COMMIT;
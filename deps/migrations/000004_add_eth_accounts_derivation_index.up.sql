@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE eth_accounts ADD COLUMN IF NOT EXISTS derivation_index BIGINT;
+
+COMMIT;
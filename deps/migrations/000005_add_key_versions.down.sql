@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS key_versions;
+
+ALTER TABLE keys DROP COLUMN IF EXISTS version;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE keys ADD COLUMN IF NOT EXISTS version TEXT;
+
+CREATE TABLE IF NOT EXISTS key_versions (
+    pk SERIAL PRIMARY KEY,
+    id TEXT NOT NULL,
+    version TEXT NOT NULL,
+    store_id TEXT NOT NULL,
+    public_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    UNIQUE(id, version, store_id)
+);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE keys DROP COLUMN IF EXISTS expire_at;
+ALTER TABLE eth_accounts DROP COLUMN IF EXISTS expire_at;
+ALTER TABLE secrets DROP COLUMN IF EXISTS expire_at;
+
+COMMIT;
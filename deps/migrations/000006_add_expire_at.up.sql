@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE keys ADD COLUMN IF NOT EXISTS expire_at TIMESTAMPTZ;
+ALTER TABLE eth_accounts ADD COLUMN IF NOT EXISTS expire_at TIMESTAMPTZ;
+ALTER TABLE secrets ADD COLUMN IF NOT EXISTS expire_at TIMESTAMPTZ;
+
+COMMIT;
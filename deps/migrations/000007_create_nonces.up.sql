@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS nonces (
+    chain_id TEXT NOT NULL,
+    address TEXT NOT NULL,
+    value BIGINT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    PRIMARY KEY (chain_id, address)
+);
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS transactions (
+    hash TEXT PRIMARY KEY,
+    node_name TEXT NOT NULL,
+    chain_id TEXT NOT NULL,
+    from_address TEXT NOT NULL,
+    nonce BIGINT NOT NULL,
+    raw BYTEA NOT NULL,
+    type TEXT NOT NULL,
+    gas_price TEXT,
+    gas_fee_cap TEXT,
+    gas_tip_cap TEXT,
+    status TEXT NOT NULL,
+    replaced_by TEXT,
+    block_number BIGINT,
+    tenant TEXT,
+    username TEXT,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS transactions_status_idx ON transactions (status);
+CREATE INDEX IF NOT EXISTS transactions_account_nonce_idx ON transactions (chain_id, from_address, nonce);
+
+COMMIT;
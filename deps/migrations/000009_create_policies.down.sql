@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS spent_nonces;
+DROP TABLE IF EXISTS daily_spendings;
+DROP TABLE IF EXISTS policies;
+
+COMMIT;
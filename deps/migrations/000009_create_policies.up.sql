@@ -0,0 +1,31 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS policies (
+    name TEXT PRIMARY KEY,
+    stores TEXT[],
+    accounts TEXT[],
+    rules JSONB NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS daily_spendings (
+    address TEXT NOT NULL,
+    day DATE NOT NULL,
+    value NUMERIC(78, 0) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    PRIMARY KEY (address, day)
+);
+
+CREATE TABLE IF NOT EXISTS spent_nonces (
+    address TEXT NOT NULL,
+    chain_id TEXT NOT NULL,
+    nonce BIGINT NOT NULL,
+    value NUMERIC(78, 0) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    PRIMARY KEY (address, chain_id, nonce)
+);
+
+COMMIT;
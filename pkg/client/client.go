@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	aliastypes "github.com/consensys/quorum-key-manager/src/aliases/api/types"
@@ -17,6 +18,8 @@ type SecretsClient interface {
 	GetDeletedSecret(ctx context.Context, storeName, id string) (*storestypes.SecretResponse, error)
 	DeleteSecret(ctx context.Context, storeName, id string) error
 	RestoreSecret(ctx context.Context, storeName, id string) error
+	EnableSecret(ctx context.Context, storeName, id string) error
+	DisableSecret(ctx context.Context, storeName, id string) error
 	DestroySecret(ctx context.Context, storeName, id string) error
 	ListSecrets(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	ListDeletedSecrets(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
@@ -26,12 +29,18 @@ type KeysClient interface {
 	CreateKey(ctx context.Context, storeName, id string, request *storestypes.CreateKeyRequest) (*storestypes.KeyResponse, error)
 	ImportKey(ctx context.Context, storeName, id string, request *storestypes.ImportKeyRequest) (*storestypes.KeyResponse, error)
 	SignKey(ctx context.Context, storeName, id string, request *storestypes.SignBase64PayloadRequest) (string, error)
+	EncryptKey(ctx context.Context, storeName, id string, request *storestypes.EncryptBase64PayloadRequest) (string, error)
+	DecryptKey(ctx context.Context, storeName, id string, request *storestypes.DecryptBase64PayloadRequest) (string, error)
 	GetKey(ctx context.Context, storeName, id string) (*storestypes.KeyResponse, error)
+	GetKeyVersion(ctx context.Context, storeName, id, version string) (*storestypes.KeyResponse, error)
+	RotateKey(ctx context.Context, storeName, id string) (*storestypes.KeyResponse, error)
 	ListKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	DeleteKey(ctx context.Context, storeName, id string) error
 	GetDeletedKey(ctx context.Context, storeName, id string) (*storestypes.KeyResponse, error)
 	ListDeletedKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	RestoreKey(ctx context.Context, storeName, id string) error
+	EnableKey(ctx context.Context, storeName, id string) error
+	DisableKey(ctx context.Context, storeName, id string) error
 	DestroyKey(ctx context.Context, storeName, id string) error
 }
 
@@ -44,12 +53,17 @@ type EthClient interface {
 	SignTransaction(ctx context.Context, storeName, address string, request *storestypes.SignETHTransactionRequest) (string, error)
 	SignQuorumPrivateTransaction(ctx context.Context, storeName, address string, request *storestypes.SignQuorumPrivateTransactionRequest) (string, error)
 	SignEEATransaction(ctx context.Context, storeName, address string, request *storestypes.SignEEATransactionRequest) (string, error)
+	EncryptEth(ctx context.Context, storeName, address string, request *storestypes.EncryptRequest) (string, error)
+	DecryptEth(ctx context.Context, storeName, address string, request *storestypes.DecryptRequest) (string, error)
+	ExportEthAccount(ctx context.Context, storeName, address string, request *storestypes.ExportEthAccountRequest) (json.RawMessage, error)
 	GetEthAccount(ctx context.Context, storeName, address string) (*storestypes.EthAccountResponse, error)
 	ListEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	ListDeletedEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	DeleteEthAccount(ctx context.Context, storeName, address string) error
 	DestroyEthAccount(ctx context.Context, storeName, address string) error
 	RestoreEthAccount(ctx context.Context, storeName, address string) error
+	EnableEthAccount(ctx context.Context, storeName, address string) error
+	DisableEthAccount(ctx context.Context, storeName, address string) error
 }
 
 type UtilsClient interface {
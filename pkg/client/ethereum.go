@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
@@ -71,6 +72,45 @@ func (c *HTTPClient) SignMessage(ctx context.Context, storeName, address string,
 	return parseStringResponse(response)
 }
 
+func (c *HTTPClient) EncryptEth(ctx context.Context, storeName, address string, req *types.EncryptRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/encrypt", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
+func (c *HTTPClient) DecryptEth(ctx context.Context, storeName, address string, req *types.DecryptRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/decrypt", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
+func (c *HTTPClient) ExportEthAccount(ctx context.Context, storeName, address string, req *types.ExportEthAccountRequest) (json.RawMessage, error) {
+	var keyJSON json.RawMessage
+	reqURL := fmt.Sprintf("%s/%s/%s/export", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, &keyJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyJSON, nil
+}
+
 func (c *HTTPClient) SignTypedData(ctx context.Context, storeName, address string, req *types.SignTypedDataRequest) (string, error) {
 	reqURL := fmt.Sprintf("%s/%s/%s/sign-typed-data", withURLStore(c.config.URL, storeName), ethPath, address)
 	response, err := postRequest(ctx, c.client, reqURL, req)
@@ -173,3 +213,25 @@ func (c *HTTPClient) RestoreEthAccount(ctx context.Context, storeName, address s
 	defer closeResponse(response)
 	return parseEmptyBodyResponse(response)
 }
+
+func (c *HTTPClient) EnableEthAccount(ctx context.Context, storeName, address string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/enable", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := putRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	return parseEmptyBodyResponse(response)
+}
+
+func (c *HTTPClient) DisableEthAccount(ctx context.Context, storeName, address string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/disable", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := putRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	return parseEmptyBodyResponse(response)
+}
@@ -54,6 +54,28 @@ func (c *HTTPClient) SignKey(ctx context.Context, storeName, id string, req *typ
 	return parseStringResponse(response)
 }
 
+func (c *HTTPClient) EncryptKey(ctx context.Context, storeName, id string, req *types.EncryptBase64PayloadRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/encrypt", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
+func (c *HTTPClient) DecryptKey(ctx context.Context, storeName, id string, req *types.DecryptBase64PayloadRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/decrypt", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
 func (c *HTTPClient) GetKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
 	key := &types.KeyResponse{}
 	reqURL := fmt.Sprintf("%s/%s/%s", withURLStore(c.config.URL, storeName), keysPath, id)
@@ -72,6 +94,41 @@ func (c *HTTPClient) GetKey(ctx context.Context, storeName, id string) (*types.K
 	return key, nil
 }
 
+func (c *HTTPClient) GetKeyVersion(ctx context.Context, storeName, id, version string) (*types.KeyResponse, error) {
+	key := &types.KeyResponse{}
+	reqURL := fmt.Sprintf("%s/%s/%s?version=%s", withURLStore(c.config.URL, storeName), keysPath, id, version)
+
+	response, err := getRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (c *HTTPClient) RotateKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
+	key := &types.KeyResponse{}
+	reqURL := fmt.Sprintf("%s/%s/%s/rotate", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
 func (c *HTTPClient) GetDeletedKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
 	key := &types.KeyResponse{}
 	reqURL := fmt.Sprintf("%s/%s/%s?deleted=true", withURLStore(c.config.URL, storeName), keysPath, id)
@@ -148,3 +205,25 @@ func (c *HTTPClient) RestoreKey(ctx context.Context, storeName, id string) error
 	defer closeResponse(response)
 	return parseEmptyBodyResponse(response)
 }
+
+func (c *HTTPClient) EnableKey(ctx context.Context, storeName, id string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/enable", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := putRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	return parseEmptyBodyResponse(response)
+}
+
+func (c *HTTPClient) DisableKey(ctx context.Context, storeName, id string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/disable", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := putRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	return parseEmptyBodyResponse(response)
+}
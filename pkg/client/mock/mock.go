@@ -6,6 +6,7 @@ package mock
 
 import (
 	context "context"
+	json "encoding/json"
 	jsonrpc "github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	types "github.com/consensys/quorum-key-manager/src/aliases/api/types"
 	types0 "github.com/consensys/quorum-key-manager/src/stores/api/types"
@@ -110,6 +111,34 @@ func (mr *MockSecretsClientMockRecorder) RestoreSecret(ctx, storeName, id interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecret", reflect.TypeOf((*MockSecretsClient)(nil).RestoreSecret), ctx, storeName, id)
 }
 
+// EnableSecret mocks base method
+func (m *MockSecretsClient) EnableSecret(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableSecret", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableSecret indicates an expected call of EnableSecret
+func (mr *MockSecretsClientMockRecorder) EnableSecret(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableSecret", reflect.TypeOf((*MockSecretsClient)(nil).EnableSecret), ctx, storeName, id)
+}
+
+// DisableSecret mocks base method
+func (m *MockSecretsClient) DisableSecret(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableSecret", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableSecret indicates an expected call of DisableSecret
+func (mr *MockSecretsClientMockRecorder) DisableSecret(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableSecret", reflect.TypeOf((*MockSecretsClient)(nil).DisableSecret), ctx, storeName, id)
+}
+
 // DestroySecret mocks base method
 func (m *MockSecretsClient) DestroySecret(ctx context.Context, storeName, id string) error {
 	m.ctrl.T.Helper()
@@ -222,6 +251,36 @@ func (mr *MockKeysClientMockRecorder) SignKey(ctx, storeName, id, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeysClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// EncryptKey mocks base method
+func (m *MockKeysClient) EncryptKey(ctx context.Context, storeName, id string, request *types0.EncryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptKey indicates an expected call of EncryptKey
+func (mr *MockKeysClientMockRecorder) EncryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptKey", reflect.TypeOf((*MockKeysClient)(nil).EncryptKey), ctx, storeName, id, request)
+}
+
+// DecryptKey mocks base method
+func (m *MockKeysClient) DecryptKey(ctx context.Context, storeName, id string, request *types0.DecryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptKey indicates an expected call of DecryptKey
+func (mr *MockKeysClientMockRecorder) DecryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptKey", reflect.TypeOf((*MockKeysClient)(nil).DecryptKey), ctx, storeName, id, request)
+}
+
 // GetKey mocks base method
 func (m *MockKeysClient) GetKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +296,36 @@ func (mr *MockKeysClientMockRecorder) GetKey(ctx, storeName, id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockKeysClient)(nil).GetKey), ctx, storeName, id)
 }
 
+// GetKeyVersion mocks base method
+func (m *MockKeysClient) GetKeyVersion(ctx context.Context, storeName, id, version string) (*types0.KeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyVersion", ctx, storeName, id, version)
+	ret0, _ := ret[0].(*types0.KeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyVersion indicates an expected call of GetKeyVersion
+func (mr *MockKeysClientMockRecorder) GetKeyVersion(ctx, storeName, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyVersion", reflect.TypeOf((*MockKeysClient)(nil).GetKeyVersion), ctx, storeName, id, version)
+}
+
+// RotateKey mocks base method
+func (m *MockKeysClient) RotateKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, storeName, id)
+	ret0, _ := ret[0].(*types0.KeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey
+func (mr *MockKeysClientMockRecorder) RotateKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockKeysClient)(nil).RotateKey), ctx, storeName, id)
+}
+
 // ListKeys mocks base method
 func (m *MockKeysClient) ListKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +399,34 @@ func (mr *MockKeysClientMockRecorder) RestoreKey(ctx, storeName, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreKey", reflect.TypeOf((*MockKeysClient)(nil).RestoreKey), ctx, storeName, id)
 }
 
+// EnableKey mocks base method
+func (m *MockKeysClient) EnableKey(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableKey", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableKey indicates an expected call of EnableKey
+func (mr *MockKeysClientMockRecorder) EnableKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableKey", reflect.TypeOf((*MockKeysClient)(nil).EnableKey), ctx, storeName, id)
+}
+
+// DisableKey mocks base method
+func (m *MockKeysClient) DisableKey(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableKey", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableKey indicates an expected call of DisableKey
+func (mr *MockKeysClientMockRecorder) DisableKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableKey", reflect.TypeOf((*MockKeysClient)(nil).DisableKey), ctx, storeName, id)
+}
+
 // DestroyKey mocks base method
 func (m *MockKeysClient) DestroyKey(ctx context.Context, storeName, id string) error {
 	m.ctrl.T.Helper()
@@ -467,6 +584,51 @@ func (mr *MockEthClientMockRecorder) SignEEATransaction(ctx, storeName, address,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockEthClient)(nil).SignEEATransaction), ctx, storeName, address, request)
 }
 
+// EncryptEth mocks base method
+func (m *MockEthClient) EncryptEth(ctx context.Context, storeName, address string, request *types0.EncryptRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptEth indicates an expected call of EncryptEth
+func (mr *MockEthClientMockRecorder) EncryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptEth", reflect.TypeOf((*MockEthClient)(nil).EncryptEth), ctx, storeName, address, request)
+}
+
+// DecryptEth mocks base method
+func (m *MockEthClient) DecryptEth(ctx context.Context, storeName, address string, request *types0.DecryptRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptEth indicates an expected call of DecryptEth
+func (mr *MockEthClientMockRecorder) DecryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptEth", reflect.TypeOf((*MockEthClient)(nil).DecryptEth), ctx, storeName, address, request)
+}
+
+// ExportEthAccount mocks base method
+func (m *MockEthClient) ExportEthAccount(ctx context.Context, storeName, address string, request *types0.ExportEthAccountRequest) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEthAccount", ctx, storeName, address, request)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEthAccount indicates an expected call of ExportEthAccount
+func (mr *MockEthClientMockRecorder) ExportEthAccount(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEthAccount", reflect.TypeOf((*MockEthClient)(nil).ExportEthAccount), ctx, storeName, address, request)
+}
+
 // GetEthAccount mocks base method
 func (m *MockEthClient) GetEthAccount(ctx context.Context, storeName, address string) (*types0.EthAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +716,34 @@ func (mr *MockEthClientMockRecorder) RestoreEthAccount(ctx, storeName, address i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEthAccount", reflect.TypeOf((*MockEthClient)(nil).RestoreEthAccount), ctx, storeName, address)
 }
 
+// EnableEthAccount mocks base method
+func (m *MockEthClient) EnableEthAccount(ctx context.Context, storeName, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableEthAccount", ctx, storeName, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableEthAccount indicates an expected call of EnableEthAccount
+func (mr *MockEthClientMockRecorder) EnableEthAccount(ctx, storeName, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableEthAccount", reflect.TypeOf((*MockEthClient)(nil).EnableEthAccount), ctx, storeName, address)
+}
+
+// DisableEthAccount mocks base method
+func (m *MockEthClient) DisableEthAccount(ctx context.Context, storeName, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableEthAccount", ctx, storeName, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableEthAccount indicates an expected call of DisableEthAccount
+func (mr *MockEthClientMockRecorder) DisableEthAccount(ctx, storeName, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableEthAccount", reflect.TypeOf((*MockEthClient)(nil).DisableEthAccount), ctx, storeName, address)
+}
+
 // MockUtilsClient is a mock of UtilsClient interface
 type MockUtilsClient struct {
 	ctrl     *gomock.Controller
@@ -922,6 +1112,34 @@ func (mr *MockKeyManagerClientMockRecorder) RestoreSecret(ctx, storeName, id int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecret", reflect.TypeOf((*MockKeyManagerClient)(nil).RestoreSecret), ctx, storeName, id)
 }
 
+// EnableSecret mocks base method
+func (m *MockKeyManagerClient) EnableSecret(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableSecret", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableSecret indicates an expected call of EnableSecret
+func (mr *MockKeyManagerClientMockRecorder) EnableSecret(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableSecret", reflect.TypeOf((*MockKeyManagerClient)(nil).EnableSecret), ctx, storeName, id)
+}
+
+// DisableSecret mocks base method
+func (m *MockKeyManagerClient) DisableSecret(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableSecret", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableSecret indicates an expected call of DisableSecret
+func (mr *MockKeyManagerClientMockRecorder) DisableSecret(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableSecret", reflect.TypeOf((*MockKeyManagerClient)(nil).DisableSecret), ctx, storeName, id)
+}
+
 // DestroySecret mocks base method
 func (m *MockKeyManagerClient) DestroySecret(ctx context.Context, storeName, id string) error {
 	m.ctrl.T.Helper()
@@ -1011,6 +1229,36 @@ func (mr *MockKeyManagerClientMockRecorder) SignKey(ctx, storeName, id, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeyManagerClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// EncryptKey mocks base method
+func (m *MockKeyManagerClient) EncryptKey(ctx context.Context, storeName, id string, request *types0.EncryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptKey indicates an expected call of EncryptKey
+func (mr *MockKeyManagerClientMockRecorder) EncryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptKey", reflect.TypeOf((*MockKeyManagerClient)(nil).EncryptKey), ctx, storeName, id, request)
+}
+
+// DecryptKey mocks base method
+func (m *MockKeyManagerClient) DecryptKey(ctx context.Context, storeName, id string, request *types0.DecryptBase64PayloadRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptKey indicates an expected call of DecryptKey
+func (mr *MockKeyManagerClientMockRecorder) DecryptKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptKey", reflect.TypeOf((*MockKeyManagerClient)(nil).DecryptKey), ctx, storeName, id, request)
+}
+
 // GetKey mocks base method
 func (m *MockKeyManagerClient) GetKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1026,6 +1274,36 @@ func (mr *MockKeyManagerClientMockRecorder) GetKey(ctx, storeName, id interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockKeyManagerClient)(nil).GetKey), ctx, storeName, id)
 }
 
+// GetKeyVersion mocks base method
+func (m *MockKeyManagerClient) GetKeyVersion(ctx context.Context, storeName, id, version string) (*types0.KeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyVersion", ctx, storeName, id, version)
+	ret0, _ := ret[0].(*types0.KeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyVersion indicates an expected call of GetKeyVersion
+func (mr *MockKeyManagerClientMockRecorder) GetKeyVersion(ctx, storeName, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyVersion", reflect.TypeOf((*MockKeyManagerClient)(nil).GetKeyVersion), ctx, storeName, id, version)
+}
+
+// RotateKey mocks base method
+func (m *MockKeyManagerClient) RotateKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, storeName, id)
+	ret0, _ := ret[0].(*types0.KeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey
+func (mr *MockKeyManagerClientMockRecorder) RotateKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockKeyManagerClient)(nil).RotateKey), ctx, storeName, id)
+}
+
 // ListKeys mocks base method
 func (m *MockKeyManagerClient) ListKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1099,6 +1377,34 @@ func (mr *MockKeyManagerClientMockRecorder) RestoreKey(ctx, storeName, id interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreKey", reflect.TypeOf((*MockKeyManagerClient)(nil).RestoreKey), ctx, storeName, id)
 }
 
+// EnableKey mocks base method
+func (m *MockKeyManagerClient) EnableKey(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableKey", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableKey indicates an expected call of EnableKey
+func (mr *MockKeyManagerClientMockRecorder) EnableKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableKey", reflect.TypeOf((*MockKeyManagerClient)(nil).EnableKey), ctx, storeName, id)
+}
+
+// DisableKey mocks base method
+func (m *MockKeyManagerClient) DisableKey(ctx context.Context, storeName, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableKey", ctx, storeName, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableKey indicates an expected call of DisableKey
+func (mr *MockKeyManagerClientMockRecorder) DisableKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableKey", reflect.TypeOf((*MockKeyManagerClient)(nil).DisableKey), ctx, storeName, id)
+}
+
 // DestroyKey mocks base method
 func (m *MockKeyManagerClient) DestroyKey(ctx context.Context, storeName, id string) error {
 	m.ctrl.T.Helper()
@@ -1233,6 +1539,51 @@ func (mr *MockKeyManagerClientMockRecorder) SignEEATransaction(ctx, storeName, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockKeyManagerClient)(nil).SignEEATransaction), ctx, storeName, address, request)
 }
 
+// EncryptEth mocks base method
+func (m *MockKeyManagerClient) EncryptEth(ctx context.Context, storeName, address string, request *types0.EncryptRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptEth indicates an expected call of EncryptEth
+func (mr *MockKeyManagerClientMockRecorder) EncryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptEth", reflect.TypeOf((*MockKeyManagerClient)(nil).EncryptEth), ctx, storeName, address, request)
+}
+
+// DecryptEth mocks base method
+func (m *MockKeyManagerClient) DecryptEth(ctx context.Context, storeName, address string, request *types0.DecryptRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecryptEth", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecryptEth indicates an expected call of DecryptEth
+func (mr *MockKeyManagerClientMockRecorder) DecryptEth(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecryptEth", reflect.TypeOf((*MockKeyManagerClient)(nil).DecryptEth), ctx, storeName, address, request)
+}
+
+// ExportEthAccount mocks base method
+func (m *MockKeyManagerClient) ExportEthAccount(ctx context.Context, storeName, address string, request *types0.ExportEthAccountRequest) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEthAccount", ctx, storeName, address, request)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEthAccount indicates an expected call of ExportEthAccount
+func (mr *MockKeyManagerClientMockRecorder) ExportEthAccount(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).ExportEthAccount), ctx, storeName, address, request)
+}
+
 // GetEthAccount mocks base method
 func (m *MockKeyManagerClient) GetEthAccount(ctx context.Context, storeName, address string) (*types0.EthAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -1320,6 +1671,34 @@ func (mr *MockKeyManagerClientMockRecorder) RestoreEthAccount(ctx, storeName, ad
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).RestoreEthAccount), ctx, storeName, address)
 }
 
+// EnableEthAccount mocks base method
+func (m *MockKeyManagerClient) EnableEthAccount(ctx context.Context, storeName, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableEthAccount", ctx, storeName, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableEthAccount indicates an expected call of EnableEthAccount
+func (mr *MockKeyManagerClientMockRecorder) EnableEthAccount(ctx, storeName, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).EnableEthAccount), ctx, storeName, address)
+}
+
+// DisableEthAccount mocks base method
+func (m *MockKeyManagerClient) DisableEthAccount(ctx context.Context, storeName, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableEthAccount", ctx, storeName, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableEthAccount indicates an expected call of DisableEthAccount
+func (mr *MockKeyManagerClientMockRecorder) DisableEthAccount(ctx, storeName, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).DisableEthAccount), ctx, storeName, address)
+}
+
 // VerifyKeySignature mocks base method
 func (m *MockKeyManagerClient) VerifyKeySignature(ctx context.Context, request *types1.VerifyKeySignatureRequest) error {
 	m.ctrl.T.Helper()
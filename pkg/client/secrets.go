@@ -80,6 +80,28 @@ func (c *HTTPClient) RestoreSecret(ctx context.Context, storeName, id string) er
 	return nil
 }
 
+func (c *HTTPClient) EnableSecret(ctx context.Context, storeName, id string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/enable", withURLStore(c.config.URL, storeName), secretsPath, id)
+	response, err := putRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	return parseEmptyBodyResponse(response)
+}
+
+func (c *HTTPClient) DisableSecret(ctx context.Context, storeName, id string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/disable", withURLStore(c.config.URL, storeName), secretsPath, id)
+	response, err := putRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	return parseEmptyBodyResponse(response)
+}
+
 func (c *HTTPClient) DestroySecret(ctx context.Context, storeName, id string) error {
 	reqURL := fmt.Sprintf("%s/%s/%s/destroy", withURLStore(c.config.URL, storeName), secretsPath, id)
 	response, err := deleteRequest(ctx, c.client, reqURL)
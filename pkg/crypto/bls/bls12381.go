@@ -0,0 +1,386 @@
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+// BLS signatures as used by the Ethereum consensus layer: public keys in G1, signatures in G2, proof of possession scheme
+// https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-04
+// Points are serialized in their compressed form, following the ZCash format
+const (
+	PrivateKeySize = 32
+	PublicKeySize  = 48
+	SignatureSize  = 96
+
+	fpSize = 48
+
+	compressedFlag = 0x80
+	infinityFlag   = 0x40
+	signFlag       = 0x20
+)
+
+var (
+	domainSeparationTag = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	fpModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	frModulus, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+	fpHalfModulus = new(big.Int).Rsh(fpModulus, 1)
+	fpSqrtExp     = new(big.Int).Rsh(new(big.Int).Add(fpModulus, big.NewInt(1)), 2)
+	fp2SqrtExp1   = new(big.Int).Rsh(new(big.Int).Sub(fpModulus, big.NewInt(3)), 2)
+	fp2SqrtExp2   = new(big.Int).Rsh(new(big.Int).Sub(fpModulus, big.NewInt(1)), 1)
+)
+
+func CreateBLS12381(importedPrivKey []byte) (privKey, pubKey []byte, err error) {
+	var sk *big.Int
+	if importedPrivKey != nil {
+		sk, err = parsePrivateKey(importedPrivKey)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		for sk == nil || sk.Sign() == 0 {
+			sk, err = rand.Int(rand.Reader, frModulus)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	g1 := bls12381.NewG1()
+	pk := g1.MulScalar(g1.New(), g1.One(), sk)
+
+	privKey = make([]byte, PrivateKeySize)
+	sk.FillBytes(privKey)
+	return privKey, compressG1(g1, pk), nil
+}
+
+func SignBLS12381(privKey, data []byte) ([]byte, error) {
+	sk, err := parsePrivateKey(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key. %s", err.Error())
+	}
+
+	g2 := bls12381.NewG2()
+	h, err := hashToG2(g2, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash data. %s", err.Error())
+	}
+
+	return compressG2(g2, g2.MulScalar(g2.New(), h, sk)), nil
+}
+
+func VerifyBLS12381Signature(publicKey, message, signature []byte) (bool, error) {
+	g1 := bls12381.NewG1()
+	pk, err := decompressG1(g1, publicKey)
+	if err != nil {
+		return false, fmt.Errorf("invalid BLS12-381 public key. %s", err.Error())
+	}
+	if g1.IsZero(pk) {
+		return false, fmt.Errorf("invalid BLS12-381 public key. identity point")
+	}
+
+	g2 := bls12381.NewG2()
+	sig, err := decompressG2(g2, signature)
+	if err != nil {
+		return false, fmt.Errorf("invalid BLS12-381 signature. %s", err.Error())
+	}
+
+	h, err := hashToG2(g2, message)
+	if err != nil {
+		return false, err
+	}
+
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(pk, h)
+	engine.AddPairInv(g1.One(), sig)
+	return engine.Check(), nil
+}
+
+func parsePrivateKey(privKey []byte) (*big.Int, error) {
+	if len(privKey) != PrivateKeySize {
+		return nil, fmt.Errorf("invalid BLS12-381 private key length")
+	}
+
+	sk := new(big.Int).SetBytes(privKey)
+	if sk.Sign() == 0 || sk.Cmp(frModulus) >= 0 {
+		return nil, fmt.Errorf("invalid BLS12-381 private key value")
+	}
+
+	return sk, nil
+}
+
+// hashToG2 implements hash_to_curve for the BLS12381G2_XMD:SHA-256_SSWU_RO_ suite
+// https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-hash-to-curve-11#section-8.8.2
+func hashToG2(g2 *bls12381.G2, msg []byte) (*bls12381.PointG2, error) {
+	uniformBytes, err := expandMessageXMD(msg, domainSeparationTag, 4*64)
+	if err != nil {
+		return nil, err
+	}
+
+	var points [2]*bls12381.PointG2
+	for i := range points {
+		// Field elements are encoded as c1 || c0 by the bls12381 package
+		u := make([]byte, 2*fpSize)
+		for j := 0; j < 2; j++ {
+			e := new(big.Int).SetBytes(uniformBytes[64*(j+2*i) : 64*(j+2*i+1)])
+			e.Mod(e, fpModulus).FillBytes(u[(1-j)*fpSize : (2-j)*fpSize])
+		}
+
+		points[i], err = g2.MapToCurve(u)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g2.Affine(g2.Add(g2.New(), points[0], points[1])), nil
+}
+
+// https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-hash-to-curve-11#section-5.4.1
+func expandMessageXMD(msg, dst []byte, length int) ([]byte, error) {
+	ell := (length + sha256.Size - 1) / sha256.Size
+	if ell > 255 || len(dst) > 255 {
+		return nil, fmt.Errorf("invalid expand message length")
+	}
+
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sha256.BlockSize))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	uniformBytes := append([]byte{}, bi...)
+	for i := 2; i <= ell; i++ {
+		xored := make([]byte, sha256.Size)
+		for j := range xored {
+			xored[j] = b0[j] ^ bi[j]
+		}
+
+		h.Reset()
+		h.Write(xored)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		uniformBytes = append(uniformBytes, bi...)
+	}
+
+	return uniformBytes[:length], nil
+}
+
+func compressG1(g1 *bls12381.G1, p *bls12381.PointG1) []byte {
+	out := make([]byte, PublicKeySize)
+	if g1.IsZero(p) {
+		out[0] = compressedFlag | infinityFlag
+		return out
+	}
+
+	raw := g1.ToBytes(p)
+	copy(out, raw[:fpSize])
+	out[0] |= compressedFlag
+	if new(big.Int).SetBytes(raw[fpSize:]).Cmp(fpHalfModulus) > 0 {
+		out[0] |= signFlag
+	}
+
+	return out
+}
+
+func decompressG1(g1 *bls12381.G1, in []byte) (*bls12381.PointG1, error) {
+	if len(in) != PublicKeySize {
+		return nil, fmt.Errorf("invalid length")
+	}
+
+	x, isInfinity, isLargest, err := parseCompressedFlags(in)
+	if err != nil {
+		return nil, err
+	}
+	if isInfinity {
+		return g1.Zero(), nil
+	}
+
+	x0 := new(big.Int).SetBytes(x)
+	if x0.Cmp(fpModulus) >= 0 {
+		return nil, fmt.Errorf("invalid field element")
+	}
+
+	// y^2 = x^3 + 4
+	y2 := new(big.Int).Exp(x0, big.NewInt(3), fpModulus)
+	y2.Add(y2, big.NewInt(4)).Mod(y2, fpModulus)
+	y := new(big.Int).Exp(y2, fpSqrtExp, fpModulus)
+	if new(big.Int).Exp(y, big.NewInt(2), fpModulus).Cmp(y2) != 0 {
+		return nil, fmt.Errorf("point is not on curve")
+	}
+	if (y.Cmp(fpHalfModulus) > 0) != isLargest {
+		y.Sub(fpModulus, y)
+	}
+
+	raw := make([]byte, 2*fpSize)
+	x0.FillBytes(raw[:fpSize])
+	y.FillBytes(raw[fpSize:])
+	p, err := g1.FromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !g1.InCorrectSubgroup(p) {
+		return nil, fmt.Errorf("point is not in the correct subgroup")
+	}
+
+	return p, nil
+}
+
+func compressG2(g2 *bls12381.G2, p *bls12381.PointG2) []byte {
+	out := make([]byte, SignatureSize)
+	if g2.IsZero(p) {
+		out[0] = compressedFlag | infinityFlag
+		return out
+	}
+
+	raw := g2.ToBytes(p)
+	copy(out, raw[:2*fpSize])
+	out[0] |= compressedFlag
+	if fp2IsLargest(newFp2(raw[2*fpSize:])) {
+		out[0] |= signFlag
+	}
+
+	return out
+}
+
+func decompressG2(g2 *bls12381.G2, in []byte) (*bls12381.PointG2, error) {
+	if len(in) != SignatureSize {
+		return nil, fmt.Errorf("invalid length")
+	}
+
+	xBytes, isInfinity, isLargest, err := parseCompressedFlags(in)
+	if err != nil {
+		return nil, err
+	}
+	if isInfinity {
+		return g2.Zero(), nil
+	}
+
+	x := newFp2(xBytes)
+	if x[0].Cmp(fpModulus) >= 0 || x[1].Cmp(fpModulus) >= 0 {
+		return nil, fmt.Errorf("invalid field element")
+	}
+
+	// y^2 = x^3 + 4(1 + i)
+	y2 := fp2Add(fp2Mul(fp2Mul(x, x), x), fp2{big.NewInt(4), big.NewInt(4)})
+	y, ok := fp2Sqrt(y2)
+	if !ok {
+		return nil, fmt.Errorf("point is not on curve")
+	}
+	if fp2IsLargest(y) != isLargest {
+		y = fp2Neg(y)
+	}
+
+	raw := make([]byte, 4*fpSize)
+	copy(raw, xBytes)
+	y[1].FillBytes(raw[2*fpSize : 3*fpSize])
+	y[0].FillBytes(raw[3*fpSize:])
+	p, err := g2.FromBytes(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !g2.InCorrectSubgroup(p) {
+		return nil, fmt.Errorf("point is not in the correct subgroup")
+	}
+
+	return p, nil
+}
+
+func parseCompressedFlags(in []byte) (x []byte, isInfinity, isLargest bool, err error) {
+	if in[0]&compressedFlag == 0 {
+		return nil, false, false, fmt.Errorf("point is not compressed")
+	}
+
+	x = append([]byte{}, in...)
+	x[0] &= 0x1f
+	isInfinity = in[0]&infinityFlag != 0
+	isLargest = in[0]&signFlag != 0
+	if isInfinity && (isLargest || !bytes.Equal(x, make([]byte, len(x)))) {
+		return nil, false, false, fmt.Errorf("invalid point at infinity")
+	}
+
+	return x, isInfinity, isLargest, nil
+}
+
+// fp2 is an element c0 + c1 * i of Fp2 = Fp[i] / (i^2 + 1)
+type fp2 [2]*big.Int
+
+// newFp2 parses an element encoded as c1 || c0
+func newFp2(in []byte) fp2 {
+	return fp2{new(big.Int).SetBytes(in[fpSize : 2*fpSize]), new(big.Int).SetBytes(in[:fpSize])}
+}
+
+func fp2Add(a, b fp2) fp2 {
+	return fp2{
+		new(big.Int).Mod(new(big.Int).Add(a[0], b[0]), fpModulus),
+		new(big.Int).Mod(new(big.Int).Add(a[1], b[1]), fpModulus),
+	}
+}
+
+func fp2Neg(a fp2) fp2 {
+	return fp2{
+		new(big.Int).Mod(new(big.Int).Neg(a[0]), fpModulus),
+		new(big.Int).Mod(new(big.Int).Neg(a[1]), fpModulus),
+	}
+}
+
+func fp2Mul(a, b fp2) fp2 {
+	c0 := new(big.Int).Sub(new(big.Int).Mul(a[0], b[0]), new(big.Int).Mul(a[1], b[1]))
+	c1 := new(big.Int).Add(new(big.Int).Mul(a[0], b[1]), new(big.Int).Mul(a[1], b[0]))
+	return fp2{c0.Mod(c0, fpModulus), c1.Mod(c1, fpModulus)}
+}
+
+func fp2Exp(a fp2, e *big.Int) fp2 {
+	r := fp2{big.NewInt(1), big.NewInt(0)}
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = fp2Mul(r, r)
+		if e.Bit(i) == 1 {
+			r = fp2Mul(r, a)
+		}
+	}
+	return r
+}
+
+func fp2Equal(a, b fp2) bool {
+	return a[0].Cmp(b[0]) == 0 && a[1].Cmp(b[1]) == 0
+}
+
+// fp2Sqrt computes a square root for p = 3 mod 4 (algorithm 9, https://eprint.iacr.org/2012/685.pdf)
+func fp2Sqrt(a fp2) (fp2, bool) {
+	a1 := fp2Exp(a, fp2SqrtExp1)
+	x0 := fp2Mul(a1, a)
+	alpha := fp2Mul(a1, x0)
+
+	var x fp2
+	if fp2Equal(alpha, fp2{new(big.Int).Sub(fpModulus, big.NewInt(1)), big.NewInt(0)}) {
+		x = fp2Mul(fp2{big.NewInt(0), big.NewInt(1)}, x0)
+	} else {
+		b := fp2Exp(fp2Add(alpha, fp2{big.NewInt(1), big.NewInt(0)}), fp2SqrtExp2)
+		x = fp2Mul(b, x0)
+	}
+
+	return x, fp2Equal(fp2Mul(x, x), a)
+}
+
+func fp2IsLargest(a fp2) bool {
+	if a[1].Sign() != 0 {
+		return a[1].Cmp(fpHalfModulus) > 0
+	}
+	return a[0].Cmp(fpHalfModulus) > 0
+}
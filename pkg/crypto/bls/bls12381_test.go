@@ -0,0 +1,92 @@
+package bls
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecode(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// Vectors from https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-hash-to-curve-11#appendix-K.1
+func TestExpandMessageXMD(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-expander")
+
+	tests := []struct {
+		msg      string
+		length   int
+		expected string
+	}{
+		{
+			msg:      "",
+			length:   0x20,
+			expected: "f659819a6473c1835b25ea59e3d38914c98b374f0970b7e4c92181df928fca88",
+		},
+		{
+			msg:      "abc",
+			length:   0x20,
+			expected: "1c38f7c211ef233367b2420d04798fa4698080a8901021a795a1151775fe4da7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			out, err := expandMessageXMD([]byte(tt.msg), dst, tt.length)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, hex.EncodeToString(out))
+		})
+	}
+}
+
+// Vectors from the Ethereum consensus spec BLS tests (sign and verify cases)
+// https://github.com/ethereum/consensus-spec-tests
+func TestSignBLS12381(t *testing.T) {
+	tests := []struct {
+		desc      string
+		privKey   string
+		pubKey    string
+		message   string
+		signature string
+	}{
+		{
+			desc:      "zero message",
+			privKey:   "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3",
+			pubKey:    "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+			message:   "0000000000000000000000000000000000000000000000000000000000000000",
+			signature: "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55",
+		},
+		{
+			desc:      "zero message, second key",
+			privKey:   "47b8192d77bf871b62e87859d653922725724a5c031afeabc60bcef5ff665138",
+			pubKey:    "b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81",
+			message:   "0000000000000000000000000000000000000000000000000000000000000000",
+			signature: "b23c46be3a001c63ca711f87a005c200cc550b9429d5f4eb38d74322144f1b63926da3388979e5321012fb1a0526bcd100b5ef5fe72628ce4cd5e904aeaa3279527843fae5ca9ca675f4f51ed8f83bbf7155da9ecc9663100a885d5dc6df96d9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, pubKey, err := CreateBLS12381(mustDecode(t, tt.privKey))
+			require.NoError(t, err)
+			assert.Equal(t, tt.pubKey, hex.EncodeToString(pubKey))
+
+			signature, err := SignBLS12381(mustDecode(t, tt.privKey), mustDecode(t, tt.message))
+			require.NoError(t, err)
+			assert.Equal(t, tt.signature, hex.EncodeToString(signature))
+
+			ok, err := VerifyBLS12381Signature(pubKey, mustDecode(t, tt.message), signature)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = VerifyBLS12381Signature(pubKey, []byte("another message"), signature)
+			require.NoError(t, err)
+			assert.False(t, ok)
+		})
+	}
+}
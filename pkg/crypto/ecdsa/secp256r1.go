@@ -0,0 +1,84 @@
+package ecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+const secp256r1KeySize = 32
+
+func CreateSecp256r1(importedPrivKey []byte) (privKey, pubKey []byte, err error) {
+	var ecdsaKey *ecdsa.PrivateKey
+	if importedPrivKey != nil {
+		ecdsaKey, err = toSecp256r1(importedPrivKey)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		ecdsaKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	privKey = make([]byte, secp256r1KeySize)
+	ecdsaKey.D.FillBytes(privKey)
+	pubKey = elliptic.Marshal(elliptic.P256(), ecdsaKey.X, ecdsaKey.Y)
+	return privKey, pubKey, nil
+}
+
+func SignSecp256r1(privKey, data []byte) ([]byte, error) {
+	if len(data) != secp256r1KeySize {
+		return nil, fmt.Errorf("data is required to be exactly %d bytes (%d)", secp256r1KeySize, len(data))
+	}
+
+	ecdsaPrivKey, err := toSecp256r1(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key. %s", err.Error())
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, ecdsaPrivKey, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign. %s", err.Error())
+	}
+
+	signature := make([]byte, 2*secp256r1KeySize)
+	r.FillBytes(signature[:secp256r1KeySize])
+	s.FillBytes(signature[secp256r1KeySize:])
+	return signature, nil
+}
+
+func VerifySecp256r1Signature(publicKey, message, signature []byte) (bool, error) {
+	x, y := elliptic.Unmarshal(elliptic.P256(), publicKey)
+	if x == nil {
+		return false, fmt.Errorf("invalid secp256r1 public key")
+	}
+	if len(signature) != 2*secp256r1KeySize {
+		return false, fmt.Errorf("invalid secp256r1 signature length")
+	}
+
+	r := new(big.Int).SetBytes(signature[:secp256r1KeySize])
+	s := new(big.Int).SetBytes(signature[secp256r1KeySize:])
+
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, message, r, s), nil
+}
+
+func toSecp256r1(privKey []byte) (*ecdsa.PrivateKey, error) {
+	if len(privKey) != secp256r1KeySize {
+		return nil, fmt.Errorf("invalid secp256r1 private key length")
+	}
+
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(privKey)
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, fmt.Errorf("invalid secp256r1 private key value")
+	}
+
+	key := &ecdsa.PrivateKey{D: d}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(privKey)
+	return key, nil
+}
@@ -0,0 +1,150 @@
+package eddsa
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+// ECIES over X25519, where the recipient X25519 key pair is derived from its ED25519 key pair:
+// ciphertext = ephemeralPublicKey (32 bytes) || nonce (12 bytes) || AES-256-GCM(data)
+const x25519ECIESInfo = "quorum-key-manager/x25519-ecies"
+
+var curve25519P, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", 16)
+
+func EncryptED25519(publicKey, data []byte) ([]byte, error) {
+	recipientPubKey, err := ed25519PublicKeyToX25519(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeralPrivKey := make([]byte, curve25519.ScalarSize)
+	if _, err = rand.Read(ephemeralPrivKey); err != nil {
+		return nil, err
+	}
+
+	ephemeralPubKey, err := curve25519.X25519(ephemeralPrivKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedSecret, err := curve25519.X25519(ephemeralPrivKey, recipientPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute shared secret. %s", err.Error())
+	}
+
+	aead, err := newX25519AEAD(sharedSecret, ephemeralPubKey, recipientPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	ciphertext := append(ephemeralPubKey, nonce...)
+	return aead.Seal(ciphertext, nonce, data, nil), nil
+}
+
+func DecryptED25519(privKey, ciphertext []byte) ([]byte, error) {
+	if len(privKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid ED25519 private key length")
+	}
+
+	recipientPrivKey := ed25519PrivateKeyToX25519(privKey)
+	recipientPubKey, err := curve25519.X25519(recipientPrivKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < curve25519.PointSize {
+		return nil, fmt.Errorf("invalid ciphertext length")
+	}
+	ephemeralPubKey := ciphertext[:curve25519.PointSize]
+
+	sharedSecret, err := curve25519.X25519(recipientPrivKey, ephemeralPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute shared secret. %s", err.Error())
+	}
+
+	aead, err := newX25519AEAD(sharedSecret, ephemeralPubKey, recipientPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < curve25519.PointSize+aead.NonceSize() {
+		return nil, fmt.Errorf("invalid ciphertext length")
+	}
+	nonce := ciphertext[curve25519.PointSize : curve25519.PointSize+aead.NonceSize()]
+
+	data, err := aead.Open(nil, nonce, ciphertext[curve25519.PointSize+aead.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt. %s", err.Error())
+	}
+
+	return data, nil
+}
+
+func newX25519AEAD(sharedSecret, ephemeralPubKey, recipientPubKey []byte) (cipher.AEAD, error) {
+	salt := append(append([]byte{}, ephemeralPubKey...), recipientPubKey...)
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, sharedSecret, salt, []byte(x25519ECIESInfo)), key); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// ed25519PrivateKeyToX25519 returns the X25519 scalar of an ED25519 private key (RFC 8032, section 5.1.5)
+func ed25519PrivateKeyToX25519(privKey []byte) []byte {
+	h := sha512.Sum512(ed25519.PrivateKey(privKey).Seed())
+	return h[:curve25519.ScalarSize]
+}
+
+// ed25519PublicKeyToX25519 maps an Edwards point to its Montgomery u-coordinate: u = (1 + y) / (1 - y) (RFC 7748, section 4.1)
+func ed25519PublicKeyToX25519(publicKey []byte) ([]byte, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ED25519 public key length")
+	}
+
+	yBytes := reverse(publicKey)
+	yBytes[0] &= 0x7f // clear the sign bit of x
+	y := new(big.Int).SetBytes(yBytes)
+
+	denominator := new(big.Int).Sub(big.NewInt(1), y)
+	denominator.Mod(denominator, curve25519P)
+	if denominator.Sign() == 0 {
+		return nil, fmt.Errorf("invalid ED25519 public key")
+	}
+
+	u := new(big.Int).Add(big.NewInt(1), y)
+	u.Mul(u, denominator.ModInverse(denominator, curve25519P))
+	u.Mod(u, curve25519P)
+
+	uBytes := make([]byte, curve25519.PointSize)
+	u.FillBytes(uBytes)
+	return reverse(uBytes), nil
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
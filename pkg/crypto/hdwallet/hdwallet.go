@@ -0,0 +1,112 @@
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	mnemonicEntropySize   = 256
+	DefaultDerivationPath = "m/44'/60'/0'/0"
+)
+
+var masterKeyHMACKey = []byte("Bitcoin seed")
+
+// NewMnemonic generates a random 24 words BIP-39 mnemonic
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropySize)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// SeedFromMnemonic validates a BIP-39 mnemonic and computes its seed using the given passphrase
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("invalid BIP-39 mnemonic")
+	}
+
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
+
+// ParseDerivationPath parses a BIP-44 derivation path, or returns the default Ethereum path if empty
+func ParseDerivationPath(path string) (accounts.DerivationPath, error) {
+	if path == "" {
+		path = DefaultDerivationPath
+	}
+
+	return accounts.ParseDerivationPath(path)
+}
+
+// DeriveSecp256k1 derives the secp256k1 private key of the child at the given index of the path (BIP-32)
+func DeriveSecp256k1(seed []byte, path accounts.DerivationPath, index uint32) ([]byte, error) {
+	if index >= 0x80000000 {
+		return nil, fmt.Errorf("derivation index must be lower than %d", uint32(0x80000000))
+	}
+
+	mac := hmac.New(sha512.New, masterKeyHMACKey)
+	_, _ = mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key, chainCode := new(big.Int).SetBytes(sum[:32]), sum[32:]
+	if key.Sign() == 0 || key.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, fmt.Errorf("invalid master key")
+	}
+
+	fullPath := append(append(accounts.DerivationPath{}, path...), index)
+	for _, i := range fullPath {
+		var err error
+		key, chainCode, err = deriveChild(key, chainCode, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return crypto.FromECDSA(toECDSA(key)), nil
+}
+
+func deriveChild(key *big.Int, chainCode []byte, i uint32) (*big.Int, []byte, error) {
+	var data []byte
+	if i >= 0x80000000 {
+		data = append([]byte{0x0}, math.PaddedBigBytes(key, 32)...)
+	} else {
+		data = crypto.CompressPubkey(&toECDSA(key).PublicKey)
+	}
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, i)
+	data = append(data, indexBytes...)
+
+	mac := hmac.New(sha512.New, chainCode)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(n) >= 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d", i)
+	}
+
+	child := tweak.Add(tweak, key)
+	child.Mod(child, n)
+	if child.Sign() == 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d", i)
+	}
+
+	return child, sum[32:], nil
+}
+
+func toECDSA(key *big.Int) *ecdsa.PrivateKey {
+	privKey, _ := crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+	return privKey
+}
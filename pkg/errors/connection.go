@@ -8,6 +8,8 @@ const (
 	Healthcheck    = "CN400"
 	BlockchainNode = "CN500"
 	Postgres       = "CN600"
+	PKCS11         = "CN700"
+	GCP            = "CN800"
 
 	InvalidRequest   = "IR000"
 	Unauthorized     = "IR100"
@@ -16,6 +18,7 @@ const (
 	InvalidFormat    = "IR400"
 	InvalidParameter = "IR500"
 	Forbidden        = "IR600"
+	PolicyViolation  = "IR610"
 	TooManyRequest   = "IR700"
 )
 
@@ -57,6 +60,26 @@ func IsAWSError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), AWS)
 }
 
+// PKCS11Error is raised when failing to perform on a PKCS#11 module
+func PKCS11Error(format string, a ...interface{}) *Error {
+	return Errorf(PKCS11, format, a...)
+}
+
+// IsPKCS11Error indicate whether an error is a PKCS#11 module error
+func IsPKCS11Error(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), PKCS11)
+}
+
+// GCPError is raised when failing to perform on GCP client
+func GCPError(format string, a ...interface{}) *Error {
+	return Errorf(GCP, format, a...)
+}
+
+// IsGCPError indicate whether an error is a GCP client connection error
+func IsGCPError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), GCP)
+}
+
 // PostgresError is raised when failing to perform on Postgres client
 func PostgresError(format string, a ...interface{}) *Error {
 	return Errorf(Postgres, format, a...)
@@ -100,6 +123,18 @@ func IsForbiddenError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), Forbidden)
 }
 
+// PolicyViolationError is raised when a transaction violates a rule of a signing policy
+func PolicyViolationError(policy, rule, format string, a ...interface{}) *Error {
+	return Errorf(PolicyViolation, format, a...).SetData(map[string]interface{}{
+		"policy": policy,
+		"rule":   rule,
+	})
+}
+
+func IsPolicyViolationError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), PolicyViolation)
+}
+
 // NotSupportedError is raised when operation is not supported
 func NotSupportedError(format string, a ...interface{}) *Error {
 	return Errorf(NotSupported, format, a...)
@@ -7,6 +7,7 @@ import (
 type Error struct {
 	Message string
 	Code    string
+	Data    map[string]interface{}
 }
 
 func (e *Error) GetCode() string {
@@ -26,8 +27,19 @@ func (e *Error) SetMessage(format string, args ...interface{}) *Error {
 	return e
 }
 
+// GetData returns the details attached to the error, if any
+func (e *Error) GetData() map[string]interface{} {
+	return e.Data
+}
+
+// SetData attaches details to the error, such as the rule a request violates
+func (e *Error) SetData(data map[string]interface{}) *Error {
+	e.Data = data
+	return e
+}
+
 func Errorf(code, format string, a ...interface{}) *Error {
-	return &Error{fmt.Sprintf(format, a...), code}
+	return &Error{Message: fmt.Sprintf(format, a...), Code: code}
 }
 
 func FromError(err interface{}) *Error {
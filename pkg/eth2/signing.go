@@ -0,0 +1,58 @@
+package eth2
+
+// Signature domains, see https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#domain-types
+type DomainType [4]byte
+
+var (
+	DomainBeaconProposer              = DomainType{0x00, 0x00, 0x00, 0x00}
+	DomainBeaconAttester              = DomainType{0x01, 0x00, 0x00, 0x00}
+	DomainRandao                      = DomainType{0x02, 0x00, 0x00, 0x00}
+	DomainDeposit                     = DomainType{0x03, 0x00, 0x00, 0x00}
+	DomainVoluntaryExit               = DomainType{0x04, 0x00, 0x00, 0x00}
+	DomainSelectionProof              = DomainType{0x05, 0x00, 0x00, 0x00}
+	DomainAggregateAndProof           = DomainType{0x06, 0x00, 0x00, 0x00}
+	DomainSyncCommittee               = DomainType{0x07, 0x00, 0x00, 0x00}
+	DomainSyncCommitteeSelectionProof = DomainType{0x08, 0x00, 0x00, 0x00}
+	DomainContributionAndProof        = DomainType{0x09, 0x00, 0x00, 0x00}
+	DomainApplicationBuilder          = DomainType{0x00, 0x00, 0x00, 0x01}
+)
+
+type Domain [32]byte
+
+// Fork is the fork of the chain at the time of signing
+type Fork struct {
+	PreviousVersion Version
+	CurrentVersion  Version
+	Epoch           uint64
+}
+
+// Version returns the fork version active at the given epoch
+func (f *Fork) Version(epoch uint64) Version {
+	if epoch < f.Epoch {
+		return f.PreviousVersion
+	}
+
+	return f.CurrentVersion
+}
+
+// ComputeDomain binds a domain type to a fork version and to a chain
+func ComputeDomain(domainType DomainType, forkVersion Version, genesisValidatorsRoot Root) Domain {
+	var versionRoot Root
+	copy(versionRoot[:], forkVersion[:])
+	forkDataRoot := containerRoot(versionRoot, genesisValidatorsRoot)
+
+	var domain Domain
+	copy(domain[:], domainType[:])
+	copy(domain[len(domainType):], forkDataRoot[:])
+	return domain
+}
+
+// ComputeSigningRoot returns the root actually signed by a validator for an object in a given domain
+func ComputeSigningRoot(objectRoot Root, domain Domain) Root {
+	return containerRoot(objectRoot, Root(domain))
+}
+
+// EpochAtSlot returns the epoch of a slot
+func EpochAtSlot(slot uint64) uint64 {
+	return slot / SlotsPerEpoch
+}
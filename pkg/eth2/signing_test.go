@@ -0,0 +1,113 @@
+package eth2
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeRoot(t *testing.T, s string) Root {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	require.Len(t, b, 32)
+
+	var root Root
+	copy(root[:], b)
+	return root
+}
+
+func TestComputeDomain(t *testing.T) {
+	// Domain of mainnet deposits
+	domain := ComputeDomain(DomainDeposit, Version{}, Root{})
+	assert.Equal(t, "03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9", hex.EncodeToString(domain[:]))
+}
+
+func TestFork(t *testing.T) {
+	fork := &Fork{PreviousVersion: Version{0, 0, 0x10, 0x20}, CurrentVersion: Version{1, 0, 0x10, 0x20}, Epoch: 36660}
+
+	assert.Equal(t, fork.PreviousVersion, fork.Version(36659))
+	assert.Equal(t, fork.CurrentVersion, fork.Version(36660))
+}
+
+func TestComputeSigningRoot(t *testing.T) {
+	root := mustDecodeRoot(t, "4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b")
+	genesisValidatorsRoot := mustDecodeRoot(t, "04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673")
+	data := AttestationData{
+		Slot:            32,
+		BeaconBlockRoot: root,
+		Source:          Checkpoint{Epoch: 1, Root: root},
+		Target:          Checkpoint{Epoch: 2, Root: root},
+	}
+
+	t.Run("should compute the signing root of an attestation", func(t *testing.T) {
+		domain := ComputeDomain(DomainBeaconAttester, Version{0, 0, 0x10, 0x20}, genesisValidatorsRoot)
+		signingRoot := ComputeSigningRoot(data.HashTreeRoot(), domain)
+
+		assert.Equal(t, "e0069713d538bc0cf5d46b4b8a6e13381e47751c42837ae022953abe99bf8da4", hex.EncodeToString(signingRoot[:]))
+	})
+
+	t.Run("should compute a different signing root in another domain", func(t *testing.T) {
+		domain := ComputeDomain(DomainBeaconProposer, Version{0, 0, 0x10, 0x20}, genesisValidatorsRoot)
+		signingRoot := ComputeSigningRoot(data.HashTreeRoot(), domain)
+
+		assert.NotEqual(t, "e0069713d538bc0cf5d46b4b8a6e13381e47751c42837ae022953abe99bf8da4", hex.EncodeToString(signingRoot[:]))
+	})
+
+	t.Run("should compute the root of an aggregated attestation", func(t *testing.T) {
+		signature := make([]byte, BLSSignatureSize)
+		for i := range signature {
+			signature[i] = byte(i)
+		}
+		attestation := &Attestation{AggregationBits: []byte{0x1b}, Data: data, Signature: signature}
+
+		attestationRoot, err := attestation.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, "3e7b7cd08f3990b3b36a1b30e546ca3d9d30ff63e4478042538d9a3e3898257d", hex.EncodeToString(attestationRoot[:]))
+	})
+
+	t.Run("should fail to compute the root of an attestation with an invalid signature", func(t *testing.T) {
+		attestation := &Attestation{AggregationBits: []byte{0x01}, Data: data, Signature: []byte{1}}
+
+		_, err := attestation.HashTreeRoot()
+		assert.Error(t, err)
+	})
+}
+
+func TestBitlistRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		bits     []byte
+		expected string
+	}{
+		{
+			name:     "empty bitlist",
+			bits:     []byte{0x01},
+			expected: "e8e527e84f666163a90ef900e013f56b0a4d020148b2224057b719f351b003a6",
+		},
+		{
+			name:     "delimiting bit in its own byte",
+			bits:     []byte{0xff, 0x01},
+			expected: "eebfa0d92b6c11efb3105805eae7ab6f4120ca797b958f5c7e7e5c46e2fb23be",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root, err := bitlistRoot(test.bits, MaxValidatorsPerCommittee)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(root[:]))
+		})
+	}
+
+	t.Run("should fail without delimiting bit", func(t *testing.T) {
+		_, err := bitlistRoot([]byte{0xff, 0x00}, MaxValidatorsPerCommittee)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the bitlist exceeds its limit", func(t *testing.T) {
+		_, err := bitlistRoot(append(make([]byte, 256), 0x03), MaxValidatorsPerCommittee)
+		assert.Error(t, err)
+	})
+}
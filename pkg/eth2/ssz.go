@@ -0,0 +1,101 @@
+package eth2
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// Minimal SSZ merkleization of the fixed size containers signed by validators
+// https://github.com/ethereum/consensus-specs/blob/dev/ssz/simple-serialize.md#merkleization
+
+const chunkSize = 32
+
+func hash(a, b Root) Root {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+func uint64Root(v uint64) Root {
+	var root Root
+	binary.LittleEndian.PutUint64(root[:], v)
+	return root
+}
+
+// pack splits serialized basic values into zero padded chunks
+func pack(data []byte) []Root {
+	chunks := make([]Root, (len(data)+chunkSize-1)/chunkSize)
+	for i := range chunks {
+		copy(chunks[i][:], data[i*chunkSize:])
+	}
+
+	return chunks
+}
+
+// merkleize computes the root of the chunks padded with zero chunks up to the next power of two of limit
+func merkleize(chunks []Root, limit int) Root {
+	size := 1
+	for size < limit || size < len(chunks) {
+		size *= 2
+	}
+
+	layer := make([]Root, size)
+	copy(layer, chunks)
+	for len(layer) > 1 {
+		next := make([]Root, len(layer)/2)
+		for i := range next {
+			next[i] = hash(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+
+	return layer[0]
+}
+
+func containerRoot(fields ...Root) Root {
+	return merkleize(fields, len(fields))
+}
+
+// bytesVectorRoot computes the root of a fixed size byte vector of the given size
+func bytesVectorRoot(data []byte, size int) (Root, error) {
+	if len(data) != size {
+		return Root{}, fmt.Errorf("invalid length %d, expected %d bytes", len(data), size)
+	}
+
+	return merkleize(pack(data), (size+chunkSize-1)/chunkSize), nil
+}
+
+// bitvectorRoot computes the root of a bitvector of the given number of bits
+func bitvectorRoot(bits []byte, size int) (Root, error) {
+	if len(bits) != (size+7)/8 {
+		return Root{}, fmt.Errorf("invalid bitvector length %d, expected %d bits", len(bits), size)
+	}
+
+	return merkleize(pack(bits), (size+255)/256), nil
+}
+
+// bitlistRoot computes the root of a bitlist serialized with its delimiting bit
+func bitlistRoot(bits []byte, limit int) (Root, error) {
+	if len(bits) == 0 || bits[len(bits)-1] == 0 {
+		return Root{}, fmt.Errorf("invalid bitlist, missing delimiting bit")
+	}
+
+	last := bits[len(bits)-1]
+	msb := 7
+	for last>>uint(msb) == 0 {
+		msb--
+	}
+
+	length := (len(bits)-1)*8 + msb
+	if length > limit {
+		return Root{}, fmt.Errorf("invalid bitlist length %d, limit is %d bits", length, limit)
+	}
+
+	data := make([]byte, (length+7)/8)
+	copy(data, bits)
+	if msb > 0 {
+		data[len(data)-1] &^= 1 << uint(msb)
+	}
+
+	root := merkleize(pack(data), (limit+255)/256)
+	return hash(root, uint64Root(uint64(length))), nil
+}
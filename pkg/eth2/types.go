@@ -0,0 +1,196 @@
+package eth2
+
+// Containers signed by validators, only the fields needed to compute their hash tree root are kept
+// https://github.com/ethereum/consensus-specs/tree/dev/specs
+
+const (
+	SlotsPerEpoch = 32
+
+	MaxValidatorsPerCommittee      = 2048
+	SyncCommitteeSize              = 512
+	SyncCommitteeSubnetCount       = 4
+	BLSPublicKeySize               = 48
+	BLSSignatureSize               = 96
+	ExecutionAddressSize           = 20
+	syncCommitteeAggregationBitLen = SyncCommitteeSize / SyncCommitteeSubnetCount
+)
+
+type (
+	Root    [32]byte
+	Version [4]byte
+)
+
+type Checkpoint struct {
+	Epoch uint64
+	Root  Root
+}
+
+type AttestationData struct {
+	Slot            uint64
+	Index           uint64
+	BeaconBlockRoot Root
+	Source          Checkpoint
+	Target          Checkpoint
+}
+
+type BeaconBlockHeader struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    Root
+	StateRoot     Root
+	BodyRoot      Root
+}
+
+type Attestation struct {
+	AggregationBits []byte
+	Data            AttestationData
+	Signature       []byte
+}
+
+type AggregateAndProof struct {
+	AggregatorIndex uint64
+	Aggregate       Attestation
+	SelectionProof  []byte
+}
+
+type DepositMessage struct {
+	PublicKey             []byte
+	WithdrawalCredentials Root
+	Amount                uint64
+}
+
+type VoluntaryExit struct {
+	Epoch          uint64
+	ValidatorIndex uint64
+}
+
+type SyncAggregatorSelectionData struct {
+	Slot              uint64
+	SubcommitteeIndex uint64
+}
+
+type SyncCommitteeContribution struct {
+	Slot              uint64
+	BeaconBlockRoot   Root
+	SubcommitteeIndex uint64
+	AggregationBits   []byte
+	Signature         []byte
+}
+
+type ContributionAndProof struct {
+	AggregatorIndex uint64
+	Contribution    SyncCommitteeContribution
+	SelectionProof  []byte
+}
+
+type ValidatorRegistration struct {
+	FeeRecipient []byte
+	GasLimit     uint64
+	Timestamp    uint64
+	PublicKey    []byte
+}
+
+// Uint64Root returns the hash tree root of a slot or an epoch
+func Uint64Root(v uint64) Root {
+	return uint64Root(v)
+}
+
+func (c *Checkpoint) HashTreeRoot() Root {
+	return containerRoot(uint64Root(c.Epoch), c.Root)
+}
+
+func (d *AttestationData) HashTreeRoot() Root {
+	return containerRoot(uint64Root(d.Slot), uint64Root(d.Index), d.BeaconBlockRoot, d.Source.HashTreeRoot(), d.Target.HashTreeRoot())
+}
+
+// HashTreeRoot returns the root of the header, equal to the root of the block it summarizes
+func (h *BeaconBlockHeader) HashTreeRoot() Root {
+	return containerRoot(uint64Root(h.Slot), uint64Root(h.ProposerIndex), h.ParentRoot, h.StateRoot, h.BodyRoot)
+}
+
+func (a *Attestation) HashTreeRoot() (Root, error) {
+	bitsRoot, err := bitlistRoot(a.AggregationBits, MaxValidatorsPerCommittee)
+	if err != nil {
+		return Root{}, err
+	}
+
+	signatureRoot, err := bytesVectorRoot(a.Signature, BLSSignatureSize)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return containerRoot(bitsRoot, a.Data.HashTreeRoot(), signatureRoot), nil
+}
+
+func (a *AggregateAndProof) HashTreeRoot() (Root, error) {
+	aggregateRoot, err := a.Aggregate.HashTreeRoot()
+	if err != nil {
+		return Root{}, err
+	}
+
+	proofRoot, err := bytesVectorRoot(a.SelectionProof, BLSSignatureSize)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return containerRoot(uint64Root(a.AggregatorIndex), aggregateRoot, proofRoot), nil
+}
+
+func (d *DepositMessage) HashTreeRoot() (Root, error) {
+	pubKeyRoot, err := bytesVectorRoot(d.PublicKey, BLSPublicKeySize)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return containerRoot(pubKeyRoot, d.WithdrawalCredentials, uint64Root(d.Amount)), nil
+}
+
+func (e *VoluntaryExit) HashTreeRoot() Root {
+	return containerRoot(uint64Root(e.Epoch), uint64Root(e.ValidatorIndex))
+}
+
+func (d *SyncAggregatorSelectionData) HashTreeRoot() Root {
+	return containerRoot(uint64Root(d.Slot), uint64Root(d.SubcommitteeIndex))
+}
+
+func (c *SyncCommitteeContribution) HashTreeRoot() (Root, error) {
+	bitsRoot, err := bitvectorRoot(c.AggregationBits, syncCommitteeAggregationBitLen)
+	if err != nil {
+		return Root{}, err
+	}
+
+	signatureRoot, err := bytesVectorRoot(c.Signature, BLSSignatureSize)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return containerRoot(uint64Root(c.Slot), c.BeaconBlockRoot, uint64Root(c.SubcommitteeIndex), bitsRoot, signatureRoot), nil
+}
+
+func (c *ContributionAndProof) HashTreeRoot() (Root, error) {
+	contributionRoot, err := c.Contribution.HashTreeRoot()
+	if err != nil {
+		return Root{}, err
+	}
+
+	proofRoot, err := bytesVectorRoot(c.SelectionProof, BLSSignatureSize)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return containerRoot(uint64Root(c.AggregatorIndex), contributionRoot, proofRoot), nil
+}
+
+func (r *ValidatorRegistration) HashTreeRoot() (Root, error) {
+	feeRecipientRoot, err := bytesVectorRoot(r.FeeRecipient, ExecutionAddressSize)
+	if err != nil {
+		return Root{}, err
+	}
+
+	pubKeyRoot, err := bytesVectorRoot(r.PublicKey, BLSPublicKeySize)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return containerRoot(feeRecipientRoot, uint64Root(r.GasLimit), uint64Root(r.Timestamp), pubKeyRoot), nil
+}
@@ -31,6 +31,7 @@ type ethService struct {
 	SendRawTransaction        func(jsonrpc.Client) func(context.Context, hexutil.Bytes) (ethcommon.Hash, error)                   `namespace:"eth"`
 	SendRawPrivateTransaction func(jsonrpc.Client) func(context.Context, hexutil.Bytes, *PrivateArgs) (ethcommon.Hash, error)     `namespace:"eth"`
 	GetBlockByNumber          func(jsonrpc.Client) func(context.Context, BlockNumber, bool) (*types.Header, error)                `method:"eth_getBlockByNumber"`
+	GetTransactionReceipt     func(jsonrpc.Client) func(context.Context, ethcommon.Hash) (*Receipt, error)                        `method:"eth_getTransactionReceipt"`
 }
 
 //go:generate mockgen -source=caller_eth.go -destination=mock/caller_eth.go -package=mock
@@ -44,6 +45,7 @@ type EthCaller interface {
 	EstimateGas(context.Context, *CallMsg) (uint64, error)
 	SendRawTransaction(context.Context, []byte) (ethcommon.Hash, error)
 	SendRawPrivateTransaction(context.Context, []byte, *PrivateArgs) (ethcommon.Hash, error)
+	GetTransactionReceipt(context.Context, ethcommon.Hash) (*Receipt, error)
 }
 
 type ethCaller struct {
@@ -102,3 +104,8 @@ func (c *ethCaller) BaseFeePerGas(ctx context.Context, blockNumber BlockNumber)
 
 	return header.BaseFee, nil
 }
+
+// GetTransactionReceipt returns the receipt of a mined transaction or nil if the transaction is not mined yet
+func (c *ethCaller) GetTransactionReceipt(ctx context.Context, hash ethcommon.Hash) (*Receipt, error) {
+	return ethSrv.GetTransactionReceipt(c.client)(ctx, hash)
+}
@@ -165,6 +165,44 @@ func TestCaller(t *testing.T) {
 		assert.Equal(t, "0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a", hash.String(), "Result should be valid")
 	})
 
+	t.Run("eth_getTransactionReceipt", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":{"transactionHash":"0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a","blockHash":"0x4b1f5b1c3e1a4a3fbe5e1b8e0d2f7cf0c5a1b7a5f3ec3b0d2ea6b3d6c0b1a2f3","blockNumber":"0x1b4","gasUsed":"0x5208","status":"0x1"}}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		receipt, err := cllr.Eth().GetTransactionReceipt(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		require.NotNil(t, receipt, "Receipt should be set")
+		assert.Equal(t, uint64(436), uint64(receipt.BlockNumber), "Block number should be valid")
+		assert.True(t, receipt.Successful(), "Receipt should be successful")
+	})
+
+	t.Run("eth_getTransactionReceipt on pending transaction", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":null}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		receipt, err := cllr.Eth().GetTransactionReceipt(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		assert.Nil(t, receipt, "Receipt should not be set")
+	})
+
 	t.Run("eea_sendRawTransaction", func(t *testing.T) {
 		m := testutils.RequestMatcher(
 			t,
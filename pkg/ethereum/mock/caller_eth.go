@@ -36,6 +36,21 @@ func (m *MockEthCaller) EXPECT() *MockEthCallerMockRecorder {
 	return m.recorder
 }
 
+// BaseFeePerGas mocks base method
+func (m *MockEthCaller) BaseFeePerGas(arg0 context.Context, arg1 ethereum.BlockNumber) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseFeePerGas", arg0, arg1)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BaseFeePerGas indicates an expected call of BaseFeePerGas
+func (mr *MockEthCallerMockRecorder) BaseFeePerGas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseFeePerGas", reflect.TypeOf((*MockEthCaller)(nil).BaseFeePerGas), arg0, arg1)
+}
+
 // ChainID mocks base method
 func (m *MockEthCaller) ChainID(arg0 context.Context) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -51,34 +66,34 @@ func (mr *MockEthCallerMockRecorder) ChainID(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainID", reflect.TypeOf((*MockEthCaller)(nil).ChainID), arg0)
 }
 
-// GasPrice mocks base method
-func (m *MockEthCaller) GasPrice(arg0 context.Context) (*big.Int, error) {
+// EstimateGas mocks base method
+func (m *MockEthCaller) EstimateGas(arg0 context.Context, arg1 *ethereum.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GasPrice", arg0)
-	ret0, _ := ret[0].(*big.Int)
+	ret := m.ctrl.Call(m, "EstimateGas", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GasPrice indicates an expected call of GasPrice
-func (mr *MockEthCallerMockRecorder) GasPrice(arg0 interface{}) *gomock.Call {
+// EstimateGas indicates an expected call of EstimateGas
+func (mr *MockEthCallerMockRecorder) EstimateGas(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GasPrice", reflect.TypeOf((*MockEthCaller)(nil).GasPrice), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockEthCaller)(nil).EstimateGas), arg0, arg1)
 }
 
-// BaseFeePerGas mocks base method
-func (m *MockEthCaller) BaseFeePerGas(arg0 context.Context, arg1 ethereum.BlockNumber) (*big.Int, error) {
+// GasPrice mocks base method
+func (m *MockEthCaller) GasPrice(arg0 context.Context) (*big.Int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BaseFeePerGas", arg0, arg1)
+	ret := m.ctrl.Call(m, "GasPrice", arg0)
 	ret0, _ := ret[0].(*big.Int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// BaseFeePerGas indicates an expected call of BaseFeePerGas
-func (mr *MockEthCallerMockRecorder) BaseFeePerGas(arg0, arg1 interface{}) *gomock.Call {
+// GasPrice indicates an expected call of GasPrice
+func (mr *MockEthCallerMockRecorder) GasPrice(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseFeePerGas", reflect.TypeOf((*MockEthCaller)(nil).BaseFeePerGas), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GasPrice", reflect.TypeOf((*MockEthCaller)(nil).GasPrice), arg0)
 }
 
 // GetTransactionCount mocks base method
@@ -96,47 +111,47 @@ func (mr *MockEthCallerMockRecorder) GetTransactionCount(arg0, arg1, arg2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionCount", reflect.TypeOf((*MockEthCaller)(nil).GetTransactionCount), arg0, arg1, arg2)
 }
 
-// EstimateGas mocks base method
-func (m *MockEthCaller) EstimateGas(arg0 context.Context, arg1 *ethereum.CallMsg) (uint64, error) {
+// GetTransactionReceipt mocks base method
+func (m *MockEthCaller) GetTransactionReceipt(arg0 context.Context, arg1 common.Hash) (*ethereum.Receipt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EstimateGas", arg0, arg1)
-	ret0, _ := ret[0].(uint64)
+	ret := m.ctrl.Call(m, "GetTransactionReceipt", arg0, arg1)
+	ret0, _ := ret[0].(*ethereum.Receipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EstimateGas indicates an expected call of EstimateGas
-func (mr *MockEthCallerMockRecorder) EstimateGas(arg0, arg1 interface{}) *gomock.Call {
+// GetTransactionReceipt indicates an expected call of GetTransactionReceipt
+func (mr *MockEthCallerMockRecorder) GetTransactionReceipt(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockEthCaller)(nil).EstimateGas), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionReceipt", reflect.TypeOf((*MockEthCaller)(nil).GetTransactionReceipt), arg0, arg1)
 }
 
-// SendRawTransaction mocks base method
-func (m *MockEthCaller) SendRawTransaction(arg0 context.Context, arg1 []byte) (common.Hash, error) {
+// SendRawPrivateTransaction mocks base method
+func (m *MockEthCaller) SendRawPrivateTransaction(arg0 context.Context, arg1 []byte, arg2 *ethereum.PrivateArgs) (common.Hash, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendRawTransaction", arg0, arg1)
+	ret := m.ctrl.Call(m, "SendRawPrivateTransaction", arg0, arg1, arg2)
 	ret0, _ := ret[0].(common.Hash)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendRawTransaction indicates an expected call of SendRawTransaction
-func (mr *MockEthCallerMockRecorder) SendRawTransaction(arg0, arg1 interface{}) *gomock.Call {
+// SendRawPrivateTransaction indicates an expected call of SendRawPrivateTransaction
+func (mr *MockEthCallerMockRecorder) SendRawPrivateTransaction(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawPrivateTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawPrivateTransaction), arg0, arg1, arg2)
 }
 
-// SendRawPrivateTransaction mocks base method
-func (m *MockEthCaller) SendRawPrivateTransaction(arg0 context.Context, arg1 []byte, arg2 *ethereum.PrivateArgs) (common.Hash, error) {
+// SendRawTransaction mocks base method
+func (m *MockEthCaller) SendRawTransaction(arg0 context.Context, arg1 []byte) (common.Hash, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendRawPrivateTransaction", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SendRawTransaction", arg0, arg1)
 	ret0, _ := ret[0].(common.Hash)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendRawPrivateTransaction indicates an expected call of SendRawPrivateTransaction
-func (mr *MockEthCallerMockRecorder) SendRawPrivateTransaction(arg0, arg1, arg2 interface{}) *gomock.Call {
+// SendRawTransaction indicates an expected call of SendRawTransaction
+func (mr *MockEthCallerMockRecorder) SendRawTransaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawPrivateTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawPrivateTransaction), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawTransaction), arg0, arg1)
 }
@@ -0,0 +1,24 @@
+package ethereum
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	ReceiptStatusFailed     = uint64(0)
+	ReceiptStatusSuccessful = uint64(1)
+)
+
+// Receipt holds the fields of a transaction receipt needed to follow a transaction until it is mined
+type Receipt struct {
+	TxHash      ethcommon.Hash `json:"transactionHash"`
+	BlockHash   ethcommon.Hash `json:"blockHash"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	GasUsed     hexutil.Uint64 `json:"gasUsed"`
+	Status      hexutil.Uint64 `json:"status"`
+}
+
+func (r *Receipt) Successful() bool {
+	return uint64(r.Status) == ReceiptStatusSuccessful
+}
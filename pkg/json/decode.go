@@ -25,6 +25,20 @@ func UnmarshalBody(body io.Reader, req interface{}) error {
 	return validateStruct(req)
 }
 
+// UnmarshalBodyLenient decodes and validates the body like UnmarshalBody but ignores unknown fields,
+// for payloads defined by third party APIs that only partially map to our types
+func UnmarshalBodyLenient(body io.Reader, req interface{}) error {
+	if body == nil {
+		return errors.InvalidFormatError("body is nil")
+	}
+	err := json.NewDecoder(body).Decode(req)
+	if err != nil {
+		return err
+	}
+
+	return validateStruct(req)
+}
+
 func UnmarshalJSON(src, dest interface{}) error {
 	bdata, err := MarshalJSON(src)
 	if err != nil {
@@ -21,7 +21,7 @@ func isHexAddress(fl validator.FieldLevel) bool {
 func isCurve(fl validator.FieldLevel) bool {
 	if fl.Field().String() != "" {
 		switch fl.Field().String() {
-		case string(entities.Secp256k1), string(entities.Babyjubjub):
+		case string(entities.Secp256k1), string(entities.Babyjubjub), string(entities.Curve25519), string(entities.Bls12381), string(entities.Secp256r1):
 			return true
 		default:
 			return false
@@ -34,7 +34,7 @@ func isCurve(fl validator.FieldLevel) bool {
 func isSigningAlgorithm(fl validator.FieldLevel) bool {
 	if fl.Field().String() != "" {
 		switch fl.Field().String() {
-		case string(entities.Ecdsa), string(entities.Eddsa):
+		case string(entities.Ecdsa), string(entities.Eddsa), string(entities.Bls):
 			return true
 		default:
 			return false
@@ -16,6 +16,14 @@ type Client interface {
 	Do(*RequestMsg) (*ResponseMsg, error)
 }
 
+// BatchClient is a jsonrpc client able to send batches of requests
+type BatchClient interface {
+	Client
+
+	// DoBatch sends a batch of jsonrpc requests and returns the responses in the order of the requests
+	DoBatch(BatchRequestMsg) (BatchResponseMsg, error)
+}
+
 type incrementalIDClient struct {
 	client Client
 
@@ -58,3 +58,51 @@ func (c *HTTPClient) Do(reqMsg *RequestMsg) (*ResponseMsg, error) {
 
 	return respMsg, nil
 }
+
+// DoBatch sends a batch of jsonrpc requests in a single HTTP request and returns the responses in the order of the requests
+func (c *HTTPClient) DoBatch(reqMsgs BatchRequestMsg) (BatchResponseMsg, error) {
+	err := reqMsgs.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	req, _ := http.NewRequestWithContext(reqMsgs[0].Context(), http.MethodPost, "", nil)
+
+	// write request body
+	err = request.WriteJSON(req, reqMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, DownstreamError(err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, InvalidDownstreamHTTPStatusError(resp.StatusCode)
+	}
+
+	// A batch made only of notifications has no response
+	respMsgs := BatchResponseMsg{}
+	if reqMsgs.expectsResponse() {
+		err = response.ReadJSON(resp, &respMsgs)
+		if err != nil {
+			return nil, InvalidDownstreamResponse(err)
+		}
+	} else {
+		resp.Body.Close()
+	}
+
+	err = respMsgs.Validate()
+	if err != nil {
+		return nil, InvalidDownstreamResponse(err)
+	}
+
+	sorted, err := respMsgs.Sort(reqMsgs)
+	if err != nil {
+		return nil, InvalidDownstreamResponse(err)
+	}
+
+	return sorted, nil
+}
@@ -203,3 +203,55 @@ func TestHTTPClient(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPClientDoBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transport := testutils.NewMockRoundTripper(ctrl)
+
+	client := NewHTTPClient(&http.Client{Transport: transport})
+
+	reqMsgs := BatchRequestMsg{
+		(&RequestMsg{}).WithVersion("2.0").WithMethod("testMethod").WithID(1),
+		(&RequestMsg{}).WithVersion("2.0").WithMethod("testNotification"),
+		(&RequestMsg{}).WithVersion("2.0").WithMethod("testMethod").WithID(2),
+	}
+	expectedReqBody := []byte(`[{"jsonrpc":"2.0","method":"testMethod","params":null,"id":1},{"jsonrpc":"2.0","method":"testNotification","params":null,"id":null},{"jsonrpc":"2.0","method":"testMethod","params":null,"id":2}]`)
+
+	t.Run("should send a batch and return responses in the order of the requests", func(t *testing.T) {
+		header := make(http.Header)
+		header.Set("Content-Type", "application/json")
+		transport.EXPECT().RoundTrip(testutils.RequestMatcher(t, "", expectedReqBody)).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"jsonrpc":"2.0","id":2,"result":"b"},{"jsonrpc":"2.0","id":1,"result":"a"}]`))),
+			Header:     header,
+		}, nil)
+
+		resps, err := client.DoBatch(reqMsgs)
+		require.NoError(t, err, "DoBatch must not error")
+		require.Len(t, resps, 3, "DoBatch must return a response per request")
+
+		var res string
+		require.NoError(t, resps[0].UnmarshalResult(&res))
+		assert.Equal(t, "a", res, "First response should be correct")
+		assert.Nil(t, resps[1], "Notification should have no response")
+		require.NoError(t, resps[2].UnmarshalResult(&res))
+		assert.Equal(t, "b", res, "Third response should be correct")
+	})
+
+	t.Run("should fail with invalid downstream response if a response is missing", func(t *testing.T) {
+		header := make(http.Header)
+		header.Set("Content-Type", "application/json")
+		transport.EXPECT().RoundTrip(testutils.RequestMatcher(t, "", expectedReqBody)).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"jsonrpc":"2.0","id":1,"result":"a"}]`))),
+			Header:     header,
+		}, nil)
+
+		resps, err := client.DoBatch(reqMsgs)
+		require.Error(t, err, "DoBatch must error")
+		assert.Equal(t, -32003, err.(*ErrorMsg).Code, "Error must be an invalid downstream response")
+		assert.Nil(t, resps, "DoBatch must return nil responses")
+	})
+}
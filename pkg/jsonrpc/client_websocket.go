@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -22,7 +23,7 @@ type WebSocketClient struct {
 
 	liveOps map[string]*operation
 
-	todos     chan *operation
+	todos     chan *outgoing
 	failedOps chan *operation
 
 	readResp chan *ResponseMsg
@@ -43,7 +44,7 @@ func NewWebsocketClient(conn *websocket.Conn) *WebSocketClient {
 		conn:         conn,
 		writeTimeout: 10 * time.Second,
 		liveOps:      make(map[string]*operation),
-		todos:        make(chan *operation),
+		todos:        make(chan *outgoing),
 		failedOps:    make(chan *operation),
 		readResp:     make(chan *ResponseMsg, 20),
 		readErr:      make(chan error),
@@ -89,16 +90,11 @@ func (c *WebSocketClient) Do(reqMsg *RequestMsg) (*ResponseMsg, error) {
 		return nil, err
 	}
 
-	rawID, _ := json.Marshal(reqMsg.ID)
-	op := &operation{
-		c:    c,
-		id:   string(rawID),
-		msg:  reqMsg,
+	op := c.newOp(reqMsg)
+	err = c.send(&outgoing{
+		ops:  []*operation{op},
 		sent: make(chan error),
-		resp: make(chan *ResponseMsg),
-	}
-
-	err = c.send(op)
+	})
 	if err != nil {
 		return nil, DownstreamError(err)
 	}
@@ -112,15 +108,61 @@ func (c *WebSocketClient) Do(reqMsg *RequestMsg) (*ResponseMsg, error) {
 	return respMsg, nil
 }
 
-func (c *WebSocketClient) send(op *operation) error {
-	ctx := op.msg.Context()
+// DoBatch sends a batch of jsonrpc requests in a single websocket message and returns the responses in the order of the requests.
+// Requests without ID (notifications) are given a nil response
+func (c *WebSocketClient) DoBatch(reqMsgs BatchRequestMsg) (BatchResponseMsg, error) {
+	err := reqMsgs.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	out := &outgoing{
+		batch: true,
+		sent:  make(chan error),
+	}
+	for _, reqMsg := range reqMsgs {
+		out.ops = append(out.ops, c.newOp(reqMsg))
+	}
+
+	err = c.send(out)
+	if err != nil {
+		return nil, DownstreamError(err)
+	}
+
+	respMsgs := make(BatchResponseMsg, len(reqMsgs))
+	for i, op := range out.ops {
+		if op.msg.ID == nil {
+			continue
+		}
+
+		respMsgs[i], err = op.wait()
+		if err != nil {
+			return nil, DownstreamError(err)
+		}
+	}
+
+	return respMsgs, nil
+}
+
+func (c *WebSocketClient) newOp(reqMsg *RequestMsg) *operation {
+	rawID, _ := json.Marshal(reqMsg.ID)
+	return &operation{
+		c:    c,
+		id:   string(rawID),
+		msg:  reqMsg,
+		resp: make(chan *ResponseMsg, 1),
+	}
+}
+
+func (c *WebSocketClient) send(out *outgoing) error {
+	ctx := out.ops[0].msg.Context()
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	case <-c.closing:
 		return ErrClientQuit
-	case c.todos <- op:
-		err := <-op.sent
+	case c.todos <- out:
+		err := <-out.sent
 		return err
 	}
 }
@@ -138,44 +180,57 @@ func (c *WebSocketClient) read() {
 			return
 		}
 
-		respMsg := new(ResponseMsg)
-		err = json.NewDecoder(r).Decode(respMsg)
+		b, err := ioutil.ReadAll(r)
 		if err != nil {
 			continue
 		}
 
-		err = respMsg.Validate()
+		respMsgs := BatchResponseMsg{}
+		if IsBatch(b) {
+			err = json.Unmarshal(b, &respMsgs)
+		} else {
+			respMsg := new(ResponseMsg)
+			err = json.Unmarshal(b, respMsg)
+			respMsgs = append(respMsgs, respMsg)
+		}
 		if err != nil {
 			continue
 		}
 
-		c.readResp <- respMsg
+		for _, respMsg := range respMsgs {
+			if respMsg.Validate() == nil {
+				c.readResp <- respMsg
+			}
+		}
 	}
 }
 
 func (c *WebSocketClient) manageOp() {
 	for {
 		select {
-		case op := <-c.todos:
-			// Register op
-			c.addOp(op)
+		case out := <-c.todos:
+			// Register ops, notifications in a batch expect no response
+			for _, op := range out.ops {
+				if !out.batch || op.msg.ID != nil {
+					c.addOp(op)
+				}
+			}
 
 			// Compute write deadline
-			ctx := op.msg.Context()
+			ctx := out.ops[0].msg.Context()
 			deadline, ok := ctx.Deadline()
 			if !ok {
 				deadline = time.Now().Add(c.writeTimeout)
 			}
 
 			// Write Message
-			_ = op.c.conn.SetWriteDeadline(deadline)
-			err := op.c.conn.WriteJSON(op.msg)
-			op.sent <- err
+			_ = c.conn.SetWriteDeadline(deadline)
+			err := c.conn.WriteJSON(out.msg())
+			out.sent <- err
 
 			if err != nil {
-				select {
-				case op.c.failedOps <- op:
-				case <-op.c.closing:
+				for _, op := range out.ops {
+					c.removeOp(op)
 				}
 			}
 		case msg := <-c.readResp:
@@ -239,14 +294,33 @@ func (c *WebSocketClient) handleRespMsg(respMsg *ResponseMsg) {
 	c.removeOp(op)
 }
 
+// outgoing is a websocket message carrying a single request or a batch of requests
+type outgoing struct {
+	ops   []*operation
+	batch bool
+
+	sent chan error
+}
+
+func (out *outgoing) msg() interface{} {
+	if !out.batch {
+		return out.ops[0].msg
+	}
+
+	msgs := make(BatchRequestMsg, len(out.ops))
+	for i, op := range out.ops {
+		msgs[i] = op.msg
+	}
+
+	return msgs
+}
+
 type operation struct {
 	c *WebSocketClient
 
 	id  string
 	msg *RequestMsg
 
-	sent chan error
-
 	resp chan *ResponseMsg
 	err  error
 }
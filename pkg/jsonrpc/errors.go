@@ -122,3 +122,19 @@ func InvalidDownstreamResponse(err error) *ErrorMsg {
 		},
 	}
 }
+
+// TransactionRejectedError is returned when the key manager refuses to sign a transaction, as defined in EIP-1474
+func TransactionRejectedError(err error, data map[string]interface{}) *ErrorMsg {
+	errData := map[string]interface{}{
+		"message": err.Error(),
+	}
+	for k, v := range data {
+		errData[k] = v
+	}
+
+	return &ErrorMsg{
+		Code:    -32003,
+		Message: "Transaction rejected",
+		Data:    errData,
+	}
+}
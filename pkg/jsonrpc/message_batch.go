@@ -0,0 +1,95 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// BatchRequestMsg is a JSON-RPC v2 batch of requests
+type BatchRequestMsg []*RequestMsg
+
+// BatchResponseMsg is a JSON-RPC v2 batch of responses
+type BatchResponseMsg []*ResponseMsg
+
+// IsBatch indicates whether a JSON-RPC message body is a batch, i.e. a JSON array
+func IsBatch(b []byte) bool {
+	b = bytes.TrimLeft(b, " \t\r\n")
+	return len(b) > 0 && b[0] == '['
+}
+
+// Validate JSON-RPC batch of requests
+func (batch BatchRequestMsg) Validate() error {
+	if len(batch) == 0 {
+		return InvalidRequest(fmt.Errorf("empty batch"))
+	}
+
+	ids := make(map[string]bool)
+	for _, msg := range batch {
+		err := msg.Validate()
+		if err != nil {
+			return err
+		}
+
+		if msg.ID == nil {
+			continue
+		}
+
+		rawID, _ := json.Marshal(msg.ID)
+		if ids[string(rawID)] {
+			return InvalidRequest(fmt.Errorf("duplicated id %s in batch", rawID))
+		}
+		ids[string(rawID)] = true
+	}
+
+	return nil
+}
+
+// expectsResponse indicates whether at least one request of the batch is not a notification
+func (batch BatchRequestMsg) expectsResponse() bool {
+	for _, msg := range batch {
+		if msg.ID != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate JSON-RPC batch of responses
+func (batch BatchResponseMsg) Validate() error {
+	for _, msg := range batch {
+		err := msg.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sort returns the responses in the order of the requests of the batch, responses being matched on IDs.
+// Requests without ID (notifications) have no response and are given a nil response
+func (batch BatchResponseMsg) Sort(reqs BatchRequestMsg) (BatchResponseMsg, error) {
+	resps := make(map[string]*ResponseMsg)
+	for _, msg := range batch {
+		rawID, _ := json.Marshal(msg.ID)
+		resps[string(rawID)] = msg
+	}
+
+	sorted := make(BatchResponseMsg, len(reqs))
+	for i, req := range reqs {
+		if req.ID == nil {
+			continue
+		}
+
+		rawID, _ := json.Marshal(req.ID)
+		resp, ok := resps[string(rawID)]
+		if !ok {
+			return nil, fmt.Errorf("missing response for id %s", rawID)
+		}
+		sorted[i] = resp
+	}
+
+	return sorted, nil
+}
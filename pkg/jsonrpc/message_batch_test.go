@@ -0,0 +1,97 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBatch(t *testing.T) {
+	assert.True(t, IsBatch([]byte(`[{"jsonrpc":"2.0","method":"eth_chainId","id":1}]`)), "Array should be a batch")
+	assert.True(t, IsBatch([]byte(" \n\t[]")), "Array with leading whitespaces should be a batch")
+	assert.False(t, IsBatch([]byte(`{"jsonrpc":"2.0","method":"eth_chainId","id":1}`)), "Object should not be a batch")
+	assert.False(t, IsBatch([]byte(``)), "Empty body should not be a batch")
+}
+
+func TestUnmarshalBatchRequestMsg(t *testing.T) {
+	batch := BatchRequestMsg{}
+	err := json.Unmarshal([]byte(`[{"jsonrpc":"2.0","method":"eth_chainId","id":1},{"jsonrpc":"2.0","method":"eth_blockNumber"}]`), &batch)
+	require.NoError(t, err, "Unmarshal should not error")
+	require.Len(t, batch, 2, "Batch should have 2 requests")
+
+	assert.Equal(t, "eth_chainId", batch[0].Method, "Method should be correct")
+	assert.Equal(t, "eth_blockNumber", batch[1].Method, "Method should be correct")
+	assert.Nil(t, batch[1].ID, "Notification should have no ID")
+}
+
+func TestBatchRequestMsgValidate(t *testing.T) {
+	tests := []struct {
+		desc        string
+		batch       BatchRequestMsg
+		expectedErr bool
+	}{
+		{
+			desc: "valid batch",
+			batch: BatchRequestMsg{
+				(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_chainId").WithID(1),
+				(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_chainId").WithID(2),
+				(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_chainId"),
+			},
+		},
+		{
+			desc:        "empty batch",
+			batch:       BatchRequestMsg{},
+			expectedErr: true,
+		},
+		{
+			desc: "invalid request",
+			batch: BatchRequestMsg{
+				(&RequestMsg{}).WithVersion("2.0").WithID(1),
+			},
+			expectedErr: true,
+		},
+		{
+			desc: "duplicated ID",
+			batch: BatchRequestMsg{
+				(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_chainId").WithID(1),
+				(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_blockNumber").WithID(1),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.batch.Validate()
+			if tt.expectedErr {
+				assert.Error(t, err, "Validate should error")
+			} else {
+				assert.NoError(t, err, "Validate should not error")
+			}
+		})
+	}
+}
+
+func TestBatchResponseMsgSort(t *testing.T) {
+	reqs := BatchRequestMsg{
+		(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_chainId").WithID("a"),
+		(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_chainId"),
+		(&RequestMsg{}).WithVersion("2.0").WithMethod("eth_chainId").WithID("b"),
+	}
+
+	resps := BatchResponseMsg{}
+	err := json.Unmarshal([]byte(`[{"jsonrpc":"2.0","result":"0x2","id":"b"},{"jsonrpc":"2.0","result":"0x1","id":"a"}]`), &resps)
+	require.NoError(t, err, "Unmarshal should not error")
+
+	sorted, err := resps.Sort(reqs)
+	require.NoError(t, err, "Sort should not error")
+	require.Len(t, sorted, 3, "Sorted responses should match requests")
+	assert.Equal(t, json.RawMessage(`"0x1"`), sorted[0].Result, "First response should be correct")
+	assert.Nil(t, sorted[1], "Notification should have no response")
+	assert.Equal(t, json.RawMessage(`"0x2"`), sorted[2].Result, "Third response should be correct")
+
+	_, err = resps[:1].Sort(reqs)
+	assert.Error(t, err, "Sort should error on missing response")
+}
@@ -22,6 +22,14 @@ type jsonRespMsg struct {
 	Result  *json.RawMessage `json:"result,omitempty"`
 	Error   *json.RawMessage `json:"error,omitempty"`
 	ID      *json.RawMessage `json:"id,omitempty"`
+
+	// nullResult indicates the body holds an explicit null result (which is a valid result on success)
+	nullResult bool
+}
+
+// jsonRespResult is used to tell an explicit null result apart from a missing one
+type jsonRespResult struct {
+	Result json.RawMessage `json:"result"`
 }
 
 func (msg *ResponseMsg) UnmarshalJSON(b []byte) error {
@@ -40,6 +48,11 @@ func (msg *ResponseMsg) UnmarshalJSON(b []byte) error {
 
 	if raw.Result != nil {
 		msg.Result = *raw.Result
+	} else if raw.Error == nil {
+		res := new(jsonRespResult)
+		if err = json.Unmarshal(b, res); err == nil {
+			raw.nullResult = res.Result != nil
+		}
 	}
 
 	if raw.Error != nil {
@@ -110,7 +123,7 @@ func (msg *ResponseMsg) Validate() error {
 	}
 
 	isSuccess := msg.Error == nil
-	hasResult := msg.Result != nil
+	hasResult := msg.Result != nil || (msg.raw != nil && msg.raw.nullResult)
 
 	if isSuccess && !hasResult {
 		return fmt.Errorf("missing result on success")
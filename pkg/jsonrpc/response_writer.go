@@ -99,3 +99,62 @@ func (rw *responseWriter) WriteMsg(msg *ResponseMsg) error {
 func (rw *responseWriter) Writer() io.Writer {
 	return rw.w
 }
+
+// BatchResponseWriter collects the responses to the requests of a batch and writes them as a single JSON array
+type BatchResponseWriter struct {
+	w    io.Writer
+	msgs BatchResponseMsg
+}
+
+func NewBatchResponseWriter(w io.Writer, size int) *BatchResponseWriter {
+	return &BatchResponseWriter{
+		w:    w,
+		msgs: make(BatchResponseMsg, size),
+	}
+}
+
+// Element returns the ResponseWriter of the i-th request of the batch
+func (rw *BatchResponseWriter) Element(i int) ResponseWriter {
+	return &elementResponseWriter{
+		batch: rw,
+		index: i,
+	}
+}
+
+// Flush writes the responses in the order of the requests of the batch.
+// Requests without response (notifications) are omitted and nothing is written if no request has a response
+func (rw *BatchResponseWriter) Flush() error {
+	msgs := BatchResponseMsg{}
+	for _, msg := range rw.msgs {
+		if msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if httpRw, ok := rw.w.(http.ResponseWriter); ok {
+		httpRw.Header().Set("Content-Type", "application/json")
+	}
+	return json.NewEncoder(rw.w).Encode(msgs)
+}
+
+func (rw *BatchResponseWriter) Writer() io.Writer {
+	return rw.w
+}
+
+type elementResponseWriter struct {
+	batch *BatchResponseWriter
+	index int
+}
+
+func (rw *elementResponseWriter) WriteMsg(msg *ResponseMsg) error {
+	rw.batch.msgs[rw.index] = msg
+	return nil
+}
+
+func (rw *elementResponseWriter) Writer() io.Writer {
+	return rw.batch.w
+}
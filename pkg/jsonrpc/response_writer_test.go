@@ -83,3 +83,28 @@ func TestWriteError(t *testing.T) {
 	expectedBody := []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"test error"}},"id":"abcd"}`)
 	assert.Equal(t, expectedBody, rec.Body.Bytes()[:(rec.Body.Len()-1)], "WriteError should write correct body")
 }
+
+func TestBatchResponseWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rw := NewBatchResponseWriter(rec, 3)
+
+	// Responses are written out of order and the second request is a notification
+	err := WriteResult(RWWithVersion("2.0")(RWWithID(3)(rw.Element(2))), "c")
+	require.NoError(t, err, "WriteResult should not error")
+	err = WriteResult(RWWithVersion("2.0")(RWWithID(1)(rw.Element(0))), "a")
+	require.NoError(t, err, "WriteResult should not error")
+	assert.Equal(t, 0, rec.Body.Len(), "Nothing should be written before Flush")
+
+	err = rw.Flush()
+	require.NoError(t, err, "Flush should not error")
+
+	expectedBody := []byte(`[{"jsonrpc":"2.0","result":"a","error":null,"id":1},{"jsonrpc":"2.0","result":"c","error":null,"id":3}]`)
+	assert.Equal(t, expectedBody, rec.Body.Bytes()[:(rec.Body.Len()-1)], "Flush should write correct body")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"), "Header Content-Type should have been set")
+
+	// Batch of notifications
+	rec = httptest.NewRecorder()
+	err = NewBatchResponseWriter(rec, 2).Flush()
+	require.NoError(t, err, "Flush should not error")
+	assert.Equal(t, 0, rec.Body.Len(), "Flush should write nothing")
+}
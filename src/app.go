@@ -15,6 +15,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 	tls "github.com/consensys/quorum-key-manager/src/infra/tls/filesystem"
 	nodesapp "github.com/consensys/quorum-key-manager/src/nodes/app"
+	policiesapp "github.com/consensys/quorum-key-manager/src/policies/app"
 	storesapp "github.com/consensys/quorum-key-manager/src/stores/app"
 	utilsapp "github.com/consensys/quorum-key-manager/src/utils/app"
 	vaultsapp "github.com/consensys/quorum-key-manager/src/vaults/app"
@@ -62,11 +63,16 @@ func New(ctx context.Context, cfg *Config, logger log.Logger) (*app.App, error)
 
 	aliasService := aliasapp.RegisterService(router, logger.WithComponent("aliases"), pgClient, authService)
 	vaultsService := vaultsapp.RegisterService(logger.WithComponent("vaults"), authService)
-	storesService := storesapp.RegisterService(router, logger.WithComponent("stores"), pgClient, authService, vaultsService)
-	nodesService := nodesapp.RegisterService(router, logger.WithComponent("nodes"), authService, storesService, aliasService)
+	policiesService := policiesapp.RegisterService(router, logger.WithComponent("policies"), pgClient, authService, aliasService)
+	storesService := storesapp.RegisterService(router, logger.WithComponent("stores"), pgClient, authService, vaultsService, policiesService, cfg.Web3Signer)
+	nodesService, err := nodesapp.RegisterService(a, logger.WithComponent("nodes"), pgClient, authService, storesService, aliasService, cfg.TxTracker)
+	if err != nil {
+		return nil, err
+	}
+
 	_ = utilsapp.RegisterService(router, logger.WithComponent("utilities"))
 
-	err = initialize(ctx, cfg.Manifest, authService, vaultsService, storesService, nodesService)
+	err = initialize(ctx, cfg.Manifest, authService, vaultsService, storesService, policiesService, nodesService)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,10 @@ func NewNoAuth() *NoAuth {
 
 func (m *NoAuth) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		next.ServeHTTP(rw, r.WithContext(WithUserInfo(r.Context(), entities.NewWildcardUser())))
+		// Without authentication, users are also allowed to manage policies which are not part of the wildcard permissions
+		userInfo := entities.NewWildcardUser()
+		userInfo.Permissions = append(userInfo.Permissions, entities.ReadPolicy, entities.WritePolicy, entities.DeletePolicy)
+
+		next.ServeHTTP(rw, r.WithContext(WithUserInfo(r.Context(), userInfo)))
 	})
 }
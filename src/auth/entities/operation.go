@@ -9,6 +9,7 @@ var ActionSign OpAction = "sign"
 var ActionEncrypt OpAction = "encrypt"
 var ActionDelete OpAction = "delete"
 var ActionDestroy OpAction = "destroy"
+var ActionExport OpAction = "export"
 var ActionProxy OpAction = "proxy"
 
 var ResourceKey OpResource = "keys"
@@ -17,6 +18,9 @@ var ResourceEthAccount OpResource = "ethereum"
 var ResourceStore OpResource = "stores"
 var ResourceNode OpResource = "nodes"
 var ResourceAlias OpResource = "aliases"
+var ResourceNonce OpResource = "nonces"
+var ResourceTransaction OpResource = "transactions"
+var ResourcePolicy OpResource = "policies"
 
 type Operation struct {
 	Action   OpAction
@@ -26,12 +26,27 @@ const DestroyEth Permission = "destroy:ethereum"
 const SignEth Permission = "sign:ethereum"
 const EncryptEth Permission = "encrypt:ethereum"
 
+// ExportEth allows to extract private keys, it is not included in wildcard permissions and must be granted explicitly
+const ExportEth Permission = "export:ethereum"
+
 const ProxyNode Permission = "proxy:nodes"
 
 const ReadAlias Permission = "read:aliases"
 const WriteAlias Permission = "write:aliases"
 const DeleteAlias Permission = "delete:aliases"
 
+const ReadNonce Permission = "read:nonces"
+const DeleteNonce Permission = "delete:nonces"
+
+const ReadTransaction Permission = "read:transactions"
+const WriteTransaction Permission = "write:transactions"
+
+// Policy permissions allow to lift the restrictions of the accounts of all tenants, they are not included in wildcard
+// permissions and must be granted explicitly
+const ReadPolicy Permission = "read:policies"
+const WritePolicy Permission = "write:policies"
+const DeletePolicy Permission = "delete:policies"
+
 func ListPermissions() []Permission {
 	return []Permission{
 		ReadSecret,
@@ -54,6 +69,10 @@ func ListPermissions() []Permission {
 		ReadAlias,
 		WriteAlias,
 		DeleteAlias,
+		ReadNonce,
+		DeleteNonce,
+		ReadTransaction,
+		WriteTransaction,
 	}
 }
 
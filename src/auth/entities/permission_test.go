@@ -11,8 +11,14 @@ func TestListWildcardPermission(t *testing.T) {
 	assert.Equal(t, list, ListPermissions())
 
 	list = ListWildcardPermission("read:*")
-	assert.Equal(t, list, []Permission{ReadSecret, ReadKey, ReadEth, ReadAlias})
+	assert.Equal(t, list, []Permission{ReadSecret, ReadKey, ReadEth, ReadAlias, ReadNonce, ReadTransaction})
 
 	list = ListWildcardPermission("*:ethereum")
 	assert.Equal(t, list, []Permission{ReadEth, WriteEth, DeleteEth, DestroyEth, SignEth, EncryptEth})
+
+	list = ListWildcardPermission("export:*")
+	assert.Empty(t, list)
+
+	list = ListWildcardPermission("*:policies")
+	assert.Empty(t, list)
 }
@@ -8,14 +8,18 @@ import (
 	manifestreader "github.com/consensys/quorum-key-manager/src/infra/manifests/yaml"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 	tls "github.com/consensys/quorum-key-manager/src/infra/tls/filesystem"
+	nodesapp "github.com/consensys/quorum-key-manager/src/nodes/app"
+	storesapp "github.com/consensys/quorum-key-manager/src/stores/app"
 )
 
 type Config struct {
-	HTTP     *server.Config
-	Logger   *zap.Config
-	Postgres *client.Config
-	OIDC     *jose.Config
-	APIKey   *csv.Config
-	TLS      *tls.Config
-	Manifest *manifestreader.Config
+	HTTP       *server.Config
+	Logger     *zap.Config
+	Postgres   *client.Config
+	OIDC       *jose.Config
+	APIKey     *csv.Config
+	TLS        *tls.Config
+	Manifest   *manifestreader.Config
+	Web3Signer *storesapp.Web3SignerConfig
+	TxTracker  *nodesapp.TxTrackerConfig
 }
@@ -6,10 +6,13 @@ type KeyType string
 const (
 	Ecdsa KeyType = "ecdsa"
 	Eddsa KeyType = "eddsa"
+	Bls   KeyType = "bls"
 
 	Babyjubjub Curve = "babyjubjub"
 	Secp256k1  Curve = "secp256k1"
 	Curve25519 Curve = "curve25519"
+	Bls12381   Curve = "bls12381"
+	Secp256r1  Curve = "secp256r1"
 )
 
 type Algorithm struct {
@@ -2,8 +2,10 @@ package client
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/consensys/quorum-key-manager/src/entities"
@@ -22,19 +24,39 @@ type Config struct {
 	Password            string
 	EnvironmentName     string
 	Resource            string
+	ManagedIdentity     bool
+	WorkloadIdentity    bool
+	FederatedTokenPath  string
 }
 
+// Environment variables injected by the Azure AD workload identity webhook
+const (
+	federatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
+	tenantIDEnv           = "AZURE_TENANT_ID"
+	clientIDEnv           = "AZURE_CLIENT_ID"
+)
+
 func NewConfig(cfg *entities.AzureConfig) *Config {
 	return &Config{
-		Endpoint:     fmt.Sprintf("https://%s.%s", cfg.VaultName, azure.PublicCloud.KeyVaultDNSSuffix),
-		TenantID:     cfg.TenantID,
-		ClientID:     cfg.ClientID,
-		ClientSecret: cfg.ClientSecret,
+		Endpoint:            fmt.Sprintf("https://%s.%s", cfg.VaultName, azure.PublicCloud.KeyVaultDNSSuffix),
+		TenantID:            cfg.TenantID,
+		ClientID:            cfg.ClientID,
+		ClientSecret:        cfg.ClientSecret,
+		CertificatePath:     cfg.CertificatePath,
+		CertificatePassword: cfg.CertificatePassword,
+		ManagedIdentity:     cfg.ManagedIdentity,
+		WorkloadIdentity:    cfg.WorkloadIdentity,
+		FederatedTokenPath:  cfg.FederatedTokenPath,
 	}
 }
 
 // ToAzureAuthConfig  Inspired by NewAuthorizerFromEnvironmentWithResource from github.com/azure/go-autorest/autorest/azure/auth@v0.5.7/auth.go (https://github.com/Azure/go-autorest/blob/master/autorest/azure/auth/auth.go)
 func (c *Config) ToAzureAuthConfig() (autorest.Authorizer, error) {
+	err := c.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	resource, err := c.getResource()
 	if err != nil {
 		return nil, err
@@ -46,7 +68,67 @@ func (c *Config) ToAzureAuthConfig() (autorest.Authorizer, error) {
 	}
 
 	settings.Values[auth.Resource] = resource
-	return settings.GetAuthorizer()
+
+	switch {
+	case c.WorkloadIdentity:
+		return c.workloadIdentityAuthorizer(settings)
+	case c.ManagedIdentity:
+		// ClientID selects a user-assigned identity, the system-assigned identity is used otherwise
+		return settings.GetMSI().Authorizer()
+	default:
+		return settings.GetAuthorizer()
+	}
+}
+
+func (c *Config) validate() error {
+	methods := 0
+	for _, isSet := range []bool{c.ClientSecret != "", c.CertificatePath != "", c.ManagedIdentity, c.WorkloadIdentity} {
+		if isSet {
+			methods++
+		}
+	}
+
+	switch {
+	case methods == 0:
+		return fmt.Errorf("one authentication method must be set: client secret, certificate, managed identity or workload identity")
+	case methods > 1:
+		return fmt.Errorf("only one authentication method can be set: client secret, certificate, managed identity or workload identity")
+	case (c.ClientSecret != "" || c.CertificatePath != "") && (c.TenantID == "" || c.ClientID == ""):
+		return fmt.Errorf("tenant ID and client ID are required for client secret and certificate authentication")
+	}
+
+	return nil
+}
+
+// workloadIdentityAuthorizer exchanges a federated token for an Azure AD token.
+// Tenant ID, client ID and token path default to the values injected by the Azure AD workload identity webhook
+func (c *Config) workloadIdentityAuthorizer(settings auth.EnvironmentSettings) (autorest.Authorizer, error) {
+	tenantID := valueOrEnv(c.TenantID, tenantIDEnv)
+	clientID := valueOrEnv(c.ClientID, clientIDEnv)
+	tokenPath := valueOrEnv(c.FederatedTokenPath, federatedTokenFileEnv)
+	if tenantID == "" || clientID == "" || tokenPath == "" {
+		return nil, fmt.Errorf("tenant ID, client ID and federated token path are required for workload identity authentication")
+	}
+
+	oauthConfig, err := adal.NewOAuthConfig(settings.Environment.ActiveDirectoryEndpoint, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	spToken, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig, clientID, settings.Values[auth.Resource], &federatedTokenSecret{path: tokenPath})
+	if err != nil {
+		return nil, err
+	}
+
+	return autorest.NewBearerAuthorizer(spToken), nil
+}
+
+func valueOrEnv(value, env string) string {
+	if value != "" {
+		return value
+	}
+
+	return os.Getenv(env)
 }
 
 // Inspired by getResource from services/keyvault/auth/auth.go (https://github.com/Azure/azure-sdk-for-go/blob/master/services/keyvault/auth/auth.go)
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// federatedTokenSecret implements adal.ServicePrincipalSecret for Azure AD workload identity federation.
+// The token file is read at each refresh as it is rotated by the platform (i.e. the projected service account token in AKS)
+type federatedTokenSecret struct {
+	path string
+}
+
+var _ adal.ServicePrincipalSecret = &federatedTokenSecret{}
+
+func (s *federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read the federated token file (%s): %v", s.path, err)
+	}
+
+	v.Set("client_assertion", strings.TrimSpace(string(token)))
+	v.Set("client_assertion_type", clientAssertionType)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s federatedTokenSecret) MarshalJSON() ([]byte, error) {
+	return nil, fmt.Errorf("marshalling federatedTokenSecret is not supported")
+}
@@ -32,11 +32,15 @@ type SecretsManagerClient interface {
 
 type KmsClient interface {
 	CreateKey(ctx context.Context, id, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error)
+	ImportKey(ctx context.Context, id, keyType string, keyMaterial []byte, tags []*kms.Tag) (*kms.CreateKeyOutput, error)
+	RotateKey(ctx context.Context, id, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error)
 	GetPublicKey(ctx context.Context, keyID string) (*kms.GetPublicKeyOutput, error)
 	ListKeys(ctx context.Context, limit int64, marker string) (*kms.ListKeysOutput, error)
 	ListTags(ctx context.Context, keyID, marker string) (*kms.ListResourceTagsOutput, error)
 	DescribeKey(ctx context.Context, id string) (*kms.DescribeKeyOutput, error)
 	Sign(ctx context.Context, keyID string, msg []byte, signingAlgorithm string) (*kms.SignOutput, error)
+	Encrypt(ctx context.Context, keyID string, plaintext []byte, encryptionAlgorithm string) (*kms.EncryptOutput, error)
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte, encryptionAlgorithm string) (*kms.DecryptOutput, error)
 	DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleKeyDeletionOutput, error)
 	RestoreKey(ctx context.Context, keyID string) (*kms.CancelKeyDeletionOutput, error)
 	GetAlias(ctx context.Context, keyID string) (string, error)
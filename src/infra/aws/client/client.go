@@ -3,7 +3,6 @@ package client
 import (
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/cenkalti/backoff/v4"
@@ -22,7 +21,7 @@ type AWSClient struct {
 var _ awsinfra.Client = &AWSClient{}
 
 func New(cfg *Config, logger log.Logger) (*AWSClient, error) {
-	sess, err := session.NewSession(cfg.ToAWSConfig())
+	sess, err := cfg.NewSession()
 	if err != nil {
 		return nil, err
 	}
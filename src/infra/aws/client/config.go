@@ -1,31 +1,59 @@
 package client
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/consensys/quorum-key-manager/src/entities"
 )
 
+const (
+	defaultRoleSessionName = "quorum-key-manager"
+	// Assumed role credentials are refreshed this long before they expire
+	assumeRoleExpiryWindow = time.Minute
+	// Bounds of the waiting period of KMS keys scheduled for deletion
+	minDeletionWindowDays = 7
+	maxDeletionWindowDays = 30
+)
+
 type Config struct {
-	Region    string
-	AccessID  string
-	SecretKey string
-	Debug     bool
+	Region             string
+	AccessID           string
+	SecretKey          string
+	Profile            string
+	RoleARN            string
+	ExternalID         string
+	RoleSessionName    string
+	DeletionWindowDays int64
+	Debug              bool
 }
 
 func NewConfig(cfg *entities.AWSConfig) *Config {
 	return &Config{
-		Region:    cfg.Region,
-		AccessID:  cfg.AccessID,
-		SecretKey: cfg.SecretKey,
-		Debug:     cfg.Debug,
+		Region:             cfg.Region,
+		AccessID:           cfg.AccessID,
+		SecretKey:          cfg.SecretKey,
+		Profile:            cfg.Profile,
+		RoleARN:            cfg.RoleARN,
+		ExternalID:         cfg.ExternalID,
+		RoleSessionName:    cfg.RoleSessionName,
+		DeletionWindowDays: cfg.DeletionWindowDays,
+		Debug:              cfg.Debug,
 	}
 }
 
+// ToAWSConfig uses static credentials if an access key is configured, the default credential chain of the session otherwise
 func (c *Config) ToAWSConfig() *aws.Config {
 	awsConfig := &aws.Config{
-		Credentials: credentials.NewStaticCredentials(c.AccessID, c.SecretKey, ""),
-		Region:      aws.String(c.Region),
+		Region: aws.String(c.Region),
+	}
+
+	if c.AccessID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(c.AccessID, c.SecretKey, "")
 	}
 
 	if c.Debug {
@@ -35,3 +63,44 @@ func (c *Config) ToAWSConfig() *aws.Config {
 
 	return awsConfig
 }
+
+// NewSession creates a session resolving credentials from the static access key or the standard credential chain
+// (environment, shared profile, web identity, container and instance metadata), assuming RoleARN if set.
+// Credentials are refreshed by the SDK when they expire
+func (c *Config) NewSession() (*session.Session, error) {
+	if (c.AccessID == "") != (c.SecretKey == "") {
+		return nil, fmt.Errorf("access_id and secret_key must be specified together")
+	}
+
+	if c.DeletionWindowDays != 0 && (c.DeletionWindowDays < minDeletionWindowDays || c.DeletionWindowDays > maxDeletionWindowDays) {
+		return nil, fmt.Errorf("deletion_window_days must be between %d and %d", minDeletionWindowDays, maxDeletionWindowDays)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *c.ToAWSConfig(),
+		Profile:           c.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.RoleARN == "" {
+		return sess, nil
+	}
+
+	roleSessionName := c.RoleSessionName
+	if roleSessionName == "" {
+		roleSessionName = defaultRoleSessionName
+	}
+
+	creds := stscreds.NewCredentials(sess, c.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = roleSessionName
+		p.ExpiryWindow = assumeRoleExpiryWindow
+		if c.ExternalID != "" {
+			p.ExternalID = aws.String(c.ExternalID)
+		}
+	})
+
+	return sess.Copy(&aws.Config{Credentials: creds}), nil
+}
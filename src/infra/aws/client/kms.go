@@ -2,6 +2,10 @@ package client
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"fmt"
 	"strings"
 	"time"
@@ -56,6 +60,133 @@ func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags [
 	return out, nil
 }
 
+// ImportKey creates a key with external origin and imports its key material, given as a DER encoded PKCS#8 private key.
+// The key material is wrapped with the RSA public key returned by KMS for this import and does not expire
+func (c *AWSClient) ImportKey(ctx context.Context, keyID, keyType string, keyMaterial []byte, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
+	keyUsage := kms.KeyUsageTypeSignVerify
+	origin := kms.OriginTypeExternal
+
+	out, err := c.kmsClient.CreateKey(&kms.CreateKeyInput{
+		KeySpec:  &keyType,
+		KeyUsage: &keyUsage,
+		Origin:   &origin,
+		Tags:     tags,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	params, err := c.kmsClient.GetParametersForImport(&kms.GetParametersForImportInput{
+		KeyId:             out.KeyMetadata.KeyId,
+		WrappingAlgorithm: aws.String(kms.AlgorithmSpecRsaesOaepSha256),
+		WrappingKeySpec:   aws.String(kms.WrappingKeySpecRsa2048),
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	encryptedKeyMaterial, err := wrapKeyMaterial(params.PublicKey, keyMaterial)
+	if err != nil {
+		return nil, errors.AWSError("failed to wrap key material: %v", err)
+	}
+
+	_, err = c.kmsClient.ImportKeyMaterial(&kms.ImportKeyMaterialInput{
+		KeyId:                out.KeyMetadata.KeyId,
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ImportToken:          params.ImportToken,
+		ExpirationModel:      aws.String(kms.ExpirationModelTypeKeyMaterialDoesNotExpire),
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	_, err = c.kmsClient.CreateAlias(&kms.CreateAliasInput{
+		AliasName:   &keyID,
+		TargetKeyId: out.KeyMetadata.KeyId,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	err = c.waitKeyState(ctx, keyID, func(metadata *kms.KeyMetadata) error {
+		if *metadata.Enabled {
+			return nil
+		}
+		return fmt.Errorf("key %s is still in not enabled", keyID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// RotateKey creates a new key and updates the alias to target it. If the alias cannot be moved to the new key,
+// it is left on the previous key and the new key is scheduled for deletion
+func (c *AWSClient) RotateKey(ctx context.Context, keyID, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
+	previous, err := c.DescribeKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyUsage := kms.KeyUsageTypeSignVerify
+
+	out, err := c.kmsClient.CreateKey(&kms.CreateKeyInput{
+		KeySpec:  &keyType,
+		KeyUsage: &keyUsage,
+		Tags:     tags,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	_, err = c.kmsClient.UpdateAlias(&kms.UpdateAliasInput{
+		AliasName:   &keyID,
+		TargetKeyId: out.KeyMetadata.KeyId,
+	})
+	if err != nil {
+		c.deleteOrphanKey(*out.KeyMetadata.KeyId)
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	err = c.waitKeyState(ctx, keyID, func(metadata *kms.KeyMetadata) error {
+		if *metadata.KeyId == *out.KeyMetadata.KeyId && *metadata.Enabled {
+			return nil
+		}
+		return fmt.Errorf("key %s is still in not enabled", keyID)
+	})
+	if err != nil {
+		_, aliasErr := c.kmsClient.UpdateAlias(&kms.UpdateAliasInput{
+			AliasName:   &keyID,
+			TargetKeyId: previous.KeyMetadata.KeyId,
+		})
+		if aliasErr != nil {
+			c.logger.With("alias", keyID, "key_id", *out.KeyMetadata.KeyId).WithError(aliasErr).Error("failed to restore the alias of a key failing to rotate")
+			return nil, err
+		}
+
+		c.deleteOrphanKey(*out.KeyMetadata.KeyId)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// deleteOrphanKey schedules the deletion of a key created by a failed rotation, that no alias targets
+func (c *AWSClient) deleteOrphanKey(keyID string) {
+	input := &kms.ScheduleKeyDeletionInput{
+		KeyId: &keyID,
+	}
+	if c.cfg.DeletionWindowDays > 0 {
+		input.PendingWindowInDays = &c.cfg.DeletionWindowDays
+	}
+
+	_, err := c.kmsClient.ScheduleKeyDeletion(input)
+	if err != nil {
+		c.logger.With("key_id", keyID).WithError(parseKmsErrorResponse(err)).Error("failed to schedule the deletion of a key created by a failed rotation")
+	}
+}
+
 func (c *AWSClient) GetPublicKey(_ context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
 	out, err := c.kmsClient.GetPublicKey(&kms.GetPublicKeyInput{
 		KeyId: &keyID,
@@ -140,10 +271,41 @@ func (c *AWSClient) Sign(_ context.Context, keyID string, msg []byte, signingAlg
 	return out, nil
 }
 
+func (c *AWSClient) Encrypt(_ context.Context, keyID string, plaintext []byte, encryptionAlgorithm string) (*kms.EncryptOutput, error) {
+	out, err := c.kmsClient.Encrypt(&kms.EncryptInput{
+		KeyId:               &keyID,
+		Plaintext:           plaintext,
+		EncryptionAlgorithm: &encryptionAlgorithm,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+func (c *AWSClient) Decrypt(_ context.Context, keyID string, ciphertext []byte, encryptionAlgorithm string) (*kms.DecryptOutput, error) {
+	out, err := c.kmsClient.Decrypt(&kms.DecryptInput{
+		KeyId:               &keyID,
+		CiphertextBlob:      ciphertext,
+		EncryptionAlgorithm: &encryptionAlgorithm,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	return out, nil
+}
+
 func (c *AWSClient) DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleKeyDeletionOutput, error) {
-	out, err := c.kmsClient.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+	input := &kms.ScheduleKeyDeletionInput{
 		KeyId: &keyID,
-	})
+	}
+	if c.cfg.DeletionWindowDays > 0 {
+		input.PendingWindowInDays = &c.cfg.DeletionWindowDays
+	}
+
+	out, err := c.kmsClient.ScheduleKeyDeletion(input)
 	if err != nil {
 		return nil, parseKmsErrorResponse(err)
 	}
@@ -224,6 +386,20 @@ func (c *AWSClient) UntagResource(_ context.Context, keyID string, tagKeys []*st
 	return outUntagResource, nil
 }
 
+func wrapKeyMaterial(wrappingKey, keyMaterial []byte) ([]byte, error) {
+	pubKey, err := x509.ParsePKIXPublicKey(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaPubKey, ok := pubKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("wrapping key is not an RSA public key")
+	}
+
+	return rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPubKey, keyMaterial, nil)
+}
+
 func (c *AWSClient) waitKeyState(ctx context.Context, keyID string, stateCheck func(metadata *kms.KeyMetadata) error) error {
 	return backoff.RetryNotify(func() error {
 		descData, err := c.DescribeKey(ctx, keyID)
@@ -202,6 +202,36 @@ func (mr *MockClientMockRecorder) CreateKey(ctx, id, keyType, tags interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockClient)(nil).CreateKey), ctx, id, keyType, tags)
 }
 
+// ImportKey mocks base method
+func (m *MockClient) ImportKey(ctx context.Context, id, keyType string, keyMaterial []byte, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKey", ctx, id, keyType, keyMaterial, tags)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKey indicates an expected call of ImportKey
+func (mr *MockClientMockRecorder) ImportKey(ctx, id, keyType, keyMaterial, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockClient)(nil).ImportKey), ctx, id, keyType, keyMaterial, tags)
+}
+
+// RotateKey mocks base method
+func (m *MockClient) RotateKey(ctx context.Context, id, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, id, keyType, tags)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey
+func (mr *MockClientMockRecorder) RotateKey(ctx, id, keyType, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockClient)(nil).RotateKey), ctx, id, keyType, tags)
+}
+
 // GetPublicKey mocks base method
 func (m *MockClient) GetPublicKey(ctx context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +307,36 @@ func (mr *MockClientMockRecorder) Sign(ctx, keyID, msg, signingAlgorithm interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockClient)(nil).Sign), ctx, keyID, msg, signingAlgorithm)
 }
 
+// Encrypt mocks base method
+func (m *MockClient) Encrypt(ctx context.Context, keyID string, plaintext []byte, encryptionAlgorithm string) (*kms.EncryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, keyID, plaintext, encryptionAlgorithm)
+	ret0, _ := ret[0].(*kms.EncryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt
+func (mr *MockClientMockRecorder) Encrypt(ctx, keyID, plaintext, encryptionAlgorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockClient)(nil).Encrypt), ctx, keyID, plaintext, encryptionAlgorithm)
+}
+
+// Decrypt mocks base method
+func (m *MockClient) Decrypt(ctx context.Context, keyID string, ciphertext []byte, encryptionAlgorithm string) (*kms.DecryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, keyID, ciphertext, encryptionAlgorithm)
+	ret0, _ := ret[0].(*kms.DecryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt
+func (mr *MockClientMockRecorder) Decrypt(ctx, keyID, ciphertext, encryptionAlgorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockClient)(nil).Decrypt), ctx, keyID, ciphertext, encryptionAlgorithm)
+}
+
 // DeleteKey mocks base method
 func (m *MockClient) DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleKeyDeletionOutput, error) {
 	m.ctrl.T.Helper()
@@ -564,6 +624,36 @@ func (mr *MockKmsClientMockRecorder) CreateKey(ctx, id, keyType, tags interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockKmsClient)(nil).CreateKey), ctx, id, keyType, tags)
 }
 
+// ImportKey mocks base method
+func (m *MockKmsClient) ImportKey(ctx context.Context, id, keyType string, keyMaterial []byte, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKey", ctx, id, keyType, keyMaterial, tags)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKey indicates an expected call of ImportKey
+func (mr *MockKmsClientMockRecorder) ImportKey(ctx, id, keyType, keyMaterial, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockKmsClient)(nil).ImportKey), ctx, id, keyType, keyMaterial, tags)
+}
+
+// RotateKey mocks base method
+func (m *MockKmsClient) RotateKey(ctx context.Context, id, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, id, keyType, tags)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey
+func (mr *MockKmsClientMockRecorder) RotateKey(ctx, id, keyType, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockKmsClient)(nil).RotateKey), ctx, id, keyType, tags)
+}
+
 // GetPublicKey mocks base method
 func (m *MockKmsClient) GetPublicKey(ctx context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
 	m.ctrl.T.Helper()
@@ -639,6 +729,36 @@ func (mr *MockKmsClientMockRecorder) Sign(ctx, keyID, msg, signingAlgorithm inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockKmsClient)(nil).Sign), ctx, keyID, msg, signingAlgorithm)
 }
 
+// Encrypt mocks base method
+func (m *MockKmsClient) Encrypt(ctx context.Context, keyID string, plaintext []byte, encryptionAlgorithm string) (*kms.EncryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, keyID, plaintext, encryptionAlgorithm)
+	ret0, _ := ret[0].(*kms.EncryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt
+func (mr *MockKmsClientMockRecorder) Encrypt(ctx, keyID, plaintext, encryptionAlgorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockKmsClient)(nil).Encrypt), ctx, keyID, plaintext, encryptionAlgorithm)
+}
+
+// Decrypt mocks base method
+func (m *MockKmsClient) Decrypt(ctx context.Context, keyID string, ciphertext []byte, encryptionAlgorithm string) (*kms.DecryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, keyID, ciphertext, encryptionAlgorithm)
+	ret0, _ := ret[0].(*kms.DecryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt
+func (mr *MockKmsClientMockRecorder) Decrypt(ctx, keyID, ciphertext, encryptionAlgorithm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKmsClient)(nil).Decrypt), ctx, keyID, ciphertext, encryptionAlgorithm)
+}
+
 // DeleteKey mocks base method
 func (m *MockKmsClient) DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleKeyDeletionOutput, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"crypto/tls"
+
+	gcpinfra "github.com/consensys/quorum-key-manager/src/infra/gcp"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type GCPClient struct {
+	kmsClient     kmspb.KeyManagementServiceClient
+	secretsClient secretmanagerpb.SecretManagerServiceClient
+	cfg           *Config
+	logger        log.Logger
+}
+
+var _ gcpinfra.Client = &GCPClient{}
+
+func New(cfg *Config, logger log.Logger) (*GCPClient, error) {
+	tokenSource, err := cfg.tokenSource()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})),
+		grpc.WithPerRPCCredentials(&tokenCredentials{tokenSource: tokenSource}),
+	}
+
+	kmsConn, err := grpc.Dial(cfg.KMSEndpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	secretsConn, err := grpc.Dial(cfg.SecretManagerEndpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(kmsConn, secretsConn, cfg, logger), nil
+}
+
+func newClient(kmsConn, secretsConn grpc.ClientConnInterface, cfg *Config, logger log.Logger) *GCPClient {
+	return &GCPClient{
+		kmsClient:     kmspb.NewKeyManagementServiceClient(kmsConn),
+		secretsClient: secretmanagerpb.NewSecretManagerServiceClient(secretsConn),
+		cfg:           cfg,
+		logger:        logger,
+	}
+}
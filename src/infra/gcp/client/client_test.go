@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/gcp/testutils"
+	testutils2 "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+)
+
+func TestGCPClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	conn := testutils.NewFakeServerConn(t, testutils.NewFakeKMSServer(), testutils.NewFakeSecretManagerServer())
+	cfg := NewConfig(&entities.GCPConfig{Project: "my-project", KeyRing: "my-key-ring"})
+	cli := newClient(conn, conn, cfg, testutils2.NewMockLogger(ctrl))
+
+	t.Run("should create keys and list them", func(t *testing.T) {
+		key, err := cli.CreateKey(ctx, "my-key", kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256, map[string]string{"tag": "1"})
+		require.NoError(t, err)
+		assert.Equal(t, "projects/my-project/locations/global/keyRings/my-key-ring/cryptoKeys/my-key", key.Name)
+
+		_, err = cli.CreateKey(ctx, "my-key-2", kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256, nil)
+		require.NoError(t, err)
+
+		keys, err := cli.ListKeys(ctx)
+		require.NoError(t, err)
+		assert.Len(t, keys, 2)
+
+		_, err = cli.CreateKey(ctx, "my-key", kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256, nil)
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should sign with a key version and verify with its public key", func(t *testing.T) {
+		digest := sha256.Sum256([]byte("my data"))
+
+		signature, err := cli.Sign(ctx, "my-key", "1", digest[:])
+		require.NoError(t, err)
+
+		pubKey, err := cli.GetPublicKey(ctx, "my-key", "1")
+		require.NoError(t, err)
+
+		block, _ := pem.Decode([]byte(pubKey.Pem))
+		parsedKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		require.NoError(t, err)
+		assert.True(t, ecdsa.VerifyASN1(parsedKey.(*ecdsa.PublicKey), digest[:], signature))
+
+		_, err = cli.Sign(ctx, "my-key", "1", []byte("not a digest"))
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should create key versions and update labels", func(t *testing.T) {
+		version, err := cli.CreateKeyVersion(ctx, "my-key")
+		require.NoError(t, err)
+		assert.Equal(t, kmspb.CryptoKeyVersion_ENABLED, version.State)
+
+		versions, err := cli.ListKeyVersions(ctx, "my-key")
+		require.NoError(t, err)
+		assert.Len(t, versions, 2)
+
+		key, err := cli.UpdateKeyLabels(ctx, "my-key", map[string]string{"tag": "2"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"tag": "2"}, key.Labels)
+	})
+
+	t.Run("should destroy and restore key versions", func(t *testing.T) {
+		version, err := cli.DestroyKeyVersion(ctx, "my-key", "2")
+		require.NoError(t, err)
+		assert.Equal(t, kmspb.CryptoKeyVersion_DESTROY_SCHEDULED, version.State)
+
+		_, err = cli.GetPublicKey(ctx, "my-key", "2")
+		assert.True(t, errors.IsStatusConflictError(err))
+
+		version, err = cli.RestoreKeyVersion(ctx, "my-key", "2")
+		require.NoError(t, err)
+		assert.Equal(t, kmspb.CryptoKeyVersion_ENABLED, version.State)
+	})
+
+	t.Run("should fail with NotFound error if the key does not exist", func(t *testing.T) {
+		_, err := cli.GetKey(ctx, "inexistent-key")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should create secrets, add versions and access them", func(t *testing.T) {
+		_, err := cli.CreateSecret(ctx, "my-secret", map[string]string{"tag": "1"})
+		require.NoError(t, err)
+
+		_, err = cli.AddSecretVersion(ctx, "my-secret", []byte("value-1"))
+		require.NoError(t, err)
+		_, err = cli.AddSecretVersion(ctx, "my-secret", []byte("value-2"))
+		require.NoError(t, err)
+
+		value, err := cli.AccessSecretVersion(ctx, "my-secret", "")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value-2"), value)
+
+		value, err = cli.AccessSecretVersion(ctx, "my-secret", "1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value-1"), value)
+
+		version, err := cli.GetSecretVersion(ctx, "my-secret", "")
+		require.NoError(t, err)
+		assert.Equal(t, "projects/my-project/secrets/my-secret/versions/2", version.Name)
+
+		secrets, err := cli.ListSecrets(ctx)
+		require.NoError(t, err)
+		assert.Len(t, secrets, 1)
+	})
+
+	t.Run("should update secret labels and delete the secret", func(t *testing.T) {
+		secret, err := cli.UpdateSecretLabels(ctx, "my-secret", map[string]string{"tag": "2"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"tag": "2"}, secret.Labels)
+
+		err = cli.DeleteSecret(ctx, "my-secret")
+		require.NoError(t, err)
+
+		_, err = cli.GetSecret(ctx, "my-secret")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
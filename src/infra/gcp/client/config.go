@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+const (
+	defaultLocation              = "global"
+	defaultKMSEndpoint           = "cloudkms.googleapis.com:443"
+	defaultSecretManagerEndpoint = "secretmanager.googleapis.com:443"
+)
+
+type Config struct {
+	Project               string
+	Location              string
+	KeyRing               string
+	CredentialsPath       string
+	KMSEndpoint           string
+	SecretManagerEndpoint string
+}
+
+func NewConfig(cfg *entities.GCPConfig) *Config {
+	config := &Config{
+		Project:               cfg.Project,
+		Location:              cfg.Location,
+		KeyRing:               cfg.KeyRing,
+		CredentialsPath:       cfg.CredentialsPath,
+		KMSEndpoint:           cfg.KMSEndpoint,
+		SecretManagerEndpoint: cfg.SecretManagerEndpoint,
+	}
+
+	if config.Location == "" {
+		config.Location = defaultLocation
+	}
+	if config.KMSEndpoint == "" {
+		config.KMSEndpoint = defaultKMSEndpoint
+	}
+	if config.SecretManagerEndpoint == "" {
+		config.SecretManagerEndpoint = defaultSecretManagerEndpoint
+	}
+
+	return config
+}
+
+func (c *Config) projectName() string {
+	return fmt.Sprintf("projects/%s", c.Project)
+}
+
+func (c *Config) keyRingName() string {
+	return fmt.Sprintf("projects/%s/locations/%s/keyRings/%s", c.Project, c.Location, c.KeyRing)
+}
+
+func (c *Config) keyName(id string) string {
+	return fmt.Sprintf("%s/cryptoKeys/%s", c.keyRingName(), id)
+}
+
+func (c *Config) keyVersionName(id, version string) string {
+	return fmt.Sprintf("%s/cryptoKeyVersions/%s", c.keyName(id), version)
+}
+
+func (c *Config) secretName(id string) string {
+	return fmt.Sprintf("%s/secrets/%s", c.projectName(), id)
+}
+
+func (c *Config) secretVersionName(id, version string) string {
+	return fmt.Sprintf("%s/versions/%s", c.secretName(id), version)
+}
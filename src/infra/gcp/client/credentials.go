@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// tokenSource uses the credentials file if configured, the application default credentials otherwise
+// (GOOGLE_APPLICATION_CREDENTIALS, gcloud user credentials, workload identity federation or the metadata server)
+func (c *Config) tokenSource() (oauth2.TokenSource, error) {
+	ctx := context.Background()
+
+	if c.CredentialsPath == "" {
+		return &defaultTokenSource{}, nil
+	}
+
+	data, err := ioutil.ReadFile(c.CredentialsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file (%s): %v", c.CredentialsPath, err)
+	}
+
+	creds, err := google.CredentialsFromJSON(ctx, data, cloudPlatformScope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file (%s): %v", c.CredentialsPath, err)
+	}
+
+	return creds.TokenSource, nil
+}
+
+// defaultTokenSource resolves the application default credentials on first use, so that the client can be created
+// before they are available (metadata server not reachable yet) like the other cloud vaults
+type defaultTokenSource struct {
+	tokenSource oauth2.TokenSource
+	mux         sync.Mutex
+}
+
+func (s *defaultTokenSource) Token() (*oauth2.Token, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.tokenSource == nil {
+		creds, err := google.FindDefaultCredentials(context.Background(), cloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find default credentials: %v", err)
+		}
+
+		s.tokenSource = creds.TokenSource
+	}
+
+	return s.tokenSource.Token()
+}
+
+// tokenCredentials sets the OAuth2 access token of each gRPC call
+type tokenCredentials struct {
+	tokenSource oauth2.TokenSource
+}
+
+func (c *tokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		"authorization": token.Type() + " " + token.AccessToken,
+	}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
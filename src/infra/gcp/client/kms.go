@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	// Max wait of 10 seconds for Cloud KMS to generate key versions
+	keyGenerationRetryInterval = time.Second
+	keyGenerationMaxRetries    = 10
+)
+
+// CreateKey creates an asymmetric signing key protected by Cloud HSM, required by secp256k1 keys
+func (c *GCPClient) CreateKey(ctx context.Context, id string, algorithm kmspb.CryptoKeyVersion_CryptoKeyVersionAlgorithm, labels map[string]string) (*kmspb.CryptoKey, error) {
+	key, err := c.kmsClient.CreateCryptoKey(ctx, &kmspb.CreateCryptoKeyRequest{
+		Parent:      c.cfg.keyRingName(),
+		CryptoKeyId: id,
+		CryptoKey: &kmspb.CryptoKey{
+			Purpose: kmspb.CryptoKey_ASYMMETRIC_SIGN,
+			VersionTemplate: &kmspb.CryptoKeyVersionTemplate{
+				ProtectionLevel: kmspb.ProtectionLevel_HSM,
+				Algorithm:       algorithm,
+			},
+			Labels: labels,
+		},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	// The first version of an asymmetric key is generated asynchronously
+	err = c.waitKeyVersionEnabled(ctx, id, "1")
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (c *GCPClient) GetKey(ctx context.Context, id string) (*kmspb.CryptoKey, error) {
+	key, err := c.kmsClient.GetCryptoKey(ctx, &kmspb.GetCryptoKeyRequest{Name: c.cfg.keyName(id)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return key, nil
+}
+
+func (c *GCPClient) ListKeys(ctx context.Context) ([]*kmspb.CryptoKey, error) {
+	var keys []*kmspb.CryptoKey
+	pageToken := ""
+
+	for {
+		out, err := c.kmsClient.ListCryptoKeys(ctx, &kmspb.ListCryptoKeysRequest{
+			Parent:    c.cfg.keyRingName(),
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, parseErrorResponse(err)
+		}
+
+		keys = append(keys, out.CryptoKeys...)
+		if out.NextPageToken == "" {
+			break
+		}
+		pageToken = out.NextPageToken
+	}
+
+	return keys, nil
+}
+
+func (c *GCPClient) UpdateKeyLabels(ctx context.Context, id string, labels map[string]string) (*kmspb.CryptoKey, error) {
+	key, err := c.kmsClient.UpdateCryptoKey(ctx, &kmspb.UpdateCryptoKeyRequest{
+		CryptoKey: &kmspb.CryptoKey{
+			Name:   c.cfg.keyName(id),
+			Labels: labels,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return key, nil
+}
+
+func (c *GCPClient) CreateKeyVersion(ctx context.Context, id string) (*kmspb.CryptoKeyVersion, error) {
+	version, err := c.kmsClient.CreateCryptoKeyVersion(ctx, &kmspb.CreateCryptoKeyVersionRequest{
+		Parent:           c.cfg.keyName(id),
+		CryptoKeyVersion: &kmspb.CryptoKeyVersion{},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	err = c.waitKeyVersionEnabled(ctx, id, path.Base(version.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func (c *GCPClient) GetKeyVersion(ctx context.Context, id, version string) (*kmspb.CryptoKeyVersion, error) {
+	out, err := c.kmsClient.GetCryptoKeyVersion(ctx, &kmspb.GetCryptoKeyVersionRequest{Name: c.cfg.keyVersionName(id, version)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+func (c *GCPClient) ListKeyVersions(ctx context.Context, id string) ([]*kmspb.CryptoKeyVersion, error) {
+	var versions []*kmspb.CryptoKeyVersion
+	pageToken := ""
+
+	for {
+		out, err := c.kmsClient.ListCryptoKeyVersions(ctx, &kmspb.ListCryptoKeyVersionsRequest{
+			Parent:    c.cfg.keyName(id),
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, parseErrorResponse(err)
+		}
+
+		versions = append(versions, out.CryptoKeyVersions...)
+		if out.NextPageToken == "" {
+			break
+		}
+		pageToken = out.NextPageToken
+	}
+
+	return versions, nil
+}
+
+// DestroyKeyVersion schedules the destruction of a key version, it can be restored until the end of the destruction period of the key
+func (c *GCPClient) DestroyKeyVersion(ctx context.Context, id, version string) (*kmspb.CryptoKeyVersion, error) {
+	out, err := c.kmsClient.DestroyCryptoKeyVersion(ctx, &kmspb.DestroyCryptoKeyVersionRequest{Name: c.cfg.keyVersionName(id, version)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+// RestoreKeyVersion cancels the scheduled destruction of a key version and enables it
+func (c *GCPClient) RestoreKeyVersion(ctx context.Context, id, version string) (*kmspb.CryptoKeyVersion, error) {
+	name := c.cfg.keyVersionName(id, version)
+
+	_, err := c.kmsClient.RestoreCryptoKeyVersion(ctx, &kmspb.RestoreCryptoKeyVersionRequest{Name: name})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	// Restored versions are disabled
+	out, err := c.kmsClient.UpdateCryptoKeyVersion(ctx, &kmspb.UpdateCryptoKeyVersionRequest{
+		CryptoKeyVersion: &kmspb.CryptoKeyVersion{
+			Name:  name,
+			State: kmspb.CryptoKeyVersion_ENABLED,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+func (c *GCPClient) GetPublicKey(ctx context.Context, id, version string) (*kmspb.PublicKey, error) {
+	out, err := c.kmsClient.GetPublicKey(ctx, &kmspb.GetPublicKeyRequest{Name: c.cfg.keyVersionName(id, version)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+// Sign signs a SHA-256 digest, returning the DER encoded signature
+func (c *GCPClient) Sign(ctx context.Context, id, version string, digest []byte) ([]byte, error) {
+	out, err := c.kmsClient.AsymmetricSign(ctx, &kmspb.AsymmetricSignRequest{
+		Name: c.cfg.keyVersionName(id, version),
+		Digest: &kmspb.Digest{
+			Digest: &kmspb.Digest_Sha256{Sha256: digest},
+		},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out.Signature, nil
+}
+
+func (c *GCPClient) waitKeyVersionEnabled(ctx context.Context, id, version string) error {
+	return backoff.RetryNotify(func() error {
+		out, err := c.GetKeyVersion(ctx, id, version)
+		if err != nil {
+			return err
+		}
+
+		switch out.State {
+		case kmspb.CryptoKeyVersion_ENABLED:
+			return nil
+		case kmspb.CryptoKeyVersion_PENDING_GENERATION:
+			return errors.StatusConflictError("key %s version %s is still being generated", id, version)
+		default:
+			return backoff.Permanent(errors.GCPError("key %s version %s could not be enabled, state %s", id, version, out.State))
+		}
+	}, backoff.WithContext(backoff.WithMaxRetries(backoff.NewConstantBackOff(keyGenerationRetryInterval), keyGenerationMaxRetries), ctx),
+		func(err error, t time.Duration) {
+			c.logger.Debug(fmt.Sprintf("ERR: %s, retrying in %s", err.Error(), t.String()))
+		},
+	)
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func parseErrorResponse(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.GCPError(err.Error())
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errors.NotFoundError(st.Message())
+	case codes.AlreadyExists:
+		return errors.AlreadyExistsError(st.Message())
+	case codes.InvalidArgument, codes.OutOfRange:
+		return errors.InvalidParameterError(st.Message())
+	case codes.FailedPrecondition:
+		return errors.StatusConflictError(st.Message())
+	case codes.ResourceExhausted:
+		return errors.TooManyRequestError(st.Message())
+	default:
+		return errors.GCPError(st.Message())
+	}
+}
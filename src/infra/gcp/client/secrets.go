@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const latestVersion = "latest"
+
+// CreateSecret creates a secret with automatic replication and no version
+func (c *GCPClient) CreateSecret(ctx context.Context, id string, labels map[string]string) (*secretmanagerpb.Secret, error) {
+	out, err := c.secretsClient.CreateSecret(ctx, &secretmanagerpb.CreateSecretRequest{
+		Parent:   c.cfg.projectName(),
+		SecretId: id,
+		Secret: &secretmanagerpb.Secret{
+			Replication: &secretmanagerpb.Replication{
+				Replication: &secretmanagerpb.Replication_Automatic_{Automatic: &secretmanagerpb.Replication_Automatic{}},
+			},
+			Labels: labels,
+		},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+func (c *GCPClient) GetSecret(ctx context.Context, id string) (*secretmanagerpb.Secret, error) {
+	out, err := c.secretsClient.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{Name: c.cfg.secretName(id)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+func (c *GCPClient) ListSecrets(ctx context.Context) ([]*secretmanagerpb.Secret, error) {
+	var secrets []*secretmanagerpb.Secret
+	pageToken := ""
+
+	for {
+		out, err := c.secretsClient.ListSecrets(ctx, &secretmanagerpb.ListSecretsRequest{
+			Parent:    c.cfg.projectName(),
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, parseErrorResponse(err)
+		}
+
+		secrets = append(secrets, out.Secrets...)
+		if out.NextPageToken == "" {
+			break
+		}
+		pageToken = out.NextPageToken
+	}
+
+	return secrets, nil
+}
+
+func (c *GCPClient) UpdateSecretLabels(ctx context.Context, id string, labels map[string]string) (*secretmanagerpb.Secret, error) {
+	out, err := c.secretsClient.UpdateSecret(ctx, &secretmanagerpb.UpdateSecretRequest{
+		Secret: &secretmanagerpb.Secret{
+			Name:   c.cfg.secretName(id),
+			Labels: labels,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+// DeleteSecret permanently deletes a secret and all its versions
+func (c *GCPClient) DeleteSecret(ctx context.Context, id string) error {
+	_, err := c.secretsClient.DeleteSecret(ctx, &secretmanagerpb.DeleteSecretRequest{Name: c.cfg.secretName(id)})
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *GCPClient) AddSecretVersion(ctx context.Context, id string, value []byte) (*secretmanagerpb.SecretVersion, error) {
+	out, err := c.secretsClient.AddSecretVersion(ctx, &secretmanagerpb.AddSecretVersionRequest{
+		Parent:  c.cfg.secretName(id),
+		Payload: &secretmanagerpb.SecretPayload{Data: value},
+	})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+// GetSecretVersion gets the metadata of a version of a secret, the latest one if version is empty
+func (c *GCPClient) GetSecretVersion(ctx context.Context, id, version string) (*secretmanagerpb.SecretVersion, error) {
+	if version == "" {
+		version = latestVersion
+	}
+
+	out, err := c.secretsClient.GetSecretVersion(ctx, &secretmanagerpb.GetSecretVersionRequest{Name: c.cfg.secretVersionName(id, version)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+// AccessSecretVersion gets the value of a version of a secret, the latest one if version is empty
+func (c *GCPClient) AccessSecretVersion(ctx context.Context, id, version string) ([]byte, error) {
+	if version == "" {
+		version = latestVersion
+	}
+
+	out, err := c.secretsClient.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{Name: c.cfg.secretVersionName(id, version)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return out.Payload.Data, nil
+}
@@ -0,0 +1,42 @@
+package gcp
+
+import (
+	"context"
+
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+)
+
+//go:generate mockgen -source=gcp.go -destination=mocks/gcp.go -package=mocks
+
+type Client interface {
+	KmsClient
+	SecretManagerClient
+}
+
+// KmsClient manages the crypto keys of a Cloud KMS key ring, keys and versions being identified by their short IDs
+type KmsClient interface {
+	CreateKey(ctx context.Context, id string, algorithm kmspb.CryptoKeyVersion_CryptoKeyVersionAlgorithm, labels map[string]string) (*kmspb.CryptoKey, error)
+	GetKey(ctx context.Context, id string) (*kmspb.CryptoKey, error)
+	ListKeys(ctx context.Context) ([]*kmspb.CryptoKey, error)
+	UpdateKeyLabels(ctx context.Context, id string, labels map[string]string) (*kmspb.CryptoKey, error)
+	CreateKeyVersion(ctx context.Context, id string) (*kmspb.CryptoKeyVersion, error)
+	GetKeyVersion(ctx context.Context, id, version string) (*kmspb.CryptoKeyVersion, error)
+	ListKeyVersions(ctx context.Context, id string) ([]*kmspb.CryptoKeyVersion, error)
+	DestroyKeyVersion(ctx context.Context, id, version string) (*kmspb.CryptoKeyVersion, error)
+	RestoreKeyVersion(ctx context.Context, id, version string) (*kmspb.CryptoKeyVersion, error)
+	GetPublicKey(ctx context.Context, id, version string) (*kmspb.PublicKey, error)
+	Sign(ctx context.Context, id, version string, digest []byte) ([]byte, error)
+}
+
+// SecretManagerClient manages the secrets of a project in Secret Manager
+type SecretManagerClient interface {
+	CreateSecret(ctx context.Context, id string, labels map[string]string) (*secretmanagerpb.Secret, error)
+	GetSecret(ctx context.Context, id string) (*secretmanagerpb.Secret, error)
+	ListSecrets(ctx context.Context) ([]*secretmanagerpb.Secret, error)
+	UpdateSecretLabels(ctx context.Context, id string, labels map[string]string) (*secretmanagerpb.Secret, error)
+	DeleteSecret(ctx context.Context, id string) error
+	AddSecretVersion(ctx context.Context, id string, value []byte) (*secretmanagerpb.SecretVersion, error)
+	GetSecretVersion(ctx context.Context, id, version string) (*secretmanagerpb.SecretVersion, error)
+	AccessSecretVersion(ctx context.Context, id, version string) ([]byte, error)
+}
@@ -0,0 +1,323 @@
+package testutils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FakeKMSServer is an in-memory Cloud KMS supporting asymmetric signing keys.
+// All key versions are P-256 keys, whatever the requested algorithm, and are enabled as soon as they are created
+type FakeKMSServer struct {
+	kmspb.UnimplementedKeyManagementServiceServer
+
+	mux      sync.Mutex
+	keys     map[string]*kmspb.CryptoKey
+	versions map[string][]*fakeKeyVersion
+}
+
+type fakeKeyVersion struct {
+	version *kmspb.CryptoKeyVersion
+	privKey *ecdsa.PrivateKey
+}
+
+func NewFakeKMSServer() *FakeKMSServer {
+	return &FakeKMSServer{
+		keys:     make(map[string]*kmspb.CryptoKey),
+		versions: make(map[string][]*fakeKeyVersion),
+	}
+}
+
+func (s *FakeKMSServer) CreateCryptoKey(_ context.Context, req *kmspb.CreateCryptoKeyRequest) (*kmspb.CryptoKey, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	name := fmt.Sprintf("%s/cryptoKeys/%s", req.Parent, req.CryptoKeyId)
+	if _, ok := s.keys[name]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "crypto key %s already exists", name)
+	}
+
+	key := &kmspb.CryptoKey{
+		Name:            name,
+		Purpose:         req.CryptoKey.Purpose,
+		VersionTemplate: req.CryptoKey.VersionTemplate,
+		Labels:          req.CryptoKey.Labels,
+		CreateTime:      timestamppb.Now(),
+	}
+	s.keys[name] = key
+
+	if !req.SkipInitialVersionCreation {
+		_, err := s.addVersion(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+func (s *FakeKMSServer) GetCryptoKey(_ context.Context, req *kmspb.GetCryptoKeyRequest) (*kmspb.CryptoKey, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.getKey(req.Name)
+}
+
+// ListCryptoKeys returns one key per page to exercise pagination
+func (s *FakeKMSServer) ListCryptoKeys(_ context.Context, req *kmspb.ListCryptoKeysRequest) (*kmspb.ListCryptoKeysResponse, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var names []string
+	for name := range s.keys {
+		if strings.HasPrefix(name, req.Parent+"/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		if req.PageToken != "" && name <= req.PageToken {
+			continue
+		}
+
+		res := &kmspb.ListCryptoKeysResponse{CryptoKeys: []*kmspb.CryptoKey{s.keys[name]}}
+		if i < len(names)-1 {
+			res.NextPageToken = name
+		}
+		return res, nil
+	}
+
+	return &kmspb.ListCryptoKeysResponse{}, nil
+}
+
+func (s *FakeKMSServer) UpdateCryptoKey(_ context.Context, req *kmspb.UpdateCryptoKeyRequest) (*kmspb.CryptoKey, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	key, err := s.getKey(req.CryptoKey.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range req.UpdateMask.GetPaths() {
+		if p != "labels" {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path %s", p)
+		}
+		key.Labels = req.CryptoKey.Labels
+	}
+
+	return key, nil
+}
+
+func (s *FakeKMSServer) CreateCryptoKeyVersion(_ context.Context, req *kmspb.CreateCryptoKeyVersionRequest) (*kmspb.CryptoKeyVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	key, err := s.getKey(req.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.addVersion(key)
+}
+
+func (s *FakeKMSServer) GetCryptoKeyVersion(_ context.Context, req *kmspb.GetCryptoKeyVersionRequest) (*kmspb.CryptoKeyVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getVersion(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.version, nil
+}
+
+func (s *FakeKMSServer) ListCryptoKeyVersions(_ context.Context, req *kmspb.ListCryptoKeyVersionsRequest) (*kmspb.ListCryptoKeyVersionsResponse, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, err := s.getKey(req.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &kmspb.ListCryptoKeyVersionsResponse{}
+	for _, v := range s.versions[req.Parent] {
+		res.CryptoKeyVersions = append(res.CryptoKeyVersions, v.version)
+	}
+
+	return res, nil
+}
+
+func (s *FakeKMSServer) UpdateCryptoKeyVersion(_ context.Context, req *kmspb.UpdateCryptoKeyVersionRequest) (*kmspb.CryptoKeyVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getVersion(req.CryptoKeyVersion.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range req.UpdateMask.GetPaths() {
+		if p != "state" {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path %s", p)
+		}
+
+		switch {
+		case v.version.State == kmspb.CryptoKeyVersion_DESTROY_SCHEDULED:
+			return nil, status.Errorf(codes.FailedPrecondition, "crypto key version %s is scheduled for destruction", v.version.Name)
+		case req.CryptoKeyVersion.State != kmspb.CryptoKeyVersion_ENABLED && req.CryptoKeyVersion.State != kmspb.CryptoKeyVersion_DISABLED:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid state %s", req.CryptoKeyVersion.State)
+		}
+		v.version.State = req.CryptoKeyVersion.State
+	}
+
+	return v.version, nil
+}
+
+func (s *FakeKMSServer) DestroyCryptoKeyVersion(_ context.Context, req *kmspb.DestroyCryptoKeyVersionRequest) (*kmspb.CryptoKeyVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getVersion(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.version.State != kmspb.CryptoKeyVersion_ENABLED && v.version.State != kmspb.CryptoKeyVersion_DISABLED {
+		return nil, status.Errorf(codes.FailedPrecondition, "crypto key version %s cannot be destroyed in state %s", req.Name, v.version.State)
+	}
+
+	v.version.State = kmspb.CryptoKeyVersion_DESTROY_SCHEDULED
+	v.version.DestroyTime = timestamppb.Now()
+	return v.version, nil
+}
+
+func (s *FakeKMSServer) RestoreCryptoKeyVersion(_ context.Context, req *kmspb.RestoreCryptoKeyVersionRequest) (*kmspb.CryptoKeyVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getVersion(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.version.State != kmspb.CryptoKeyVersion_DESTROY_SCHEDULED {
+		return nil, status.Errorf(codes.FailedPrecondition, "crypto key version %s is not scheduled for destruction", req.Name)
+	}
+
+	v.version.State = kmspb.CryptoKeyVersion_DISABLED
+	v.version.DestroyTime = nil
+	return v.version, nil
+}
+
+func (s *FakeKMSServer) GetPublicKey(_ context.Context, req *kmspb.GetPublicKeyRequest) (*kmspb.PublicKey, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getEnabledVersion(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&v.privKey.PublicKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &kmspb.PublicKey{
+		Name:      req.Name,
+		Pem:       string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		Algorithm: v.version.Algorithm,
+	}, nil
+}
+
+func (s *FakeKMSServer) AsymmetricSign(_ context.Context, req *kmspb.AsymmetricSignRequest) (*kmspb.AsymmetricSignResponse, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getEnabledVersion(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := req.Digest.GetSha256()
+	if len(digest) != sha256.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "digest must be a SHA-256 digest")
+	}
+
+	signature, err := ecdsa.SignASN1(rand.Reader, v.privKey, digest)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &kmspb.AsymmetricSignResponse{Name: req.Name, Signature: signature}, nil
+}
+
+func (s *FakeKMSServer) getKey(name string) (*kmspb.CryptoKey, error) {
+	key, ok := s.keys[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "crypto key %s not found", name)
+	}
+
+	return key, nil
+}
+
+func (s *FakeKMSServer) getVersion(name string) (*fakeKeyVersion, error) {
+	for _, v := range s.versions[path.Dir(path.Dir(name))] {
+		if v.version.Name == name {
+			return v, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "crypto key version %s not found", name)
+}
+
+func (s *FakeKMSServer) getEnabledVersion(name string) (*fakeKeyVersion, error) {
+	v, err := s.getVersion(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.version.State != kmspb.CryptoKeyVersion_ENABLED {
+		return nil, status.Errorf(codes.FailedPrecondition, "crypto key version %s is not enabled", name)
+	}
+
+	return v, nil
+}
+
+func (s *FakeKMSServer) addVersion(key *kmspb.CryptoKey) (*kmspb.CryptoKeyVersion, error) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	version := &kmspb.CryptoKeyVersion{
+		Name:       fmt.Sprintf("%s/cryptoKeyVersions/%d", key.Name, len(s.versions[key.Name])+1),
+		State:      kmspb.CryptoKeyVersion_ENABLED,
+		CreateTime: timestamppb.Now(),
+	}
+	if key.VersionTemplate != nil {
+		version.Algorithm = key.VersionTemplate.Algorithm
+		version.ProtectionLevel = key.VersionTemplate.ProtectionLevel
+	}
+
+	s.versions[key.Name] = append(s.versions[key.Name], &fakeKeyVersion{version: version, privKey: privKey})
+	return version, nil
+}
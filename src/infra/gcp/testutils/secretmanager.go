@@ -0,0 +1,202 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FakeSecretManagerServer is an in-memory Secret Manager
+type FakeSecretManagerServer struct {
+	secretmanagerpb.UnimplementedSecretManagerServiceServer
+
+	mux      sync.Mutex
+	secrets  map[string]*secretmanagerpb.Secret
+	versions map[string][]*fakeSecretVersion
+}
+
+type fakeSecretVersion struct {
+	version *secretmanagerpb.SecretVersion
+	data    []byte
+}
+
+func NewFakeSecretManagerServer() *FakeSecretManagerServer {
+	return &FakeSecretManagerServer{
+		secrets:  make(map[string]*secretmanagerpb.Secret),
+		versions: make(map[string][]*fakeSecretVersion),
+	}
+}
+
+func (s *FakeSecretManagerServer) CreateSecret(_ context.Context, req *secretmanagerpb.CreateSecretRequest) (*secretmanagerpb.Secret, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if req.Secret.GetReplication() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "replication is required")
+	}
+
+	name := fmt.Sprintf("%s/secrets/%s", req.Parent, req.SecretId)
+	if _, ok := s.secrets[name]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "secret %s already exists", name)
+	}
+
+	secret := &secretmanagerpb.Secret{
+		Name:        name,
+		Replication: req.Secret.Replication,
+		Labels:      req.Secret.Labels,
+		CreateTime:  timestamppb.Now(),
+	}
+	s.secrets[name] = secret
+
+	return secret, nil
+}
+
+func (s *FakeSecretManagerServer) GetSecret(_ context.Context, req *secretmanagerpb.GetSecretRequest) (*secretmanagerpb.Secret, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.getSecret(req.Name)
+}
+
+// ListSecrets returns one secret per page to exercise pagination
+func (s *FakeSecretManagerServer) ListSecrets(_ context.Context, req *secretmanagerpb.ListSecretsRequest) (*secretmanagerpb.ListSecretsResponse, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var names []string
+	for name := range s.secrets {
+		if strings.HasPrefix(name, req.Parent+"/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		if req.PageToken != "" && name <= req.PageToken {
+			continue
+		}
+
+		res := &secretmanagerpb.ListSecretsResponse{Secrets: []*secretmanagerpb.Secret{s.secrets[name]}}
+		if i < len(names)-1 {
+			res.NextPageToken = name
+		}
+		return res, nil
+	}
+
+	return &secretmanagerpb.ListSecretsResponse{}, nil
+}
+
+func (s *FakeSecretManagerServer) UpdateSecret(_ context.Context, req *secretmanagerpb.UpdateSecretRequest) (*secretmanagerpb.Secret, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	secret, err := s.getSecret(req.Secret.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range req.UpdateMask.GetPaths() {
+		if p != "labels" {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path %s", p)
+		}
+		secret.Labels = req.Secret.Labels
+	}
+
+	return secret, nil
+}
+
+func (s *FakeSecretManagerServer) DeleteSecret(_ context.Context, req *secretmanagerpb.DeleteSecretRequest) (*emptypb.Empty, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, err := s.getSecret(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.secrets, req.Name)
+	delete(s.versions, req.Name)
+	return &emptypb.Empty{}, nil
+}
+
+func (s *FakeSecretManagerServer) AddSecretVersion(_ context.Context, req *secretmanagerpb.AddSecretVersionRequest) (*secretmanagerpb.SecretVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, err := s.getSecret(req.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &secretmanagerpb.SecretVersion{
+		Name:       fmt.Sprintf("%s/versions/%d", req.Parent, len(s.versions[req.Parent])+1),
+		State:      secretmanagerpb.SecretVersion_ENABLED,
+		CreateTime: timestamppb.Now(),
+	}
+	s.versions[req.Parent] = append(s.versions[req.Parent], &fakeSecretVersion{version: version, data: req.Payload.GetData()})
+
+	return version, nil
+}
+
+func (s *FakeSecretManagerServer) GetSecretVersion(_ context.Context, req *secretmanagerpb.GetSecretVersionRequest) (*secretmanagerpb.SecretVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getVersion(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.version, nil
+}
+
+func (s *FakeSecretManagerServer) AccessSecretVersion(_ context.Context, req *secretmanagerpb.AccessSecretVersionRequest) (*secretmanagerpb.AccessSecretVersionResponse, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	v, err := s.getVersion(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretmanagerpb.AccessSecretVersionResponse{
+		Name:    v.version.Name,
+		Payload: &secretmanagerpb.SecretPayload{Data: v.data},
+	}, nil
+}
+
+func (s *FakeSecretManagerServer) getSecret(name string) (*secretmanagerpb.Secret, error) {
+	secret, ok := s.secrets[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", name)
+	}
+
+	return secret, nil
+}
+
+// getVersion resolves the "latest" alias to the most recent version
+func (s *FakeSecretManagerServer) getVersion(name string) (*fakeSecretVersion, error) {
+	secretName := path.Dir(path.Dir(name))
+	versions := s.versions[secretName]
+
+	if path.Base(name) == "latest" && len(versions) > 0 {
+		return versions[len(versions)-1], nil
+	}
+
+	for _, v := range versions {
+		if v.version.Name == name {
+			return v, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "secret version %s not found", name)
+}
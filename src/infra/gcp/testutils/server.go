@@ -0,0 +1,44 @@
+package testutils
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufferSize = 1024 * 1024
+
+// NewFakeServerConn serves fake Cloud KMS and Secret Manager APIs in memory and returns a connection to them.
+// The server and the connection are closed at the end of the test
+func NewFakeServerConn(t *testing.T, kmsServer kmspb.KeyManagementServiceServer, secretManagerServer secretmanagerpb.SecretManagerServiceServer) *grpc.ClientConn {
+	listener := bufconn.Listen(bufferSize)
+
+	server := grpc.NewServer()
+	kmspb.RegisterKeyManagementServiceServer(server, kmsServer)
+	secretmanagerpb.RegisterSecretManagerServiceServer(server, secretManagerServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial fake GCP server: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+	})
+
+	return conn
+}
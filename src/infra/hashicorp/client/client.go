@@ -1,7 +1,10 @@
 package client
 
 import (
+	"path"
+
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/hashicorp"
 	"github.com/hashicorp/vault/api"
 )
@@ -11,6 +14,8 @@ const dataLabel = "data"
 type HashicorpVaultClient struct {
 	client     *api.Client
 	mountPoint string
+	engine     string
+	kvVersion  int
 }
 
 var _ hashicorp.Client = &HashicorpVaultClient{}
@@ -27,7 +32,17 @@ func NewClient(cfg *Config) (*HashicorpVaultClient, error) {
 
 	client.SetNamespace(cfg.Namespace)
 
-	return &HashicorpVaultClient{client: client, mountPoint: cfg.MountPoint}, nil
+	engine := cfg.Engine
+	if engine == "" {
+		engine = entities.HashicorpPluginEngine
+	}
+
+	return &HashicorpVaultClient{client: client, mountPoint: cfg.MountPoint, engine: engine, kvVersion: cfg.KVVersion}, nil
+}
+
+// Engine returns the secrets engine used for keys on the mount point
+func (c *HashicorpVaultClient) Engine() string {
+	return c.engine
 }
 
 func (c *HashicorpVaultClient) SetToken(token string) {
@@ -43,6 +58,19 @@ func (c *HashicorpVaultClient) UnwrapToken(token string) (*api.Secret, error) {
 	return secret, nil
 }
 
+func (c *HashicorpVaultClient) Login(mountPath string, data map[string]interface{}) (*api.Secret, error) {
+	secret, err := c.client.Logical().Write(path.Join("auth", mountPath, "login"), data)
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
+
+func (c *HashicorpVaultClient) NewLifetimeWatcher(secret *api.Secret) (*api.LifetimeWatcher, error) {
+	return c.client.NewLifetimeWatcher(&api.LifetimeWatcherInput{Secret: secret})
+}
+
 func (c *HashicorpVaultClient) HealthCheck() error {
 	resp, err := c.client.Sys().Health()
 	if err != nil {
@@ -18,6 +18,8 @@ import (
 // Config object that be converted into an api.Config later
 type Config struct {
 	MountPoint    string
+	Engine        string
+	KVVersion     int
 	Address       string
 	CACert        string
 	CAPath        string
@@ -47,6 +49,8 @@ func NewConfig(specs *entities.HashicorpConfig) *Config {
 		MaxRetries:    specs.MaxRetries,
 		SkipVerify:    specs.SkipVerify,
 		MountPoint:    specs.MountPoint,
+		Engine:        specs.Engine,
+		KVVersion:     specs.KVVersion,
 	}
 }
 
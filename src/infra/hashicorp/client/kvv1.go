@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func (c *HashicorpVaultClient) ReadKvv1Secret(id string) (*api.Secret, error) {
+	secret, err := c.client.Logical().Read(path.Join(c.mountPoint, id))
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
+
+func (c *HashicorpVaultClient) SetKvv1Secret(id string, data map[string]interface{}) error {
+	_, err := c.client.Logical().Write(path.Join(c.mountPoint, id), data)
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *HashicorpVaultClient) ListKvv1Secrets() (*api.Secret, error) {
+	secret, err := c.client.Logical().List(c.mountPoint)
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
+
+func (c *HashicorpVaultClient) DeleteKvv1Secret(id string) error {
+	_, err := c.client.Logical().Delete(path.Join(c.mountPoint, id))
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+// KVVersion returns the configured version of the KV engine, or detects it from the mount options.
+// Mounts without version option are KV version 1 mounts
+func (c *HashicorpVaultClient) KVVersion() (int, error) {
+	if c.kvVersion != 0 {
+		return c.kvVersion, nil
+	}
+
+	secret, err := c.client.Logical().Read(path.Join("sys/internal/ui/mounts", c.mountPoint))
+	if err != nil {
+		return 0, parseErrorResponse(err)
+	}
+	if secret == nil || secret.Data == nil {
+		return 0, fmt.Errorf("mount %s was not found", c.mountPoint)
+	}
+
+	options, _ := secret.Data["options"].(map[string]interface{})
+	version, ok := options["version"].(string)
+	if !ok || version == "" {
+		return 1, nil
+	}
+
+	return strconv.Atoi(version)
+}
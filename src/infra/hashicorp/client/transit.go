@@ -0,0 +1,70 @@
+package client
+
+import (
+	"path"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func (c *HashicorpVaultClient) CreateTransitKey(id string, data map[string]interface{}) error {
+	_, err := c.client.Logical().Write(c.pathKeys(id), data)
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *HashicorpVaultClient) GetTransitKey(id string) (*api.Secret, error) {
+	secret, err := c.client.Logical().Read(c.pathKeys(id))
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
+
+func (c *HashicorpVaultClient) ListTransitKeys() (*api.Secret, error) {
+	secret, err := c.client.Logical().List(c.pathKeys(""))
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
+
+func (c *HashicorpVaultClient) RotateTransitKey(id string) error {
+	_, err := c.client.Logical().Write(path.Join(c.pathKeys(id), "rotate"), nil)
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *HashicorpVaultClient) UpdateTransitKeyConfig(id string, data map[string]interface{}) error {
+	_, err := c.client.Logical().Write(path.Join(c.pathKeys(id), "config"), data)
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *HashicorpVaultClient) DeleteTransitKey(id string) error {
+	_, err := c.client.Logical().Delete(c.pathKeys(id))
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *HashicorpVaultClient) TransitSign(id string, data map[string]interface{}) (*api.Secret, error) {
+	secret, err := c.client.Logical().Write(path.Join(c.mountPoint, "sign", id), data)
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
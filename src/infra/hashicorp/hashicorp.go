@@ -7,14 +7,27 @@ import (
 //go:generate mockgen -source=hashicorp.go -destination=mocks/hashicorp.go -package=mocks
 
 type Client interface {
+	Kvv1Client
 	Kvv2Client
 	PluginClient
+	TransitClient
+	Engine() string
+	KVVersion() (int, error)
 	SetToken(token string)
 	UnwrapToken(token string) (*hashicorp.Secret, error)
+	Login(mountPath string, data map[string]interface{}) (*hashicorp.Secret, error)
+	NewLifetimeWatcher(secret *hashicorp.Secret) (*hashicorp.LifetimeWatcher, error)
 	Mount(path string, mountInfo *hashicorp.MountInput) error
 	HealthCheck() error
 }
 
+type Kvv1Client interface {
+	ReadKvv1Secret(id string) (*hashicorp.Secret, error)
+	SetKvv1Secret(id string, data map[string]interface{}) error
+	ListKvv1Secrets() (*hashicorp.Secret, error)
+	DeleteKvv1Secret(id string) error
+}
+
 type Kvv2Client interface {
 	ReadData(id string, data map[string][]string) (*hashicorp.Secret, error)
 	ReadMetadata(id string) (*hashicorp.Secret, error)
@@ -34,3 +47,13 @@ type PluginClient interface {
 	DestroyKey(id string) error
 	Sign(id string, data []byte) (*hashicorp.Secret, error)
 }
+
+type TransitClient interface {
+	CreateTransitKey(id string, data map[string]interface{}) error
+	GetTransitKey(id string) (*hashicorp.Secret, error)
+	ListTransitKeys() (*hashicorp.Secret, error)
+	RotateTransitKey(id string) error
+	UpdateTransitKeyConfig(id string, data map[string]interface{}) error
+	DeleteTransitKey(id string) error
+	TransitSign(id string, data map[string]interface{}) (*hashicorp.Secret, error)
+}
@@ -33,6 +33,64 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// ReadKvv1Secret mocks base method
+func (m *MockClient) ReadKvv1Secret(id string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadKvv1Secret", id)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadKvv1Secret indicates an expected call of ReadKvv1Secret
+func (mr *MockClientMockRecorder) ReadKvv1Secret(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadKvv1Secret", reflect.TypeOf((*MockClient)(nil).ReadKvv1Secret), id)
+}
+
+// SetKvv1Secret mocks base method
+func (m *MockClient) SetKvv1Secret(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKvv1Secret", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKvv1Secret indicates an expected call of SetKvv1Secret
+func (mr *MockClientMockRecorder) SetKvv1Secret(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKvv1Secret", reflect.TypeOf((*MockClient)(nil).SetKvv1Secret), id, data)
+}
+
+// ListKvv1Secrets mocks base method
+func (m *MockClient) ListKvv1Secrets() (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKvv1Secrets")
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKvv1Secrets indicates an expected call of ListKvv1Secrets
+func (mr *MockClientMockRecorder) ListKvv1Secrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKvv1Secrets", reflect.TypeOf((*MockClient)(nil).ListKvv1Secrets))
+}
+
+// DeleteKvv1Secret mocks base method
+func (m *MockClient) DeleteKvv1Secret(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKvv1Secret", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKvv1Secret indicates an expected call of DeleteKvv1Secret
+func (mr *MockClientMockRecorder) DeleteKvv1Secret(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKvv1Secret", reflect.TypeOf((*MockClient)(nil).DeleteKvv1Secret), id)
+}
+
 // ReadData mocks base method
 func (m *MockClient) ReadData(id string, data map[string][]string) (*api.Secret, error) {
 	m.ctrl.T.Helper()
@@ -239,6 +297,136 @@ func (mr *MockClientMockRecorder) Sign(id, data interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockClient)(nil).Sign), id, data)
 }
 
+// CreateTransitKey mocks base method
+func (m *MockClient) CreateTransitKey(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitKey", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransitKey indicates an expected call of CreateTransitKey
+func (mr *MockClientMockRecorder) CreateTransitKey(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitKey", reflect.TypeOf((*MockClient)(nil).CreateTransitKey), id, data)
+}
+
+// GetTransitKey mocks base method
+func (m *MockClient) GetTransitKey(id string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransitKey", id)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransitKey indicates an expected call of GetTransitKey
+func (mr *MockClientMockRecorder) GetTransitKey(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitKey", reflect.TypeOf((*MockClient)(nil).GetTransitKey), id)
+}
+
+// ListTransitKeys mocks base method
+func (m *MockClient) ListTransitKeys() (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransitKeys")
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransitKeys indicates an expected call of ListTransitKeys
+func (mr *MockClientMockRecorder) ListTransitKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitKeys", reflect.TypeOf((*MockClient)(nil).ListTransitKeys))
+}
+
+// RotateTransitKey mocks base method
+func (m *MockClient) RotateTransitKey(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateTransitKey", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateTransitKey indicates an expected call of RotateTransitKey
+func (mr *MockClientMockRecorder) RotateTransitKey(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateTransitKey", reflect.TypeOf((*MockClient)(nil).RotateTransitKey), id)
+}
+
+// UpdateTransitKeyConfig mocks base method
+func (m *MockClient) UpdateTransitKeyConfig(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransitKeyConfig", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransitKeyConfig indicates an expected call of UpdateTransitKeyConfig
+func (mr *MockClientMockRecorder) UpdateTransitKeyConfig(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransitKeyConfig", reflect.TypeOf((*MockClient)(nil).UpdateTransitKeyConfig), id, data)
+}
+
+// DeleteTransitKey mocks base method
+func (m *MockClient) DeleteTransitKey(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitKey", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitKey indicates an expected call of DeleteTransitKey
+func (mr *MockClientMockRecorder) DeleteTransitKey(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitKey", reflect.TypeOf((*MockClient)(nil).DeleteTransitKey), id)
+}
+
+// TransitSign mocks base method
+func (m *MockClient) TransitSign(id string, data map[string]interface{}) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitSign", id, data)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitSign indicates an expected call of TransitSign
+func (mr *MockClientMockRecorder) TransitSign(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitSign", reflect.TypeOf((*MockClient)(nil).TransitSign), id, data)
+}
+
+// Engine mocks base method
+func (m *MockClient) Engine() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Engine")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Engine indicates an expected call of Engine
+func (mr *MockClientMockRecorder) Engine() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Engine", reflect.TypeOf((*MockClient)(nil).Engine))
+}
+
+// KVVersion mocks base method
+func (m *MockClient) KVVersion() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KVVersion")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KVVersion indicates an expected call of KVVersion
+func (mr *MockClientMockRecorder) KVVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KVVersion", reflect.TypeOf((*MockClient)(nil).KVVersion))
+}
+
 // SetToken mocks base method
 func (m *MockClient) SetToken(token string) {
 	m.ctrl.T.Helper()
@@ -266,6 +454,36 @@ func (mr *MockClientMockRecorder) UnwrapToken(token interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnwrapToken", reflect.TypeOf((*MockClient)(nil).UnwrapToken), token)
 }
 
+// Login mocks base method
+func (m *MockClient) Login(mountPath string, data map[string]interface{}) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", mountPath, data)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login
+func (mr *MockClientMockRecorder) Login(mountPath, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockClient)(nil).Login), mountPath, data)
+}
+
+// NewLifetimeWatcher mocks base method
+func (m *MockClient) NewLifetimeWatcher(secret *api.Secret) (*api.LifetimeWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewLifetimeWatcher", secret)
+	ret0, _ := ret[0].(*api.LifetimeWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewLifetimeWatcher indicates an expected call of NewLifetimeWatcher
+func (mr *MockClientMockRecorder) NewLifetimeWatcher(secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewLifetimeWatcher", reflect.TypeOf((*MockClient)(nil).NewLifetimeWatcher), secret)
+}
+
 // Mount mocks base method
 func (m *MockClient) Mount(path string, mountInfo *api.MountInput) error {
 	m.ctrl.T.Helper()
@@ -294,6 +512,87 @@ func (mr *MockClientMockRecorder) HealthCheck() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockClient)(nil).HealthCheck))
 }
 
+// MockKvv1Client is a mock of Kvv1Client interface
+type MockKvv1Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockKvv1ClientMockRecorder
+}
+
+// MockKvv1ClientMockRecorder is the mock recorder for MockKvv1Client
+type MockKvv1ClientMockRecorder struct {
+	mock *MockKvv1Client
+}
+
+// NewMockKvv1Client creates a new mock instance
+func NewMockKvv1Client(ctrl *gomock.Controller) *MockKvv1Client {
+	mock := &MockKvv1Client{ctrl: ctrl}
+	mock.recorder = &MockKvv1ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockKvv1Client) EXPECT() *MockKvv1ClientMockRecorder {
+	return m.recorder
+}
+
+// ReadKvv1Secret mocks base method
+func (m *MockKvv1Client) ReadKvv1Secret(id string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadKvv1Secret", id)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadKvv1Secret indicates an expected call of ReadKvv1Secret
+func (mr *MockKvv1ClientMockRecorder) ReadKvv1Secret(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadKvv1Secret", reflect.TypeOf((*MockKvv1Client)(nil).ReadKvv1Secret), id)
+}
+
+// SetKvv1Secret mocks base method
+func (m *MockKvv1Client) SetKvv1Secret(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKvv1Secret", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKvv1Secret indicates an expected call of SetKvv1Secret
+func (mr *MockKvv1ClientMockRecorder) SetKvv1Secret(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKvv1Secret", reflect.TypeOf((*MockKvv1Client)(nil).SetKvv1Secret), id, data)
+}
+
+// ListKvv1Secrets mocks base method
+func (m *MockKvv1Client) ListKvv1Secrets() (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKvv1Secrets")
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKvv1Secrets indicates an expected call of ListKvv1Secrets
+func (mr *MockKvv1ClientMockRecorder) ListKvv1Secrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKvv1Secrets", reflect.TypeOf((*MockKvv1Client)(nil).ListKvv1Secrets))
+}
+
+// DeleteKvv1Secret mocks base method
+func (m *MockKvv1Client) DeleteKvv1Secret(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKvv1Secret", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKvv1Secret indicates an expected call of DeleteKvv1Secret
+func (mr *MockKvv1ClientMockRecorder) DeleteKvv1Secret(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKvv1Secret", reflect.TypeOf((*MockKvv1Client)(nil).DeleteKvv1Secret), id)
+}
+
 // MockKvv2Client is a mock of Kvv2Client interface
 type MockKvv2Client struct {
 	ctrl     *gomock.Controller
@@ -545,3 +844,127 @@ func (mr *MockPluginClientMockRecorder) Sign(id, data interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockPluginClient)(nil).Sign), id, data)
 }
+
+// MockTransitClient is a mock of TransitClient interface
+type MockTransitClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransitClientMockRecorder
+}
+
+// MockTransitClientMockRecorder is the mock recorder for MockTransitClient
+type MockTransitClientMockRecorder struct {
+	mock *MockTransitClient
+}
+
+// NewMockTransitClient creates a new mock instance
+func NewMockTransitClient(ctrl *gomock.Controller) *MockTransitClient {
+	mock := &MockTransitClient{ctrl: ctrl}
+	mock.recorder = &MockTransitClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransitClient) EXPECT() *MockTransitClientMockRecorder {
+	return m.recorder
+}
+
+// CreateTransitKey mocks base method
+func (m *MockTransitClient) CreateTransitKey(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitKey", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransitKey indicates an expected call of CreateTransitKey
+func (mr *MockTransitClientMockRecorder) CreateTransitKey(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitKey", reflect.TypeOf((*MockTransitClient)(nil).CreateTransitKey), id, data)
+}
+
+// GetTransitKey mocks base method
+func (m *MockTransitClient) GetTransitKey(id string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransitKey", id)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransitKey indicates an expected call of GetTransitKey
+func (mr *MockTransitClientMockRecorder) GetTransitKey(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitKey", reflect.TypeOf((*MockTransitClient)(nil).GetTransitKey), id)
+}
+
+// ListTransitKeys mocks base method
+func (m *MockTransitClient) ListTransitKeys() (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransitKeys")
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransitKeys indicates an expected call of ListTransitKeys
+func (mr *MockTransitClientMockRecorder) ListTransitKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitKeys", reflect.TypeOf((*MockTransitClient)(nil).ListTransitKeys))
+}
+
+// RotateTransitKey mocks base method
+func (m *MockTransitClient) RotateTransitKey(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateTransitKey", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateTransitKey indicates an expected call of RotateTransitKey
+func (mr *MockTransitClientMockRecorder) RotateTransitKey(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateTransitKey", reflect.TypeOf((*MockTransitClient)(nil).RotateTransitKey), id)
+}
+
+// UpdateTransitKeyConfig mocks base method
+func (m *MockTransitClient) UpdateTransitKeyConfig(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransitKeyConfig", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransitKeyConfig indicates an expected call of UpdateTransitKeyConfig
+func (mr *MockTransitClientMockRecorder) UpdateTransitKeyConfig(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransitKeyConfig", reflect.TypeOf((*MockTransitClient)(nil).UpdateTransitKeyConfig), id, data)
+}
+
+// DeleteTransitKey mocks base method
+func (m *MockTransitClient) DeleteTransitKey(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitKey", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransitKey indicates an expected call of DeleteTransitKey
+func (mr *MockTransitClientMockRecorder) DeleteTransitKey(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitKey", reflect.TypeOf((*MockTransitClient)(nil).DeleteTransitKey), id)
+}
+
+// TransitSign mocks base method
+func (m *MockTransitClient) TransitSign(id string, data map[string]interface{}) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitSign", id, data)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitSign indicates an expected call of TransitSign
+func (mr *MockTransitClientMockRecorder) TransitSign(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitSign", reflect.TypeOf((*MockTransitClient)(nil).TransitSign), id, data)
+}
@@ -0,0 +1,240 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	defaultAppRoleMountPath    = "approle"
+	defaultKubernetesMountPath = "kubernetes"
+	defaultKubernetesJWTPath   = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	minLoginRetryDelay = time.Second
+	maxLoginRetryDelay = time.Minute
+)
+
+// LoginMethod is an auth method used to obtain a client token
+type LoginMethod interface {
+	// MountPath is the path the auth method is enabled at
+	MountPath() string
+	// LoginData returns the login payload, credentials read from files are read again at each login
+	LoginData() (map[string]interface{}, error)
+}
+
+type appRoleLogin struct {
+	cfg *entities.HashicorpAppRoleAuth
+}
+
+func NewAppRoleLogin(cfg *entities.HashicorpAppRoleAuth) LoginMethod {
+	return &appRoleLogin{cfg: cfg}
+}
+
+func (l *appRoleLogin) MountPath() string {
+	if l.cfg.MountPath != "" {
+		return l.cfg.MountPath
+	}
+
+	return defaultAppRoleMountPath
+}
+
+func (l *appRoleLogin) LoginData() (map[string]interface{}, error) {
+	secretID := l.cfg.SecretID
+	if l.cfg.SecretIDPath != "" {
+		var err error
+		secretID, err = readFile(l.cfg.SecretIDPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"role_id":   l.cfg.RoleID,
+		"secret_id": secretID,
+	}, nil
+}
+
+type kubernetesLogin struct {
+	cfg *entities.HashicorpKubernetesAuth
+}
+
+func NewKubernetesLogin(cfg *entities.HashicorpKubernetesAuth) LoginMethod {
+	return &kubernetesLogin{cfg: cfg}
+}
+
+func (l *kubernetesLogin) MountPath() string {
+	if l.cfg.MountPath != "" {
+		return l.cfg.MountPath
+	}
+
+	return defaultKubernetesMountPath
+}
+
+func (l *kubernetesLogin) LoginData() (map[string]interface{}, error) {
+	jwtPath := l.cfg.JWTPath
+	if jwtPath == "" {
+		jwtPath = defaultKubernetesJWTPath
+	}
+
+	// Service account tokens are rotated by the kubelet so the file is read at each login
+	jwt, err := readFile(jwtPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"role": l.cfg.Role,
+		"jwt":  jwt,
+	}, nil
+}
+
+// LoginWatcher logs in with an auth method, renews the client token while its lease allows it
+// and logs in again once the token can no longer be renewed
+type LoginWatcher struct {
+	client hashicorp.Client
+	method LoginMethod
+	logger log.Logger
+	secret *api.Secret
+}
+
+func NewLoginWatcher(client hashicorp.Client, method LoginMethod, logger log.Logger) *LoginWatcher {
+	return &LoginWatcher{
+		client: client,
+		method: method,
+		logger: logger.With("auth_mount_path", method.MountPath()),
+	}
+}
+
+// Login obtains a new client token and sets it on the client
+func (lw *LoginWatcher) Login() error {
+	data, err := lw.method.LoginData()
+	if err != nil {
+		errMessage := "failed to read login credentials"
+		lw.logger.WithError(err).Error(errMessage)
+		return errors.ConfigError(errMessage)
+	}
+
+	secret, err := lw.client.Login(lw.method.MountPath(), data)
+	if err != nil {
+		errMessage := "failed to login to Hashicorp Vault"
+		lw.logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		errMessage := "login response does not contain a client token"
+		lw.logger.Error(errMessage)
+		return errors.HashicorpVaultError(errMessage)
+	}
+
+	lw.client.SetToken(secret.Auth.ClientToken)
+	lw.secret = secret
+
+	lw.logger.Info("logged in successfully", "lease_duration", secret.Auth.LeaseDuration)
+	return nil
+}
+
+// Start renews the client token until its lease expires and then logs in again, until the context is cancelled
+func (lw *LoginWatcher) Start(ctx context.Context) error {
+	retryDelay := minLoginRetryDelay
+	for {
+		if lw.secret == nil {
+			if err := lw.Login(); err != nil {
+				lw.logger.WithError(err).Warn("login failed, retrying", "delay", retryDelay.String())
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(retryDelay):
+				}
+
+				retryDelay = nextRetryDelay(retryDelay)
+				continue
+			}
+		}
+		retryDelay = minLoginRetryDelay
+
+		err := lw.watchLifetime(ctx)
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// The token has reached its maximum TTL or could not be renewed
+		lw.secret = nil
+	}
+}
+
+func (lw *LoginWatcher) watchLifetime(ctx context.Context) error {
+	if !lw.secret.Auth.Renewable {
+		var expiry <-chan time.Time
+		// Tokens without lease duration never expire
+		if lw.secret.Auth.LeaseDuration > 0 {
+			expiry = time.After(time.Duration(lw.secret.Auth.LeaseDuration) * time.Second)
+		}
+
+		lw.logger.Debug("client token is not renewable, waiting for its expiry")
+		select {
+		case <-ctx.Done():
+		case <-expiry:
+		}
+
+		return nil
+	}
+
+	watcher, err := lw.client.NewLifetimeWatcher(lw.secret)
+	if err != nil {
+		errMessage := "failed to instantiate token lifetime watcher"
+		lw.logger.WithError(err).Error(errMessage)
+		return errors.HashicorpVaultError(errMessage)
+	}
+
+	go watcher.Start()
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.DoneCh():
+			if err != nil {
+				lw.logger.WithError(err).Warn("failed to renew client token, logging in again")
+			} else {
+				lw.logger.Info("client token has reached its maximum TTL, logging in again")
+			}
+			return nil
+		case renewal := <-watcher.RenewCh():
+			lw.logger.Debug("client token has been successfully renewed", "renewed_at", renewal.RenewedAt.String())
+		}
+	}
+}
+
+func nextRetryDelay(delay time.Duration) time.Duration {
+	delay *= 2
+	if delay > maxLoginRetryDelay {
+		return maxLoginRetryDelay
+	}
+
+	return delay
+}
+
+func readFile(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
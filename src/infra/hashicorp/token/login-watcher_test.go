@@ -0,0 +1,77 @@
+package token
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp/mocks"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginWatcher_Login(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockClient(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+
+	t.Run("should login with AppRole reading the secret ID from file and set the client token", func(t *testing.T) {
+		secretIDPath := filepath.Join(t.TempDir(), ".secret_id")
+		require.NoError(t, ioutil.WriteFile(secretIDPath, []byte("my-secret-id\n"), 0600))
+
+		method := NewAppRoleLogin(&entities.HashicorpAppRoleAuth{RoleID: "my-role-id", SecretIDPath: secretIDPath})
+		client.EXPECT().Login("approle", map[string]interface{}{
+			"role_id":   "my-role-id",
+			"secret_id": "my-secret-id",
+		}).Return(&api.Secret{Auth: &api.SecretAuth{ClientToken: "s.token", LeaseDuration: 3600, Renewable: true}}, nil)
+		client.EXPECT().SetToken("s.token")
+
+		err := NewLoginWatcher(client, method, logger).Login()
+		assert.NoError(t, err)
+	})
+
+	t.Run("should login with Kubernetes on a custom mount path", func(t *testing.T) {
+		jwtPath := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, ioutil.WriteFile(jwtPath, []byte("my-jwt"), 0600))
+
+		method := NewKubernetesLogin(&entities.HashicorpKubernetesAuth{Role: "qkm", JWTPath: jwtPath, MountPath: "k8s-cluster"})
+		client.EXPECT().Login("k8s-cluster", map[string]interface{}{
+			"role": "qkm",
+			"jwt":  "my-jwt",
+		}).Return(&api.Secret{Auth: &api.SecretAuth{ClientToken: "s.token"}}, nil)
+		client.EXPECT().SetToken("s.token")
+
+		err := NewLoginWatcher(client, method, logger).Login()
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with ConfigError if the service account token cannot be read", func(t *testing.T) {
+		method := NewKubernetesLogin(&entities.HashicorpKubernetesAuth{Role: "qkm", JWTPath: filepath.Join(t.TempDir(), "missing")})
+
+		err := NewLoginWatcher(client, method, logger).Login()
+		assert.True(t, errors.IsConfigError(err))
+	})
+
+	t.Run("should fail with same error if login fails", func(t *testing.T) {
+		method := NewAppRoleLogin(&entities.HashicorpAppRoleAuth{RoleID: "my-role-id", SecretID: "my-secret-id"})
+		client.EXPECT().Login("approle", gomock.Any()).Return(nil, errors.InvalidFormatError("invalid secret id"))
+
+		err := NewLoginWatcher(client, method, logger).Login()
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+
+	t.Run("should fail with HashicorpVaultError if the response contains no client token", func(t *testing.T) {
+		method := NewAppRoleLogin(&entities.HashicorpAppRoleAuth{RoleID: "my-role-id", SecretID: "my-secret-id"})
+		client.EXPECT().Login("approle", gomock.Any()).Return(&api.Secret{}, nil)
+
+		err := NewLoginWatcher(client, method, logger).Login()
+		assert.True(t, errors.IsHashicorpVaultError(err))
+	})
+}
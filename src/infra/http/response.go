@@ -32,7 +32,7 @@ func WriteHTTPErrorResponse(rw http.ResponseWriter, err error) {
 		writeErrorResponse(rw, http.StatusTooManyRequests, err)
 	case errors.IsInvalidParameterError(err), errors.IsEncodingError(err):
 		writeErrorResponse(rw, http.StatusUnprocessableEntity, err)
-	case errors.IsHashicorpVaultError(err), errors.IsAKVError(err), errors.IsDependencyFailureError(err), errors.IsAWSError(err), errors.IsPostgresError(err):
+	case errors.IsHashicorpVaultError(err), errors.IsAKVError(err), errors.IsDependencyFailureError(err), errors.IsAWSError(err), errors.IsPKCS11Error(err), errors.IsGCPError(err), errors.IsPostgresError(err):
 		writeErrorResponse(rw, http.StatusFailedDependency, errors.DependencyFailureError(internalDepErrMsg))
 	case errors.IsNotImplementedError(err), errors.IsNotSupportedError(err):
 		writeErrorResponse(rw, http.StatusNotImplemented, err)
@@ -42,7 +42,8 @@ func WriteHTTPErrorResponse(rw http.ResponseWriter, err error) {
 }
 
 func writeErrorResponse(rw http.ResponseWriter, status int, err error) {
-	msg, e := json.Marshal(ErrorResponse{Message: err.Error(), Code: errors.FromError(err).GetCode()})
+	ierr := errors.FromError(err)
+	msg, e := json.Marshal(ErrorResponse{Message: err.Error(), Code: ierr.GetCode(), Data: ierr.GetData()})
 	if e != nil {
 		http.Error(rw, e.Error(), status)
 		return
@@ -1,8 +1,9 @@
 package http
 
 type ErrorResponse struct {
-	Message string `json:"message" example:"error message"`
-	Code    string `json:"code,omitempty" example:"IR001"`
+	Message string                 `json:"message" example:"error message"`
+	Code    string                 `json:"code,omitempty" example:"IR001"`
+	Data    map[string]interface{} `json:"data,omitempty"`
 }
 
 type PageResponse struct {
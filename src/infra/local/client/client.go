@@ -0,0 +1,183 @@
+package client
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/consensys/quorum-key-manager/src/infra/local"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	vaultFile  = "vault.json"
+	secretsDir = "secrets"
+
+	saltSize  = 32
+	keySize   = 32
+	scryptN   = 1 << 15
+	scryptR   = 8
+	scryptP   = 1
+	checkData = "quorum-key-manager"
+)
+
+// vaultInfo is stored in clear next to the secrets, it holds the parameters needed to derive the master key
+// and a value encrypted with it in order to detect a wrong master key at startup
+type vaultInfo struct {
+	Salt  []byte `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	Check []byte `json:"check"`
+}
+
+// LocalClient stores secrets in a directory, each secret and its versions being encrypted with AES-256-GCM
+// in its own file, using a master key derived with scrypt
+type LocalClient struct {
+	path string
+	aead cipher.AEAD
+	mux  sync.RWMutex
+}
+
+var _ local.Client = &LocalClient{}
+
+func New(cfg *Config) (*LocalClient, error) {
+	masterSecret, err := cfg.MasterSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Join(cfg.Path, secretsDir), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	info, isNew, err := loadOrCreateVaultInfo(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := scrypt.Key(masterSecret, info.Salt, info.N, info.R, info.P, keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &LocalClient{
+		path: cfg.Path,
+		aead: aead,
+	}
+
+	if isNew {
+		info.Check, err = c.encrypt([]byte(checkData), []byte(vaultFile))
+		if err != nil {
+			return nil, err
+		}
+
+		err = writeJSONFile(filepath.Join(cfg.Path, vaultFile), info)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		check, err := c.decrypt(info.Check, []byte(vaultFile))
+		if err != nil || string(check) != checkData {
+			return nil, fmt.Errorf("invalid master key for local vault %s", cfg.Path)
+		}
+	}
+
+	return c, nil
+}
+
+func loadOrCreateVaultInfo(path string) (info *vaultInfo, isNew bool, err error) {
+	data, err := ioutil.ReadFile(filepath.Join(path, vaultFile))
+	if os.IsNotExist(err) {
+		salt := make([]byte, saltSize)
+		if _, err = io.ReadFull(rand.Reader, salt); err != nil {
+			return nil, false, err
+		}
+
+		return &vaultInfo{Salt: salt, N: scryptN, R: scryptR, P: scryptP}, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	info = &vaultInfo{}
+	err = json.Unmarshal(data, info)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return info, false, nil
+}
+
+// encrypt returns the nonce followed by the ciphertext, the additional data binding the ciphertext to its file
+func (c *LocalClient) encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return c.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func (c *LocalClient) decrypt(data, additionalData []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	return c.aead.Open(nil, data[:nonceSize], data[nonceSize:], additionalData)
+}
+
+func writeJSONFile(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(path, data)
+}
+
+// writeFile writes atomically by renaming a temporary file so that a crash never leaves a truncated file
+func writeFile(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalClient(t *testing.T) {
+	ctx := context.Background()
+	cfg := &Config{Path: t.TempDir(), Passphrase: "my-passphrase"}
+
+	cli, err := New(cfg)
+	require.NoError(t, err)
+
+	t.Run("should set secret versions and get them", func(t *testing.T) {
+		secret, err := cli.SetSecret(ctx, "my-secret", "value-1", map[string]string{"tag": "1"})
+		require.NoError(t, err)
+		assert.Equal(t, "1", secret.Metadata.Version)
+
+		secret, err = cli.SetSecret(ctx, "my-secret", "value-2", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "2", secret.Metadata.Version)
+
+		secret, err = cli.GetSecret(ctx, "my-secret", "")
+		require.NoError(t, err)
+		assert.Equal(t, "value-2", secret.Value)
+
+		secret, err = cli.GetSecret(ctx, "my-secret", "1")
+		require.NoError(t, err)
+		assert.Equal(t, "value-1", secret.Value)
+		assert.Equal(t, map[string]string{"tag": "1"}, secret.Tags)
+
+		_, err = cli.GetSecret(ctx, "my-secret", "3")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should not store secrets in clear", func(t *testing.T) {
+		files, err := filepath.Glob(filepath.Join(cfg.Path, secretsDir, "*"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		data, err := ioutil.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "value-1")
+		assert.NotContains(t, string(data), "my-secret")
+	})
+
+	t.Run("should delete, restore and destroy a secret", func(t *testing.T) {
+		err := cli.DeleteSecret(ctx, "my-secret")
+		require.NoError(t, err)
+
+		_, err = cli.GetSecret(ctx, "my-secret", "")
+		assert.True(t, errors.IsNotFoundError(err))
+
+		ids, err := cli.ListDeletedSecrets(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-secret"}, ids)
+
+		err = cli.RestoreSecret(ctx, "my-secret")
+		require.NoError(t, err)
+
+		ids, err = cli.ListSecrets(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-secret"}, ids)
+
+		err = cli.DestroySecret(ctx, "my-secret")
+		assert.True(t, errors.IsStatusConflictError(err))
+
+		require.NoError(t, cli.DeleteSecret(ctx, "my-secret"))
+		require.NoError(t, cli.DestroySecret(ctx, "my-secret"))
+
+		_, err = cli.GetDeletedSecret(ctx, "my-secret")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should reopen the vault with the same master key", func(t *testing.T) {
+		_, err := cli.SetSecret(ctx, "my-secret-2", "value", nil)
+		require.NoError(t, err)
+
+		reopened, err := New(cfg)
+		require.NoError(t, err)
+
+		secret, err := reopened.GetSecret(ctx, "my-secret-2", "")
+		require.NoError(t, err)
+		assert.Equal(t, "value", secret.Value)
+	})
+
+	t.Run("should fail to open the vault with a wrong master key", func(t *testing.T) {
+		_, err := New(&Config{Path: cfg.Path, Passphrase: "wrong-passphrase"})
+		assert.Error(t, err)
+	})
+}
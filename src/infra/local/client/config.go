@@ -0,0 +1,50 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+type Config struct {
+	Path       string
+	Passphrase string
+	KeyPath    string
+	KeyEnv     string
+}
+
+func NewConfig(cfg *entities.LocalConfig) *Config {
+	return &Config{
+		Path:       cfg.Path,
+		Passphrase: cfg.Passphrase,
+		KeyPath:    cfg.KeyPath,
+		KeyEnv:     cfg.KeyEnv,
+	}
+}
+
+// MasterSecret resolves the secret the master key is derived from, in order of precedence from the configuration, a file or an environment variable
+func (c *Config) MasterSecret() ([]byte, error) {
+	switch {
+	case c.Passphrase != "":
+		return []byte(c.Passphrase), nil
+	case c.KeyPath != "":
+		key, err := ioutil.ReadFile(c.KeyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.TrimSpace(key), nil
+	case c.KeyEnv != "":
+		key, ok := os.LookupEnv(c.KeyEnv)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", c.KeyEnv)
+		}
+
+		return []byte(key), nil
+	default:
+		return nil, fmt.Errorf("one of passphrase, key_path or key_env must be specified")
+	}
+}
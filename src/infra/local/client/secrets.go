@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+const secretFileExt = ".enc"
+
+type secretRecord struct {
+	ID        string           `json:"id"`
+	Versions  []*versionRecord `json:"versions"`
+	DeletedAt *time.Time       `json:"deletedAt,omitempty"`
+}
+
+type versionRecord struct {
+	Version   int               `json:"version"`
+	Value     string            `json:"value"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+func (c *LocalClient) SetSecret(_ context.Context, id, value string, tags map[string]string) (*entities.Secret, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	record, err := c.readSecret(id)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		record = &secretRecord{ID: id}
+	}
+
+	if record.DeletedAt != nil {
+		return nil, errors.StatusConflictError("secret %s is deleted, it must be restored or destroyed first", id)
+	}
+
+	record.Versions = append(record.Versions, &versionRecord{
+		Version:   len(record.Versions) + 1,
+		Value:     value,
+		Tags:      tags,
+		CreatedAt: time.Now().UTC(),
+	})
+
+	err = c.writeSecret(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.toEntity(record.Versions[len(record.Versions)-1]), nil
+}
+
+func (c *LocalClient) GetSecret(_ context.Context, id, version string) (*entities.Secret, error) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	record, err := c.readSecret(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.DeletedAt != nil {
+		return nil, errors.NotFoundError("secret %s was not found", id)
+	}
+
+	if version == "" {
+		return record.toEntity(record.Versions[len(record.Versions)-1]), nil
+	}
+
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return nil, errors.InvalidParameterError("version must be a number")
+	}
+
+	if v < 1 || v > len(record.Versions) {
+		return nil, errors.NotFoundError("version %s of secret %s was not found", version, id)
+	}
+
+	return record.toEntity(record.Versions[v-1]), nil
+}
+
+func (c *LocalClient) ListSecrets(_ context.Context) ([]string, error) {
+	return c.listSecrets(false)
+}
+
+func (c *LocalClient) GetDeletedSecret(_ context.Context, id string) (*entities.Secret, error) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	record, err := c.readSecret(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.DeletedAt == nil {
+		return nil, errors.NotFoundError("deleted secret %s was not found", id)
+	}
+
+	return record.toEntity(record.Versions[len(record.Versions)-1]), nil
+}
+
+func (c *LocalClient) ListDeletedSecrets(_ context.Context) ([]string, error) {
+	return c.listSecrets(true)
+}
+
+func (c *LocalClient) DeleteSecret(_ context.Context, id string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	record, err := c.readSecret(id)
+	if err != nil {
+		return err
+	}
+
+	if record.DeletedAt != nil {
+		return errors.NotFoundError("secret %s was not found", id)
+	}
+
+	deletedAt := time.Now().UTC()
+	record.DeletedAt = &deletedAt
+
+	return c.writeSecret(record)
+}
+
+func (c *LocalClient) RestoreSecret(_ context.Context, id string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	record, err := c.readSecret(id)
+	if err != nil {
+		return err
+	}
+
+	if record.DeletedAt == nil {
+		return errors.NotFoundError("deleted secret %s was not found", id)
+	}
+
+	record.DeletedAt = nil
+
+	return c.writeSecret(record)
+}
+
+func (c *LocalClient) DestroySecret(_ context.Context, id string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	record, err := c.readSecret(id)
+	if err != nil {
+		return err
+	}
+
+	if record.DeletedAt == nil {
+		return errors.StatusConflictError("secret %s must be deleted before being destroyed", id)
+	}
+
+	err = os.Remove(c.secretPath(id))
+	if err != nil {
+		return errors.DependencyFailureError("failed to remove secret file: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (c *LocalClient) listSecrets(deleted bool) ([]string, error) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	files, err := ioutil.ReadDir(filepath.Join(c.path, secretsDir))
+	if err != nil {
+		return nil, errors.DependencyFailureError("failed to read local vault directory: %s", err.Error())
+	}
+
+	ids := []string{}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), secretFileExt) {
+			continue
+		}
+
+		record, err := c.readSecretFile(filepath.Join(c.path, secretsDir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if (record.DeletedAt != nil) == deleted {
+			ids = append(ids, record.ID)
+		}
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// secretPath returns the file of a secret, named after the hash of its ID so that IDs are not disclosed on the filesystem
+func (c *LocalClient) secretPath(id string) string {
+	hash := sha256.Sum256([]byte(id))
+	return filepath.Join(c.path, secretsDir, hex.EncodeToString(hash[:])+secretFileExt)
+}
+
+func (c *LocalClient) readSecret(id string) (*secretRecord, error) {
+	record, err := c.readSecretFile(c.secretPath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if record.ID != id {
+		return nil, errors.DependencyFailureError("secret file does not match secret %s", id)
+	}
+
+	return record, nil
+}
+
+func (c *LocalClient) readSecretFile(path string) (*secretRecord, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundError("secret was not found")
+	}
+	if err != nil {
+		return nil, errors.DependencyFailureError("failed to read secret file: %s", err.Error())
+	}
+
+	plaintext, err := c.decrypt(data, []byte(filepath.Base(path)))
+	if err != nil {
+		return nil, errors.DependencyFailureError("failed to decrypt secret file %s", filepath.Base(path))
+	}
+
+	record := &secretRecord{}
+	err = json.Unmarshal(plaintext, record)
+	if err != nil {
+		return nil, errors.DependencyFailureError("failed to decode secret file %s", filepath.Base(path))
+	}
+
+	return record, nil
+}
+
+func (c *LocalClient) writeSecret(record *secretRecord) error {
+	plaintext, err := json.Marshal(record)
+	if err != nil {
+		return errors.EncodingError("failed to encode secret")
+	}
+
+	path := c.secretPath(record.ID)
+	data, err := c.encrypt(plaintext, []byte(filepath.Base(path)))
+	if err != nil {
+		return errors.DependencyFailureError("failed to encrypt secret: %s", err.Error())
+	}
+
+	err = writeFile(path, data)
+	if err != nil {
+		return errors.DependencyFailureError("failed to write secret file: %s", err.Error())
+	}
+
+	return nil
+}
+
+func (r *secretRecord) toEntity(version *versionRecord) *entities.Secret {
+	metadata := &entities.Metadata{
+		Version:   strconv.Itoa(version.Version),
+		CreatedAt: version.CreatedAt,
+		UpdatedAt: r.Versions[len(r.Versions)-1].CreatedAt,
+	}
+	if r.DeletedAt != nil {
+		metadata.DeletedAt = *r.DeletedAt
+	}
+
+	return &entities.Secret{
+		ID:       r.ID,
+		Value:    version.Value,
+		Tags:     version.Tags,
+		Metadata: metadata,
+	}
+}
@@ -0,0 +1,25 @@
+package local
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+//go:generate mockgen -source=local.go -destination=mocks/local.go -package=mocks
+
+type Client interface {
+	SecretsClient
+}
+
+// SecretsClient manages versioned secrets persisted encrypted on the local filesystem
+type SecretsClient interface {
+	SetSecret(ctx context.Context, id, value string, tags map[string]string) (*entities.Secret, error)
+	GetSecret(ctx context.Context, id, version string) (*entities.Secret, error)
+	ListSecrets(ctx context.Context) ([]string, error)
+	GetDeletedSecret(ctx context.Context, id string) (*entities.Secret, error)
+	ListDeletedSecrets(ctx context.Context) ([]string, error)
+	DeleteSecret(ctx context.Context, id string) error
+	RestoreSecret(ctx context.Context, id string) error
+	DestroySecret(ctx context.Context, id string) error
+}
@@ -114,7 +114,7 @@ func addManifests(mnfs []entities.Manifest, manifestsMap map[string][]entities.M
 func isManifestKind(fl validator.FieldLevel) bool {
 	if fl.Field().String() != "" {
 		switch fl.Field().String() {
-		case entities.RoleKind, entities.StoreKind, entities.NodeKind, entities.VaultKind:
+		case entities.RoleKind, entities.StoreKind, entities.NodeKind, entities.VaultKind, entities.PolicyKind:
 			return true
 		default:
 			return false
@@ -0,0 +1,93 @@
+//go:build cgo
+// +build cgo
+
+package client
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+
+	pkcs11infra "github.com/consensys/quorum-key-manager/src/infra/pkcs11"
+)
+
+// PKCS11Client holds a single authenticated session on a PKCS#11 token.
+// PKCS#11 sessions are not safe for concurrent use, calls are therefore serialized
+type PKCS11Client struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	mux     sync.Mutex
+}
+
+var _ pkcs11infra.Client = &PKCS11Client{}
+
+func New(cfg *Config) (*PKCS11Client, error) {
+	pin, err := cfg.UserPIN()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := pkcs11.New(cfg.ModulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", cfg.ModulePath)
+	}
+
+	err = ctx.Initialize()
+	if err != nil && !isError(err, pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		return nil, err
+	}
+
+	slot, err := findSlot(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil && !isError(err, pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		_ = ctx.CloseSession(session)
+		return nil, err
+	}
+
+	return &PKCS11Client{
+		ctx:     ctx,
+		session: session,
+	}, nil
+}
+
+// findSlot returns the slot of the token matching the configured label, or the configured slot
+func findSlot(ctx *pkcs11.Ctx, cfg *Config) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, slot := range slots {
+		if cfg.TokenLabel != "" {
+			tokenInfo, err := ctx.GetTokenInfo(slot)
+			if err != nil {
+				return 0, err
+			}
+
+			if tokenInfo.Label == cfg.TokenLabel {
+				return slot, nil
+			}
+		} else if cfg.Slot != nil && *cfg.Slot == slot {
+			return slot, nil
+		}
+	}
+
+	switch {
+	case cfg.TokenLabel != "":
+		return 0, fmt.Errorf("token %s was not found", cfg.TokenLabel)
+	case cfg.Slot != nil:
+		return 0, fmt.Errorf("no token present in slot %d", *cfg.Slot)
+	default:
+		return 0, fmt.Errorf("one of token_label or slot must be specified")
+	}
+}
@@ -0,0 +1,42 @@
+//go:build !cgo
+// +build !cgo
+
+package client
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	pkcs11infra "github.com/consensys/quorum-key-manager/src/infra/pkcs11"
+)
+
+const errMessage = "PKCS#11 is not supported by binaries built without cgo"
+
+// PKCS11Client is not available when cgo is disabled as loading a PKCS#11 module requires it
+type PKCS11Client struct{}
+
+var _ pkcs11infra.Client = &PKCS11Client{}
+
+func New(_ *Config) (*PKCS11Client, error) {
+	return nil, errors.NotSupportedError(errMessage)
+}
+
+func (c *PKCS11Client) GenerateKeyPair(context.Context, string, []byte) ([]byte, error) {
+	return nil, errors.NotSupportedError(errMessage)
+}
+
+func (c *PKCS11Client) GetPublicKey(context.Context, string) (ecParams, ecPoint []byte, err error) {
+	return nil, nil, errors.NotSupportedError(errMessage)
+}
+
+func (c *PKCS11Client) ListKeys(context.Context) ([]string, error) {
+	return nil, errors.NotSupportedError(errMessage)
+}
+
+func (c *PKCS11Client) Sign(context.Context, string, []byte) ([]byte, error) {
+	return nil, errors.NotSupportedError(errMessage)
+}
+
+func (c *PKCS11Client) DestroyKeyPair(context.Context, string) error {
+	return errors.NotSupportedError(errMessage)
+}
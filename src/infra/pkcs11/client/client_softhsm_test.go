@@ -0,0 +1,63 @@
+//go:build pkcs11 && cgo
+// +build pkcs11,cgo
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Runs against a SoftHSM token, see the run-pkcs11 target of the Makefile
+func TestSoftHSM(t *testing.T) {
+	ctx := context.Background()
+	cli, err := New(&Config{
+		ModulePath: os.Getenv("PKCS11_MODULE_PATH"),
+		TokenLabel: os.Getenv("PKCS11_TOKEN_LABEL"),
+		PINEnv:     "PKCS11_PIN",
+	})
+	require.NoError(t, err)
+
+	label := "softhsm-test-key"
+	secp256k1Params, _ := asn1.Marshal(asn1.ObjectIdentifier{1, 3, 132, 0, 10})
+
+	t.Run("should generate a key pair, sign and destroy it", func(t *testing.T) {
+		ecPoint, err := cli.GenerateKeyPair(ctx, label, secp256k1Params)
+		require.NoError(t, err)
+
+		var point []byte
+		_, err = asn1.Unmarshal(ecPoint, &point)
+		require.NoError(t, err)
+		pubKey, err := crypto.UnmarshalPubkey(point)
+		require.NoError(t, err)
+
+		_, err = cli.GenerateKeyPair(ctx, label, secp256k1Params)
+		assert.True(t, errors.IsAlreadyExistsError(err))
+
+		labels, err := cli.ListKeys(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, labels, label)
+
+		digest := crypto.Keccak256([]byte("my data"))
+		signature, err := cli.Sign(ctx, label, digest)
+		require.NoError(t, err)
+		require.Len(t, signature, 64)
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		assert.True(t, ecdsa.Verify(pubKey, digest, r, s))
+
+		err = cli.DestroyKeyPair(ctx, label)
+		require.NoError(t, err)
+
+		_, _, err = cli.GetPublicKey(ctx, label)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+type Config struct {
+	ModulePath string
+	TokenLabel string
+	Slot       *uint
+	PIN        string
+	PINPath    string
+	PINEnv     string
+}
+
+func NewConfig(cfg *entities.PKCS11Config) *Config {
+	return &Config{
+		ModulePath: cfg.ModulePath,
+		TokenLabel: cfg.TokenLabel,
+		Slot:       cfg.Slot,
+		PIN:        cfg.PIN,
+		PINPath:    cfg.PINPath,
+		PINEnv:     cfg.PINEnv,
+	}
+}
+
+// UserPIN resolves the user PIN from, in order of precedence, the configuration, a file or an environment variable
+func (c *Config) UserPIN() (string, error) {
+	switch {
+	case c.PIN != "":
+		return c.PIN, nil
+	case c.PINPath != "":
+		pin, err := ioutil.ReadFile(c.PINPath)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(string(pin)), nil
+	case c.PINEnv != "":
+		pin, ok := os.LookupEnv(c.PINEnv)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", c.PINEnv)
+		}
+
+		return pin, nil
+	default:
+		return "", fmt.Errorf("one of pin, pin_path or pin_env must be specified")
+	}
+}
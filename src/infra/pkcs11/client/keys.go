@@ -0,0 +1,206 @@
+//go:build cgo
+// +build cgo
+
+package client
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/miekg/pkcs11"
+)
+
+// Maximum number of object handles returned by a single FindObjects call
+const findObjectsBatchSize = 100
+
+func (c *PKCS11Client) GenerateKeyPair(_ context.Context, label string, ecParams []byte) ([]byte, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	handles, err := c.findObjects(pkcs11.CKO_PRIVATE_KEY, label)
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+	if len(handles) > 0 {
+		return nil, errors.AlreadyExistsError("key pair %s already exists", label)
+	}
+
+	publicTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, []byte(label)),
+	}
+	privateTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, []byte(label)),
+	}
+
+	pubHandle, _, err := c.ctx.GenerateKeyPair(
+		c.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)},
+		publicTemplate,
+		privateTemplate,
+	)
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	attrs, err := c.ctx.GetAttributeValue(c.session, pubHandle, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)})
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return attrs[0].Value, nil
+}
+
+func (c *PKCS11Client) GetPublicKey(_ context.Context, label string) (ecParams, ecPoint []byte, err error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	handle, err := c.findObject(pkcs11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attrs, err := c.ctx.GetAttributeValue(c.session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, nil, parseErrorResponse(err)
+	}
+
+	return attrs[0].Value, attrs[1].Value, nil
+}
+
+func (c *PKCS11Client) ListKeys(_ context.Context) ([]string, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	handles, err := c.findObjects(pkcs11.CKO_PRIVATE_KEY, "")
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	labels := make([]string, 0, len(handles))
+	for _, handle := range handles {
+		attrs, err := c.ctx.GetAttributeValue(c.session, handle, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_LABEL, nil)})
+		if err != nil {
+			return nil, parseErrorResponse(err)
+		}
+
+		labels = append(labels, string(attrs[0].Value))
+	}
+
+	return labels, nil
+}
+
+func (c *PKCS11Client) Sign(_ context.Context, label string, digest []byte) ([]byte, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	handle, err := c.findObject(pkcs11.CKO_PRIVATE_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.ctx.SignInit(c.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, handle)
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	// CKM_ECDSA signatures are the concatenation of R and S
+	signature, err := c.ctx.Sign(c.session, digest)
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return signature, nil
+}
+
+func (c *PKCS11Client) DestroyKeyPair(_ context.Context, label string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	privHandle, err := c.findObject(pkcs11.CKO_PRIVATE_KEY, label)
+	if err != nil {
+		return err
+	}
+
+	err = c.ctx.DestroyObject(c.session, privHandle)
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	pubHandles, err := c.findObjects(pkcs11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	for _, pubHandle := range pubHandles {
+		err = c.ctx.DestroyObject(c.session, pubHandle)
+		if err != nil {
+			return parseErrorResponse(err)
+		}
+	}
+
+	return nil
+}
+
+func (c *PKCS11Client) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	handles, err := c.findObjects(class, label)
+	if err != nil {
+		return 0, parseErrorResponse(err)
+	}
+
+	if len(handles) == 0 {
+		return 0, errors.NotFoundError("key pair %s was not found", label)
+	}
+
+	return handles[0], nil
+}
+
+// findObjects returns the handles of the EC objects of the given class, filtered by label if not empty
+func (c *PKCS11Client) findObjects(class uint, label string) ([]pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+	}
+	if label != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, label))
+	}
+
+	err := c.ctx.FindObjectsInit(c.session, template)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = c.ctx.FindObjectsFinal(c.session)
+	}()
+
+	var handles []pkcs11.ObjectHandle
+	for {
+		batch, _, err := c.ctx.FindObjects(c.session, findObjectsBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		handles = append(handles, batch...)
+		if len(batch) < findObjectsBatchSize {
+			break
+		}
+	}
+
+	return handles, nil
+}
@@ -0,0 +1,34 @@
+//go:build cgo
+// +build cgo
+
+package client
+
+import (
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/miekg/pkcs11"
+)
+
+func parseErrorResponse(err error) error {
+	pkcs11Err, ok := err.(pkcs11.Error)
+	if !ok {
+		return errors.PKCS11Error(err.Error())
+	}
+
+	switch pkcs11Err {
+	case pkcs11.CKR_OBJECT_HANDLE_INVALID, pkcs11.CKR_KEY_HANDLE_INVALID:
+		return errors.NotFoundError(pkcs11Err.Error())
+	case pkcs11.CKR_DATA_INVALID, pkcs11.CKR_DATA_LEN_RANGE, pkcs11.CKR_DOMAIN_PARAMS_INVALID, pkcs11.CKR_CURVE_NOT_SUPPORTED:
+		return errors.InvalidParameterError(pkcs11Err.Error())
+	case pkcs11.CKR_MECHANISM_INVALID, pkcs11.CKR_KEY_TYPE_INCONSISTENT, pkcs11.CKR_KEY_FUNCTION_NOT_PERMITTED:
+		return errors.NotSupportedError(pkcs11Err.Error())
+	case pkcs11.CKR_PIN_INCORRECT, pkcs11.CKR_PIN_EXPIRED, pkcs11.CKR_USER_NOT_LOGGED_IN:
+		return errors.UnauthorizedError(pkcs11Err.Error())
+	default:
+		return errors.PKCS11Error(pkcs11Err.Error())
+	}
+}
+
+func isError(err error, code uint) bool {
+	pkcs11Err, ok := err.(pkcs11.Error)
+	return ok && uint(pkcs11Err) == code
+}
@@ -0,0 +1,20 @@
+package pkcs11
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=pkcs11.go -destination=mocks/pkcs11.go -package=mocks
+
+type Client interface {
+	KeysClient
+}
+
+// KeysClient manages elliptic curve key pairs stored in a PKCS#11 token, key pairs being identified by their label
+type KeysClient interface {
+	GenerateKeyPair(ctx context.Context, label string, ecParams []byte) (ecPoint []byte, err error)
+	GetPublicKey(ctx context.Context, label string) (ecParams, ecPoint []byte, err error)
+	ListKeys(ctx context.Context) ([]string, error)
+	Sign(ctx context.Context, label string, digest []byte) ([]byte, error)
+	DestroyKeyPair(ctx context.Context, label string) error
+}
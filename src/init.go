@@ -9,6 +9,8 @@ import (
 	manifestreader "github.com/consensys/quorum-key-manager/src/infra/manifests/yaml"
 	"github.com/consensys/quorum-key-manager/src/nodes"
 	nodesapi "github.com/consensys/quorum-key-manager/src/nodes/api/manifest"
+	"github.com/consensys/quorum-key-manager/src/policies"
+	policiesapi "github.com/consensys/quorum-key-manager/src/policies/api/manifest"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	storesapi "github.com/consensys/quorum-key-manager/src/stores/api/manifest"
 	"github.com/consensys/quorum-key-manager/src/vaults"
@@ -21,6 +23,7 @@ func initialize(
 	rolesService auth.Roles,
 	vaultsService vaults.Vaults,
 	storesService stores.Stores,
+	policiesService policies.Policies,
 	nodesService nodes.Nodes,
 ) error {
 	manifestReader, err := manifestreader.New(cfg)
@@ -50,6 +53,11 @@ func initialize(
 		return err
 	}
 
+	err = policiesapi.NewPoliciesHandler(policiesService).Register(ctx, manifests[entities.PolicyKind])
+	if err != nil {
+		return err
+	}
+
 	err = nodesapi.NewNodesHandler(nodesService).Register(ctx, manifests[entities.NodeKind])
 	if err != nil {
 		return err
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"net/http"
+
+	auth "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+)
+
+type NoncesAPI struct {
+	nonces nodes.Nonces
+}
+
+func NewNoncesAPI(nonceService nodes.Nonces) *NoncesAPI {
+	return &NoncesAPI{
+		nonces: nonceService,
+	}
+}
+
+func (h *NoncesAPI) Register(router *mux.Router) {
+	nonceRouter := router.PathPrefix("/nonces/{chainID}").Subrouter()
+
+	nonceRouter.Methods(http.MethodGet).Path("/{address}").HandlerFunc(h.get)
+	nonceRouter.Methods(http.MethodDelete).Path("/{address}").HandlerFunc(h.reset)
+}
+
+// @Summary      Get the nonce of an account
+// @Description  Get the next nonce allocated to an account sending transactions through the nodes
+// @Tags         Nonces
+// @Produce      json
+// @Param        chainID  path      string                   true  "chain ID"
+// @Param        address  path      string                   true  "Ethereum address"
+// @Success      200      {object}  types.NonceResponse      "Nonce data"
+// @Failure      401      {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403      {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404      {object}  infrahttp.ErrorResponse  "Nonce not found"
+// @Failure      500      {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nonces/{chainID}/{address} [get]
+func (h *NoncesAPI) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nonce, err := h.nonces.Get(ctx, getChainID(r), getAddress(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewNonceResponse(nonce))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Reset the nonce of an account
+// @Description  Discard the nonce of an account so that the next one is taken from the node
+// @Tags         Nonces
+// @Param        chainID  path  string  true  "chain ID"
+// @Param        address  path  string  true  "Ethereum address"
+// @Success      204      "Reset successfully"
+// @Failure      401      {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403      {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404      {object}  infrahttp.ErrorResponse  "Nonce not found"
+// @Failure      500      {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /nonces/{chainID}/{address} [delete]
+func (h *NoncesAPI) reset(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.nonces.Reset(ctx, getChainID(r), getAddress(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func getChainID(r *http.Request) string {
+	return mux.Vars(r)["chainID"]
+}
+
+// getAddress returns the checksummed address the nonces are stored with
+func getAddress(r *http.Request) string {
+	return ethcommon.HexToAddress(mux.Vars(r)["address"]).Hex()
+}
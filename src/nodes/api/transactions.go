@@ -0,0 +1,146 @@
+package api
+
+import (
+	"net/http"
+
+	auth "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+)
+
+type TransactionsAPI struct {
+	transactions nodes.Transactions
+}
+
+func NewTransactionsAPI(txService nodes.Transactions) *TransactionsAPI {
+	return &TransactionsAPI{
+		transactions: txService,
+	}
+}
+
+func (h *TransactionsAPI) Register(router *mux.Router) {
+	txRouter := router.PathPrefix("/transactions").Subrouter()
+
+	txRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.listPending)
+	txRouter.Methods(http.MethodGet).Path("/{hash}").HandlerFunc(h.get)
+	txRouter.Methods(http.MethodPost).Path("/{hash}/speed-up").HandlerFunc(h.speedUp)
+	txRouter.Methods(http.MethodPost).Path("/{hash}/cancel").HandlerFunc(h.cancel)
+}
+
+// @Summary      List pending transactions
+// @Description  List the transactions of the tenant sent through the nodes and not mined yet
+// @Tags         Transactions
+// @Produce      json
+// @Success      200  {array}   types.TransactionResponse  "List of pending transactions"
+// @Failure      401  {object}  infrahttp.ErrorResponse    "Unauthorized"
+// @Failure      403  {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /transactions [get]
+func (h *TransactionsAPI) listPending(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	txs, err := h.transactions.ListPending(ctx, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewTransactionsResponse(txs))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Get a transaction
+// @Description  Get a transaction sent through the nodes and its status
+// @Tags         Transactions
+// @Produce      json
+// @Param        hash  path      string                     true  "transaction hash"
+// @Success      200   {object}  types.TransactionResponse  "Transaction data"
+// @Failure      401   {object}  infrahttp.ErrorResponse    "Unauthorized"
+// @Failure      403   {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      404   {object}  infrahttp.ErrorResponse    "Transaction not found"
+// @Failure      500   {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /transactions/{hash} [get]
+func (h *TransactionsAPI) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tx, err := h.transactions.Get(ctx, getHash(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewTransactionResponse(tx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Speed up a pending transaction
+// @Description  Replace a pending transaction by the same transaction with the same nonce and bumped fees
+// @Tags         Transactions
+// @Produce      json
+// @Param        hash  path      string                     true  "transaction hash"
+// @Success      200   {object}  types.TransactionResponse  "Replacement transaction data"
+// @Failure      401   {object}  infrahttp.ErrorResponse    "Unauthorized"
+// @Failure      403   {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      404   {object}  infrahttp.ErrorResponse    "Transaction not found"
+// @Failure      422   {object}  infrahttp.ErrorResponse    "Transaction is not pending"
+// @Failure      501   {object}  infrahttp.ErrorResponse    "Private transactions cannot be replaced"
+// @Failure      500   {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /transactions/{hash}/speed-up [post]
+func (h *TransactionsAPI) speedUp(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tx, err := h.transactions.SpeedUp(ctx, getHash(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewTransactionResponse(tx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Cancel a pending transaction
+// @Description  Replace a pending transaction by an empty transfer to its sender with the same nonce and bumped fees
+// @Tags         Transactions
+// @Produce      json
+// @Param        hash  path      string                     true  "transaction hash"
+// @Success      200   {object}  types.TransactionResponse  "Replacement transaction data"
+// @Failure      401   {object}  infrahttp.ErrorResponse    "Unauthorized"
+// @Failure      403   {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      404   {object}  infrahttp.ErrorResponse    "Transaction not found"
+// @Failure      422   {object}  infrahttp.ErrorResponse    "Transaction is not pending"
+// @Failure      501   {object}  infrahttp.ErrorResponse    "Private transactions cannot be replaced"
+// @Failure      500   {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /transactions/{hash}/cancel [post]
+func (h *TransactionsAPI) cancel(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tx, err := h.transactions.Cancel(ctx, getHash(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewTransactionResponse(tx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// getHash returns the hash the transactions are journaled with
+func getHash(r *http.Request) string {
+	return ethcommon.HexToHash(mux.Vars(r)["hash"]).Hex()
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+// NonceResponse returns the next nonce allocated to an account
+type NonceResponse struct {
+	ChainID   string    `json:"chainID" example:"1337"`
+	Address   string    `json:"address" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6"`
+	Nonce     uint64    `json:"nonce" example:"12"`
+	CreatedAt time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt time.Time `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewNonceResponse(nonce *entities.Nonce) *NonceResponse {
+	return &NonceResponse{
+		ChainID:   nonce.ChainID,
+		Address:   nonce.Address,
+		Nonce:     nonce.Value,
+		CreatedAt: nonce.CreatedAt,
+		UpdatedAt: nonce.UpdatedAt,
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TransactionResponse returns a transaction sent through a node
+type TransactionResponse struct {
+	Hash        string        `json:"hash" example:"0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"`
+	Node        string        `json:"node" example:"quorum-node"`
+	ChainID     string        `json:"chainID" example:"1337"`
+	From        string        `json:"from" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6"`
+	Nonce       uint64        `json:"nonce" example:"12"`
+	Type        string        `json:"type" example:"dynamic_fee"`
+	GasPrice    *hexutil.Big  `json:"gasPrice,omitempty" example:"0x3b9aca00" swaggertype:"string"`
+	GasFeeCap   *hexutil.Big  `json:"maxFeePerGas,omitempty" example:"0x3b9aca00" swaggertype:"string"`
+	GasTipCap   *hexutil.Big  `json:"maxPriorityFeePerGas,omitempty" example:"0x3b9aca00" swaggertype:"string"`
+	Raw         hexutil.Bytes `json:"raw" example:"0xf85380808252089488a5c2d9919e46f883eb62f7b8dd9d0cc45bc2908080820713a0a1e51e4c3a84b0c5f6a89d55a8ce3fb0b7f1d0b3d7f0e2fba9a4b5b3a05c8a29a0390b7d7bbf7e3f9c5a5d0c2a2bcd3d8a0d2e8e2c9b7d6f7e3a3b1c3a7e1b2c9d" swaggertype:"string"`
+	Status      string        `json:"status" example:"pending"`
+	ReplacedBy  string        `json:"replacedBy,omitempty" example:"0x8ed3fae3ed5a21cfe4fd5cbf50a3dd6c5d1a5cc48a8cc6f9e6a4d56cdb4b9e38"`
+	BlockNumber *uint64       `json:"blockNumber,omitempty" example:"1024"`
+	Tenant      string        `json:"tenant,omitempty" example:"tenantOne"`
+	Username    string        `json:"username,omitempty" example:"alice"`
+	CreatedAt   time.Time     `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt   time.Time     `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewTransactionResponse(tx *entities.Transaction) *TransactionResponse {
+	return &TransactionResponse{
+		Hash:        tx.Hash,
+		Node:        tx.NodeName,
+		ChainID:     tx.ChainID,
+		From:        tx.From,
+		Nonce:       tx.Nonce,
+		Type:        tx.Type,
+		GasPrice:    (*hexutil.Big)(tx.GasPrice),
+		GasFeeCap:   (*hexutil.Big)(tx.GasFeeCap),
+		GasTipCap:   (*hexutil.Big)(tx.GasTipCap),
+		Raw:         tx.Raw,
+		Status:      tx.Status,
+		ReplacedBy:  tx.ReplacedBy,
+		BlockNumber: tx.BlockNumber,
+		Tenant:      tx.Tenant,
+		Username:    tx.Username,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+}
+
+func NewTransactionsResponse(txs []*entities.Transaction) []*TransactionResponse {
+	resp := make([]*TransactionResponse, len(txs))
+	for i, tx := range txs {
+		resp[i] = NewTransactionResponse(tx)
+	}
+
+	return resp
+}
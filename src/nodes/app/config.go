@@ -0,0 +1,18 @@
+package app
+
+import "time"
+
+// TxTrackerConfig holds the configuration of the tracking of the transactions sent through the nodes
+type TxTrackerConfig struct {
+	Interval  time.Duration
+	MaxAge    time.Duration
+	BatchSize uint64
+}
+
+func NewTxTrackerConfig(interval, maxAge time.Duration, batchSize uint64) *TxTrackerConfig {
+	return &TxTrackerConfig{
+		Interval:  interval,
+		MaxAge:    maxAge,
+		BatchSize: batchSize,
+	}
+}
@@ -1,27 +1,51 @@
 package app
 
 import (
+	"github.com/consensys/quorum-key-manager/pkg/app"
 	"github.com/consensys/quorum-key-manager/src/aliases"
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
 	"github.com/consensys/quorum-key-manager/src/nodes/api"
+	db "github.com/consensys/quorum-key-manager/src/nodes/database/postgres"
 	"github.com/consensys/quorum-key-manager/src/nodes/service/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/service/nonces"
+	"github.com/consensys/quorum-key-manager/src/nodes/service/transactions"
 	"github.com/consensys/quorum-key-manager/src/stores"
-	"github.com/gorilla/mux"
 )
 
 func RegisterService(
-	router *mux.Router,
+	a *app.App,
 	logger log.Logger,
+	postgresClient postgres.Client,
 	authService auth.Roles,
 	storesService stores.Stores,
 	aliasService aliases.Aliases,
-) *nodes.Nodes {
+	txTrackerCfg *TxTrackerConfig,
+) (*nodes.Nodes, error) {
+	// Data layer
+	nonceRepository := db.NewNonce(postgresClient)
+	txRepository := db.NewTransaction(postgresClient)
+
 	// Business layer
-	nodesService := nodes.New(storesService, authService, aliasService, logger)
+	nonceService := nonces.New(nonceRepository, authService, logger)
+	nodesService := nodes.New(storesService, authService, aliasService, nonceService, txRepository, logger)
+	txService := transactions.New(txRepository, nodesService, storesService, authService, logger)
+
+	if txTrackerCfg != nil {
+		err := a.RegisterService(transactions.NewTracker(txService, txTrackerCfg.Interval, txTrackerCfg.MaxAge, txTrackerCfg.BatchSize, logger.WithComponent("tx-tracker")))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		logger.Warn("transaction tracking is disabled")
+	}
 
 	// Service layer
+	router := a.Router()
+	api.NewNoncesAPI(nonceService).Register(router)
+	api.NewTransactionsAPI(txService).Register(router)
 	api.New(nodesService).Register(router)
 
-	return nodesService
+	return nodesService, nil
 }
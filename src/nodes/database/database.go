@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Nonces interface {
+	// RunInTransaction runs persistFunc in a database transaction
+	RunInTransaction(ctx context.Context, persistFunc func(dbtx Nonces) error) error
+	// Lock acquires a lock on the nonce of an account for the duration of the current transaction
+	Lock(ctx context.Context, chainID, addr string) error
+	// FindOne gets the nonce of an account
+	FindOne(ctx context.Context, chainID, addr string) (*entities.Nonce, error)
+	// Insert inserts the nonce of an account
+	Insert(ctx context.Context, nonce *entities.Nonce) (*entities.Nonce, error)
+	// Update updates the nonce of an account
+	Update(ctx context.Context, nonce *entities.Nonce) (*entities.Nonce, error)
+	// Delete deletes the nonce of an account
+	Delete(ctx context.Context, chainID, addr string) error
+}
+
+type Transactions interface {
+	// FindOne gets a transaction by hash, restricted to the transactions of a tenant if not empty
+	FindOne(ctx context.Context, hash, tenant string) (*entities.Transaction, error)
+	// FindPending gets the pending transactions, restricted to the transactions of a tenant if not empty
+	FindPending(ctx context.Context, tenant string) ([]*entities.Transaction, error)
+	// FindTracked gets at most limit transactions of all tenants waiting for a receipt, ordered by hash after the given one
+	FindTracked(ctx context.Context, after string, limit uint64) ([]*entities.Transaction, error)
+	// DropExpired marks as dropped the tracked transactions created before a date and returns their hashes
+	DropExpired(ctx context.Context, before time.Time) ([]string, error)
+	// Insert inserts a transaction
+	Insert(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error)
+	// Update updates a transaction
+	Update(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error)
+	// DropOthers marks as dropped the tracked transactions sharing the nonce of a transaction
+	DropOthers(ctx context.Context, tx *entities.Transaction) error
+}
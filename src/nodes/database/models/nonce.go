@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+type Nonce struct {
+	tableName struct{} `pg:"nonces"` // nolint:unused,structcheck // reason
+
+	ChainID   string    `pg:",pk"`
+	Address   string    `pg:",pk"`
+	Value     uint64    `pg:",use_zero"`
+	CreatedAt time.Time `pg:"default:now()"`
+	UpdatedAt time.Time `pg:"default:now()"`
+}
+
+func NewNonce(nonce *entities.Nonce) *Nonce {
+	return &Nonce{
+		ChainID:   nonce.ChainID,
+		Address:   nonce.Address,
+		Value:     nonce.Value,
+		CreatedAt: nonce.CreatedAt,
+		UpdatedAt: nonce.UpdatedAt,
+	}
+}
+
+func (n *Nonce) ToEntity() *entities.Nonce {
+	return &entities.Nonce{
+		ChainID:   n.ChainID,
+		Address:   n.Address,
+		Value:     n.Value,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+type Transaction struct {
+	tableName struct{} `pg:"transactions"` // nolint:unused,structcheck // reason
+
+	Hash        string `pg:",pk"`
+	NodeName    string
+	ChainID     string
+	FromAddress string
+	Nonce       uint64 `pg:",use_zero"`
+	Raw         []byte
+	Type        string
+	GasPrice    string
+	GasFeeCap   string
+	GasTipCap   string
+	Status      string
+	ReplacedBy  string
+	BlockNumber *uint64
+	Tenant      string
+	Username    string
+	CreatedAt   time.Time `pg:"default:now()"`
+	UpdatedAt   time.Time `pg:"default:now()"`
+}
+
+func NewTransaction(tx *entities.Transaction) *Transaction {
+	return &Transaction{
+		Hash:        tx.Hash,
+		NodeName:    tx.NodeName,
+		ChainID:     tx.ChainID,
+		FromAddress: tx.From,
+		Nonce:       tx.Nonce,
+		Raw:         tx.Raw,
+		Type:        tx.Type,
+		GasPrice:    bigToString(tx.GasPrice),
+		GasFeeCap:   bigToString(tx.GasFeeCap),
+		GasTipCap:   bigToString(tx.GasTipCap),
+		Status:      tx.Status,
+		ReplacedBy:  tx.ReplacedBy,
+		BlockNumber: tx.BlockNumber,
+		Tenant:      tx.Tenant,
+		Username:    tx.Username,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+}
+
+func (tx *Transaction) ToEntity() *entities.Transaction {
+	return &entities.Transaction{
+		Hash:        tx.Hash,
+		NodeName:    tx.NodeName,
+		ChainID:     tx.ChainID,
+		From:        tx.FromAddress,
+		Nonce:       tx.Nonce,
+		Raw:         tx.Raw,
+		Type:        tx.Type,
+		GasPrice:    stringToBig(tx.GasPrice),
+		GasFeeCap:   stringToBig(tx.GasFeeCap),
+		GasTipCap:   stringToBig(tx.GasTipCap),
+		Status:      tx.Status,
+		ReplacedBy:  tx.ReplacedBy,
+		BlockNumber: tx.BlockNumber,
+		Tenant:      tx.Tenant,
+		Username:    tx.Username,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+}
+
+func bigToString(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+
+	return v.String()
+}
+
+func stringToBig(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
+	"github.com/consensys/quorum-key-manager/src/nodes/database/models"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+type Nonce struct {
+	pgClient postgres.Client
+}
+
+var _ database.Nonces = &Nonce{}
+
+func NewNonce(pgClient postgres.Client) *Nonce {
+	return &Nonce{pgClient: pgClient}
+}
+
+func (r Nonce) RunInTransaction(ctx context.Context, persist func(dbtx database.Nonces) error) error {
+	return r.pgClient.RunInTransaction(ctx, func(dbTx postgres.Client) error {
+		r.pgClient = dbTx
+		return persist(&r)
+	})
+}
+
+func (r *Nonce) Lock(ctx context.Context, chainID, addr string) error {
+	var count int
+	return r.pgClient.QueryOne(ctx, &count, "SELECT count(*) FROM (SELECT pg_advisory_xact_lock(hashtext(? || ?))) AS nonce_lock", chainID, addr)
+}
+
+func (r *Nonce) FindOne(ctx context.Context, chainID, addr string) (*entities.Nonce, error) {
+	nonceModel := &models.Nonce{ChainID: chainID, Address: addr}
+
+	err := r.pgClient.SelectPK(ctx, nonceModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return nonceModel.ToEntity(), nil
+}
+
+func (r *Nonce) Insert(ctx context.Context, nonce *entities.Nonce) (*entities.Nonce, error) {
+	nonceModel := models.NewNonce(nonce)
+
+	err := r.pgClient.Insert(ctx, nonceModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return nonceModel.ToEntity(), nil
+}
+
+func (r *Nonce) Update(ctx context.Context, nonce *entities.Nonce) (*entities.Nonce, error) {
+	nonceModel := models.NewNonce(nonce)
+	nonceModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.UpdatePK(ctx, nonceModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return nonceModel.ToEntity(), nil
+}
+
+func (r *Nonce) Delete(ctx context.Context, chainID, addr string) error {
+	return r.pgClient.DeletePK(ctx, &models.Nonce{ChainID: chainID, Address: addr})
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
+	"github.com/consensys/quorum-key-manager/src/nodes/database/models"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+type Transaction struct {
+	pgClient postgres.Client
+}
+
+var _ database.Transactions = &Transaction{}
+
+func NewTransaction(pgClient postgres.Client) *Transaction {
+	return &Transaction{pgClient: pgClient}
+}
+
+func (r *Transaction) FindOne(ctx context.Context, hash, tenant string) (*entities.Transaction, error) {
+	txModel := &models.Transaction{}
+
+	query, params := r.whereTenant("hash = ?", tenant, hash)
+	err := r.pgClient.SelectWhere(ctx, txModel, query, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return txModel.ToEntity(), nil
+}
+
+func (r *Transaction) FindPending(ctx context.Context, tenant string) ([]*entities.Transaction, error) {
+	var txModels []*models.Transaction
+
+	query, params := r.whereTenant("status = ?", tenant, entities.PendingTxStatus)
+	err := r.pgClient.SelectWhere(ctx, &txModels, query, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntities(txModels), nil
+}
+
+func (r *Transaction) FindTracked(ctx context.Context, after string, limit uint64) ([]*entities.Transaction, error) {
+	var txModels []*models.Transaction
+
+	err := r.pgClient.SelectWhere(
+		ctx,
+		&txModels,
+		"hash IN (SELECT hash FROM transactions WHERE status IN (?, ?) AND hash > ? ORDER BY hash LIMIT ?)",
+		[]string{},
+		entities.PendingTxStatus, entities.ReplacedTxStatus, after, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntities(txModels), nil
+}
+
+func (r *Transaction) DropExpired(ctx context.Context, before time.Time) ([]string, error) {
+	var hashes []string
+
+	err := r.pgClient.Query(
+		ctx,
+		&hashes,
+		"UPDATE transactions SET status = ?, updated_at = now() WHERE status IN (?, ?) AND created_at < ? RETURNING hash",
+		entities.DroppedTxStatus, entities.PendingTxStatus, entities.ReplacedTxStatus, before,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+func (r *Transaction) Insert(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error) {
+	txModel := models.NewTransaction(tx)
+
+	err := r.pgClient.Insert(ctx, txModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return txModel.ToEntity(), nil
+}
+
+func (r *Transaction) Update(ctx context.Context, tx *entities.Transaction) (*entities.Transaction, error) {
+	txModel := models.NewTransaction(tx)
+	txModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.UpdatePK(ctx, txModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return txModel.ToEntity(), nil
+}
+
+func (r *Transaction) DropOthers(ctx context.Context, tx *entities.Transaction) error {
+	// The nonce is always written as zero values are, so it is set to the shared one
+	txModel := &models.Transaction{Status: entities.DroppedTxStatus, Nonce: tx.Nonce, UpdatedAt: time.Now()}
+
+	err := r.pgClient.UpdateWhere(
+		ctx,
+		txModel,
+		"chain_id = ? AND from_address = ? AND nonce = ? AND hash != ? AND status IN (?, ?)",
+		tx.ChainID, tx.From, tx.Nonce, tx.Hash, entities.PendingTxStatus, entities.ReplacedTxStatus,
+	)
+	if err != nil && !errors.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Transaction) whereTenant(query, tenant string, params ...interface{}) (string, []interface{}) {
+	if tenant != "" {
+		return query + " AND tenant = ?", append(params, tenant)
+	}
+
+	return query, params
+}
+
+func toEntities(txModels []*models.Transaction) []*entities.Transaction {
+	txs := make([]*entities.Transaction, len(txModels))
+	for i, txModel := range txModels {
+		txs[i] = txModel.ToEntity()
+	}
+
+	return txs
+}
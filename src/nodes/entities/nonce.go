@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// Nonce is the next nonce to be used by an Ethereum account on a chain
+type Nonce struct {
+	ChainID   string
+	Address   string
+	Value     uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"math/big"
+	"time"
+)
+
+const (
+	LegacyTxType     = "legacy"
+	DynamicFeeTxType = "dynamic_fee"
+	PrivateTxType    = "private"
+	EEATxType        = "eea"
+)
+
+const (
+	PendingTxStatus  = "pending"
+	MinedTxStatus    = "mined"
+	FailedTxStatus   = "failed"
+	ReplacedTxStatus = "replaced"
+	DroppedTxStatus  = "dropped"
+)
+
+// Transaction is a transaction sent through a node, journaled until it is mined
+type Transaction struct {
+	Hash        string
+	NodeName    string
+	ChainID     string
+	From        string
+	Nonce       uint64
+	Raw         []byte
+	Type        string
+	GasPrice    *big.Int
+	GasFeeCap   *big.Int
+	GasTipCap   *big.Int
+	Status      string
+	ReplacedBy  string
+	BlockNumber *uint64
+	Tenant      string
+	Username    string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	nodesentities "github.com/consensys/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -161,7 +162,7 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 	// Sign
 	sig, err := store.SignEEA(ctx, msg.From, chainID, msg.TxData(), &msg.PrivateArgs)
 	if err != nil {
-		return nil, err
+		return nil, signingError(err)
 	}
 
 	// Submit transaction to downstream node
@@ -172,6 +173,14 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 	}
 
 	i.logger.Info("EEA transaction sent successfully", "tx_hash", hash)
+	i.recordTx(ctx, sess, &nodesentities.Transaction{
+		Hash:     hash.Hex(),
+		From:     msg.From.Hex(),
+		Nonce:    *msg.Nonce,
+		Raw:      sig,
+		Type:     nodesentities.EEATxType,
+		GasPrice: msg.GasPrice,
+	})
 	return &hash, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	nodesentities "github.com/consensys/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -21,7 +22,7 @@ func TestEEASendTransaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	i, stores, aliases := newInterceptor(ctrl)
+	i, stores, aliases, txDB := newInterceptor(ctrl)
 	accountsStore := mockaccounts.NewMockEthStore(ctrl)
 
 	userInfo := &entities.UserInfo{
@@ -55,7 +56,7 @@ func TestEEASendTransaction(t *testing.T) {
 				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
 
 				// Get ChainID
-				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil)
+				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil).Times(2)
 
 				// Get Gas price
 				ethCaller.EXPECT().GasPrice(gomock.Any()).Return(big.NewInt(1000000000), nil)
@@ -72,6 +73,20 @@ func TestEEASendTransaction(t *testing.T) {
 				// SendRawTransaction
 				eeaCaller.EXPECT().SendRawTransaction(gomock.Any(), ethcommon.FromHex("0xa6122e27")).Return(ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
 
+				// Journal transaction
+				txDB.EXPECT().Insert(gomock.Any(), &nodesentities.Transaction{
+					Hash:     "0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778",
+					NodeName: "my-node",
+					ChainID:  "1998",
+					From:     expectedFrom.Hex(),
+					Nonce:    5,
+					Raw:      ethcommon.FromHex("0xa6122e27"),
+					Type:     nodesentities.EEATxType,
+					GasPrice: big.NewInt(1000000000),
+					Status:   nodesentities.PendingTxStatus,
+					Username: "username",
+				}).Return(nil, nil)
+
 				aliases.EXPECT().Replace(gomock.Any(), []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="}, userInfo).Return([]string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="}, nil)
 				aliases.EXPECT().ReplaceSimple(gomock.Any(), "GGilEkXLaQ9yhhtbpBT03Me9iYa7U/mWXxrJhnbl1XY=", userInfo).Return("GGilEkXLaQ9yhhtbpBT03Me9iYa7U/mWXxrJhnbl1XY=", nil)
 				aliases.EXPECT().Parse("kAbelwaVW7okoEn1+okO+AbA4Hhz/7DaCOWVQz9nx5M=").Return("", "", false)
@@ -89,7 +104,7 @@ func TestEEASendTransaction(t *testing.T) {
 				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
 
 				// Get ChainID
-				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil)
+				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil).Times(2)
 
 				// Get Gas price
 				ethCaller.EXPECT().GasPrice(gomock.Any()).Return(big.NewInt(1000000000), nil)
@@ -104,6 +119,20 @@ func TestEEASendTransaction(t *testing.T) {
 				accountsStore.EXPECT().SignEEA(gomock.Any(), expectedFrom, big.NewInt(1998), gomock.Any(), expectedPrivateArgs).Return(ethcommon.FromHex("0xa6122e27"), nil)
 
 				eeaCaller.EXPECT().SendRawTransaction(gomock.Any(), ethcommon.FromHex("0xa6122e27")).Return(ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
+
+				// Journal transaction
+				txDB.EXPECT().Insert(gomock.Any(), &nodesentities.Transaction{
+					Hash:     "0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778",
+					NodeName: "my-node",
+					ChainID:  "1998",
+					From:     expectedFrom.Hex(),
+					Nonce:    5,
+					Raw:      ethcommon.FromHex("0xa6122e27"),
+					Type:     nodesentities.EEATxType,
+					GasPrice: big.NewInt(1000000000),
+					Status:   nodesentities.PendingTxStatus,
+					Username: "username",
+				}).Return(nil, nil)
 			},
 			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778","error":null,"id":"abcd"}`),
 		},
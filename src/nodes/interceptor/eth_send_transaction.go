@@ -11,6 +11,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	nodesentities "github.com/consensys/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 )
 
@@ -28,14 +29,13 @@ func (i *Interceptor) ethSendTransaction(ctx context.Context, msg *ethereum.Send
 	}
 }
 
-func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
+func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg) (_ *ethcommon.Hash, err error) {
 	i.logger.Debug("sending Quorum private transaction")
 
 	sess := proxynode.SessionFromContext(ctx)
 	userInfo := http.UserInfoFromContext(ctx)
 
 	// get the alias in PrivateFrom if any
-	var err error
 	*msg.PrivateFrom, err = i.aliases.ReplaceSimple(ctx, *msg.PrivateFrom, userInfo)
 	if err != nil {
 		i.logger.WithError(err).Error("failed to replace alias")
@@ -58,10 +58,17 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 		return nil, err
 	}
 
-	err = i.fillNonce(ctx, sess, msg)
+	allocated, err := i.fillNonce(ctx, sess, msg)
 	if err != nil {
 		return nil, err
 	}
+	if allocated {
+		defer func() {
+			if err != nil {
+				i.releaseNonce(ctx, sess, msg.From, *msg.Nonce)
+			}
+		}()
+	}
 
 	if msg.Data == nil {
 		msg.Data = new([]byte)
@@ -112,10 +119,18 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 	}
 
 	i.logger.Info("quorum private transaction sent successfully", "tx_hash", hash)
+	i.recordTx(ctx, sess, &nodesentities.Transaction{
+		Hash:     hash.Hex(),
+		From:     msg.From.Hex(),
+		Nonce:    *msg.Nonce,
+		Raw:      *raw,
+		Type:     nodesentities.PrivateTxType,
+		GasPrice: msg.GasPrice,
+	})
 	return &hash, nil
 }
 
-func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
+func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg) (_ *ethcommon.Hash, err error) {
 	i.logger.Debug("sending ETH legacy transaction")
 
 	sess := proxynode.SessionFromContext(ctx)
@@ -130,15 +145,22 @@ func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg)
 		msg.GasPrice = gasPrice
 	}
 
-	err := i.fillGas(ctx, sess, msg)
+	err = i.fillGas(ctx, sess, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	err = i.fillNonce(ctx, sess, msg)
+	allocated, err := i.fillNonce(ctx, sess, msg)
 	if err != nil {
 		return nil, err
 	}
+	if allocated {
+		defer func() {
+			if err != nil {
+				i.releaseNonce(ctx, sess, msg.From, *msg.Nonce)
+			}
+		}()
+	}
 
 	raw, err := i.ethSignTransaction(ctx, msg)
 	if err != nil {
@@ -152,10 +174,18 @@ func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg)
 	}
 
 	i.logger.Info("legacy transaction sent successfully", "tx_hash", hash)
+	i.recordTx(ctx, sess, &nodesentities.Transaction{
+		Hash:     hash.Hex(),
+		From:     msg.From.Hex(),
+		Nonce:    *msg.Nonce,
+		Raw:      *raw,
+		Type:     nodesentities.LegacyTxType,
+		GasPrice: msg.GasPrice,
+	})
 	return &hash, nil
 }
 
-func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
+func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (_ *ethcommon.Hash, err error) {
 	i.logger.Debug("sending ETH transaction")
 
 	sess := proxynode.SessionFromContext(ctx)
@@ -187,10 +217,17 @@ func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*eth
 		return nil, err
 	}
 
-	err = i.fillNonce(ctx, sess, msg)
+	allocated, err := i.fillNonce(ctx, sess, msg)
 	if err != nil {
 		return nil, err
 	}
+	if allocated {
+		defer func() {
+			if err != nil {
+				i.releaseNonce(ctx, sess, msg.From, *msg.Nonce)
+			}
+		}()
+	}
 
 	raw, err := i.ethSignTransaction(ctx, msg)
 	if err != nil {
@@ -204,6 +241,15 @@ func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*eth
 	}
 
 	i.logger.Info("ETH transaction sent successfully", "tx_hash", hash)
+	i.recordTx(ctx, sess, &nodesentities.Transaction{
+		Hash:      hash.Hex(),
+		From:      msg.From.Hex(),
+		Nonce:     *msg.Nonce,
+		Raw:       *raw,
+		Type:      nodesentities.DynamicFeeTxType,
+		GasFeeCap: msg.GasFeeCap,
+		GasTipCap: msg.GasTipCap,
+	})
 	return &hash, nil
 }
 
@@ -231,18 +277,67 @@ func (i *Interceptor) fillGas(ctx context.Context, sess proxynode.Session, msg *
 	return nil
 }
 
-func (i *Interceptor) fillNonce(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg) error {
-	if msg.Nonce == nil {
-		n, err := sess.EthCaller().Eth().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber)
-		if err != nil {
-			i.logger.WithError(err).Error("failed to fetch nonce", "from_account", msg.From)
-			return errors.BlockchainNodeError(err.Error())
-		}
+// fillNonce allocates the next nonce of the sender if the nonce is not specified, and indicates whether it did so
+func (i *Interceptor) fillNonce(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg) (bool, error) {
+	if msg.Nonce != nil {
+		return false, nil
+	}
 
-		msg.Nonce = &n
+	pendingNonce, err := sess.EthCaller().Eth().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to fetch nonce", "from_account", msg.From)
+		return false, errors.BlockchainNodeError(err.Error())
 	}
 
-	return nil
+	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to fetch chainID")
+		return false, errors.BlockchainNodeError(err.Error())
+	}
+
+	n, err := i.nonces.Next(ctx, chainID.String(), msg.From.Hex(), pendingNonce)
+	if err != nil {
+		return false, err
+	}
+
+	msg.Nonce = &n
+	return true, nil
+}
+
+// releaseNonce rolls back the nonce allocated to a transaction that could not be sent
+func (i *Interceptor) releaseNonce(ctx context.Context, sess proxynode.Session, from ethcommon.Address, nonce uint64) {
+	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to fetch chainID")
+		return
+	}
+
+	_ = i.nonces.Release(ctx, chainID.String(), from.Hex(), nonce)
+}
+
+// recordTx journals a transaction sent to the node so that it is tracked until mined. As the transaction is already sent,
+// failing to journal it is only logged
+func (i *Interceptor) recordTx(ctx context.Context, sess proxynode.Session, tx *nodesentities.Transaction) {
+	logger := i.logger.With("tx_hash", tx.Hash)
+
+	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to fetch chainID, transaction not journaled")
+		return
+	}
+
+	tx.NodeName = i.name
+	tx.ChainID = chainID.String()
+	tx.Status = nodesentities.PendingTxStatus
+	if userInfo := http.UserInfoFromContext(ctx); userInfo != nil {
+		tx.Tenant = userInfo.Tenant
+		tx.Username = userInfo.Username
+	}
+
+	_, err = i.txDB.Insert(ctx, tx)
+	if err != nil {
+		logger.WithError(err).Error("failed to journal transaction")
+	}
 }
 
 func (i *Interceptor) EthSendTransaction() jsonrpc.Handler {
@@ -2,6 +2,7 @@ package interceptor
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -10,6 +11,9 @@ import (
 	aliasmock "github.com/consensys/quorum-key-manager/src/aliases/mock"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	dbmock "github.com/consensys/quorum-key-manager/src/nodes/database/mock"
+	nodesentities "github.com/consensys/quorum-key-manager/src/nodes/entities"
+	nodesmock "github.com/consensys/quorum-key-manager/src/nodes/mock"
 	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +37,8 @@ func TestEthSendTransaction(t *testing.T) {
 	accountsStore := mockaccounts.NewMockEthStore(ctrl)
 	stores := mockaccounts.NewMockStores(ctrl)
 	aliases := aliasmock.NewMockAliases(ctrl)
+	nonces := nodesmock.NewMockNonces(ctrl)
+	txDB := dbmock.NewMockTransactions(ctrl)
 
 	hexFrom := "0x78e6e236592597c09d5c137c2af40aecd42d12a2"
 	from := ethcommon.HexToAddress(hexFrom)
@@ -54,7 +60,7 @@ func TestEthSendTransaction(t *testing.T) {
 	session.EXPECT().ClientPrivTxManager().Return(tesseraClient).AnyTimes()
 	stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil).AnyTimes()
 
-	i := New(stores, aliases, testutils.NewMockLogger(ctrl))
+	i := New("my-node", stores, aliases, nonces, txDB, testutils.NewMockLogger(ctrl))
 
 	t.Run("should send a private tx successfully", func(t *testing.T) {
 		privateFor := []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="}
@@ -80,10 +86,12 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().GasPrice(ctx).Return(gasPrice, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
+		nonces.EXPECT().Next(gomock.Any(), chainID.String(), from.Hex(), uint64(0)).Return(uint64(0), nil)
 		tesseraClient.EXPECT().StoreRaw(ctx, *expectedData, *msg.PrivateFrom).Return(ethcommon.FromHex("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
+		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any(), gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawPrivateTransaction(ctx, expectedSignedTx, privateArgs).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, nil)
 		aliases.EXPECT().ReplaceSimple(gomock.Any(), privateFrom, userInfo).Return(privateFrom, nil)
 		aliases.EXPECT().Replace(gomock.Any(), privateFor, userInfo).Return(privateFor, nil)
 
@@ -118,10 +126,12 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().GasPrice(ctx).Return(gasPrice, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
+		nonces.EXPECT().Next(gomock.Any(), chainID.String(), from.Hex(), uint64(0)).Return(uint64(0), nil)
 		tesseraClient.EXPECT().StoreRaw(ctx, *expectedData, *msg.PrivateFrom).Return(ethcommon.FromHex("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
+		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any(), gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawPrivateTransaction(ctx, expectedSignedTx, privateArgs).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, nil)
 		aliases.EXPECT().ReplaceSimple(gomock.Any(), privateFrom, userInfo).Return(privateFrom, nil)
 		aliases.EXPECT().Replace(gomock.Any(), privateFor, userInfo).Return(privateForExp, nil)
 
@@ -164,10 +174,12 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().GasPrice(ctx).Return(gasPrice, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
+		nonces.EXPECT().Next(gomock.Any(), chainID.String(), from.Hex(), uint64(0)).Return(uint64(0), nil)
 		tesseraClient.EXPECT().StoreRaw(ctx, *expectedData, *msg.PrivateFrom).Return(ethcommon.FromHex("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
-		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
+		accountsStore.EXPECT().SignPrivate(ctx, msg.From, gomock.Any(), gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawPrivateTransaction(gomock.Any(), expectedSignedTx, privateArgsExp).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, nil)
 		aliases.EXPECT().Replace(gomock.Any(), []string{*privateArgs.PrivacyGroupID}, userInfo).Return(privateForExp, nil)
 		aliases.EXPECT().ReplaceSimple(gomock.Any(), privateFrom, userInfo).Return(privateFrom, nil)
 
@@ -194,9 +206,23 @@ func TestEthSendTransaction(t *testing.T) {
 
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
+		nonces.EXPECT().Next(gomock.Any(), chainID.String(), from.Hex(), uint64(0)).Return(uint64(0), nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
 		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), &nodesentities.Transaction{
+			Hash:     expectedHash.Hex(),
+			NodeName: "my-node",
+			ChainID:  chainID.String(),
+			From:     from.Hex(),
+			Nonce:    0,
+			Raw:      expectedSignedTx,
+			Type:     nodesentities.LegacyTxType,
+			GasPrice: gasPrice,
+			Status:   nodesentities.PendingTxStatus,
+			Tenant:   userInfo.Tenant,
+			Username: userInfo.Username,
+		}).Return(nil, nil)
 
 		hash, err := i.ethSendTransaction(ctx, msg)
 		require.NoError(t, err)
@@ -224,9 +250,11 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().BaseFeePerGas(ctx, ethereum.LatestBlockNumber).Return(gasPrice, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
+		nonces.EXPECT().Next(gomock.Any(), chainID.String(), from.Hex(), uint64(0)).Return(uint64(0), nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
 		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, nil)
 
 		hash, err := i.ethSendTransaction(ctx, msg)
 		require.NoError(t, err)
@@ -253,13 +281,90 @@ func TestEthSendTransaction(t *testing.T) {
 		ethCaller.EXPECT().GasPrice(ctx).Return(gasPrice, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
-		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
+		nonces.EXPECT().Next(gomock.Any(), chainID.String(), from.Hex(), uint64(0)).Return(uint64(0), nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
 		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
 		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, nil)
 
 		hash, err := i.ethSendTransaction(ctx, msg)
 		require.NoError(t, err)
 
 		assert.Equal(t, hash.Hex(), expectedHash.Hex())
 	})
+
+	t.Run("should use the nonce of the message without allocating one", func(t *testing.T) {
+		nonce := uint64(7)
+		gas := uint64(21000)
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+			Gas:      &gas,
+			Nonce:    &nonce,
+		}
+		expectedSignedTx := []byte("mysignature")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash.Hex(), expectedHash.Hex())
+	})
+
+	t.Run("should return the hash of a sent tx even if it fails to be journaled", func(t *testing.T) {
+		nonce := uint64(7)
+		gas := uint64(21000)
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+			Gas:      &gas,
+			Nonce:    &nonce,
+		}
+		expectedSignedTx := []byte("mysignature")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(2)
+		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+		txDB.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash.Hex(), expectedHash.Hex())
+	})
+
+	t.Run("should allocate the next nonce and release it if the tx fails to be sent", func(t *testing.T) {
+		msg := &ethereum.SendTxMsg{
+			From:     from,
+			GasPrice: gasPrice,
+		}
+		expectedEstimateGasCall := &ethereum.CallMsg{
+			From:     &msg.From,
+			To:       msg.To,
+			Value:    msg.Value,
+			Data:     msg.Data,
+			GasPrice: gasPrice,
+		}
+		expectedSignedTx := []byte("mysignature")
+
+		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
+		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(3), nil)
+		nonces.EXPECT().Next(gomock.Any(), chainID.String(), from.Hex(), uint64(3)).Return(uint64(5), nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).Times(3)
+		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(ethcommon.Hash{}, fmt.Errorf("nonce too low"))
+		nonces.EXPECT().Release(gomock.Any(), chainID.String(), from.Hex(), uint64(5)).Return(nil)
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.Error(t, err)
+
+		assert.Nil(t, hash)
+		assert.Equal(t, uint64(5), *msg.Nonce)
+	})
 }
@@ -50,14 +50,14 @@ func (i *Interceptor) ethSignTransaction(ctx context.Context, msg *ethereum.Send
 	var sig []byte
 	switch {
 	case msg.IsPrivate():
-		sig, err = store.SignPrivate(ctx, msg.From, msg.TxDataQuorum())
+		sig, err = store.SignPrivate(ctx, msg.From, chainID, msg.TxDataQuorum())
 	case msg.IsLegacy():
 		sig, err = store.SignTransaction(ctx, msg.From, chainID, msg.TxData(types.LegacyTxType, chainID))
 	default:
 		sig, err = store.SignTransaction(ctx, msg.From, chainID, msg.TxData(types.DynamicFeeTxType, chainID))
 	}
 	if err != nil {
-		return nil, err
+		return nil, signingError(err)
 	}
 
 	i.logger.Info("ETH transaction signed successfully")
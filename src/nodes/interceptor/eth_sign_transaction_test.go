@@ -7,6 +7,7 @@ import (
 
 	"github.com/consensys/quorum-key-manager/src/auth/api/http"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
@@ -19,7 +20,7 @@ func TestEthSignTransaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	i, stores, _ := newInterceptor(ctrl)
+	i, stores, _, _ := newInterceptor(ctrl)
 	accountsStore := mockaccounts.NewMockEthStore(ctrl)
 
 	session := proxynode.NewMockSession(ctrl)
@@ -63,11 +64,26 @@ func TestEthSignTransaction(t *testing.T) {
 
 				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
 				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil)
-				accountsStore.EXPECT().SignPrivate(gomock.Any(), expectedFrom, gomock.Any()).Return(ethcommon.FromHex("0xa6122e27"), nil)
+				accountsStore.EXPECT().SignPrivate(gomock.Any(), expectedFrom, big.NewInt(1998), gomock.Any()).Return(ethcommon.FromHex("0xa6122e27"), nil)
 			},
 			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9184e72a000","nonce":"0x5","data":"0x5208","value":"0x1","privateFrom":"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s="}]}`),
 			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
 		},
+		{
+			desc:    "Transaction rejected by policy",
+			handler: i,
+			ctx:     ctx,
+			prepare: func() {
+				expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil)
+				accountsStore.EXPECT().SignTransaction(gomock.Any(), expectedFrom, big.NewInt(1998), gomock.Any()).
+					Return(nil, errors.PolicyViolationError("my-policy", "max_value", "value 1 exceeds the maximum of 0"))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9172a000","nonce":"0x5","data":"0x5208","value":"0x1"}]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32003,"message":"Transaction rejected","data":{"message":"IR610: value 1 exceeds the maximum of 0","policy":"my-policy","rule":"max_value"}},"id":null}`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+func (i *Interceptor) ethSignTypedData(ctx context.Context, account string, data json.RawMessage) (*hexutil.Bytes, error) {
+	from, err := i.resolveAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := i.logger.With("from_account", from.Hex())
+	logger.Debug("signing typed data")
+
+	// Wallets send the typed data either as a JSON object or as its JSON encoded string
+	var encoded string
+	if json.Unmarshal(data, &encoded) == nil {
+		data = json.RawMessage(encoded)
+	}
+
+	typedData := &core.TypedData{}
+	err = json.Unmarshal(data, typedData)
+	if err != nil {
+		errMessage := "invalid typed data"
+		logger.WithError(err).Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	store, err := i.stores.EthereumByAddr(ctx, from, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := store.SignTypedData(ctx, from, typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("typed data signed successfully")
+	return (*hexutil.Bytes)(&sig), nil
+}
+
+func (i *Interceptor) EthSignTypedData() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.ethSignTypedData)
+	return h
+}
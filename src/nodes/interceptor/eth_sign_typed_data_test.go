@@ -0,0 +1,91 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+)
+
+const typedDataJSON = `{"types":{"EIP712Domain":[{"name":"name","type":"string"}],"Mail":[{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{"name":"Ether Mail"},"message":{"contents":"Hello, Bob!"}}`
+
+func TestEthSignTypedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &entities.UserInfo{
+		Username:    "username",
+		Roles:       []string{"role1", "role2"},
+		Permissions: []entities.Permission{"write:key", "read:key", "sign:key"},
+	}
+
+	session := proxynode.NewMockSession(ctrl)
+	i, stores, aliases, _ := newInterceptor(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = http.WithUserInfo(ctx, userInfo)
+
+	expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Signature with typed data object",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				aliases.EXPECT().Parse("0x78e6e236592597c09d5c137c2af40aecd42d12a2").Return("", "", false)
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, gomock.Any()).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", %s]}`, typedDataJSON)),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Signature with JSON encoded typed data and alias",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				aliases.EXPECT().Parse("{{my-registry:my-account}}").Return("my-registry", "my-account", true)
+				aliases.EXPECT().ReplaceSimple(gomock.Any(), "{{my-registry:my-account}}", userInfo).Return("0x78e6e236592597c09d5c137c2af40aecd42d12a2", nil)
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, gomock.Any()).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["{{my-registry:my-account}}", %q]}`, typedDataJSON)),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Invalid typed data",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				aliases.EXPECT().Parse("0x78e6e236592597c09d5c137c2af40aecd42d12a2").Return("", "", false)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", "not typed data"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: invalid typed data"}},"id":null}`),
+		},
+		{
+			desc:    "Error signing",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				aliases.EXPECT().Parse("0x78e6e236592597c09d5c137c2af40aecd42d12a2").Return("", "", false)
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, gomock.Any()).Return(nil, fmt.Errorf("error signing"))
+			},
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", %s]}`, typedDataJSON)),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"error signing"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
@@ -1,18 +1,28 @@
 package interceptor
 
 import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	"github.com/consensys/quorum-key-manager/src/aliases"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	"github.com/consensys/quorum-key-manager/src/stores"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 type Interceptor struct {
+	name    string
 	stores  stores.Stores
 	handler jsonrpc.Handler
 	logger  log.Logger
 	aliases aliases.Aliases
+	nonces  nodes.Nonces
+	txDB    database.Transactions
 }
 
 func (i *Interceptor) ServeRPC(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
@@ -29,18 +39,54 @@ func (i *Interceptor) newHandler() jsonrpc.Handler {
 	v2Router.Method("eth_sendTransaction").Handle(i.EthSendTransaction())
 	v2Router.Method("eth_sign").Handle(i.EthSign())
 	v2Router.Method("eth_signTransaction").Handle(i.EthSignTransaction())
+	v2Router.Method("eth_signTypedData_v4").Handle(i.EthSignTypedData())
 	v2Router.Method("eea_sendTransaction").Handle(i.EEASendTransaction())
 
-	// Silence JSON-RPC personal
+	v2Router.Method("personal_sign").Handle(i.PersonalSign())
+
+	// Silence other JSON-RPC personal
 	v2Router.MethodPrefix("personal_").Handle(jsonrpc.MethodNotFoundHandler())
 
 	return jsonrpc.LoggedHandler(jsonrpc.DefaultRWHandler(router), i.logger)
 }
 
-func New(storesConnector stores.Stores, aliasService aliases.Aliases, logger log.Logger) *Interceptor {
+// resolveAccount returns the address of an account given either as an address or as an alias
+func (i *Interceptor) resolveAccount(ctx context.Context, account string) (ethcommon.Address, error) {
+	if _, _, isAlias := i.aliases.Parse(account); isAlias {
+		var err error
+		account, err = i.aliases.ReplaceSimple(ctx, account, http.UserInfoFromContext(ctx))
+		if err != nil {
+			i.logger.WithError(err).Error("failed to replace alias")
+			return ethcommon.Address{}, err
+		}
+	}
+
+	if !ethcommon.IsHexAddress(account) {
+		errMessage := "invalid account"
+		i.logger.Error(errMessage, "account", account)
+		return ethcommon.Address{}, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	return ethcommon.HexToAddress(account), nil
+}
+
+// signingError returns the violations of signing policies as JSON-RPC errors naming the violated rule
+func signingError(err error) error {
+	if !errors.IsPolicyViolationError(err) {
+		return err
+	}
+
+	ierr := errors.FromError(err)
+	return jsonrpc.TransactionRejectedError(ierr, ierr.GetData())
+}
+
+func New(nodeName string, storesConnector stores.Stores, aliasService aliases.Aliases, nonceService nodes.Nonces, txDB database.Transactions, logger log.Logger) *Interceptor {
 	i := &Interceptor{
+		name:    nodeName,
 		stores:  storesConnector,
 		aliases: aliasService,
+		nonces:  nonceService,
+		txDB:    txDB,
 		logger:  logger,
 	}
 
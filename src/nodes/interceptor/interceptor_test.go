@@ -11,18 +11,21 @@ import (
 
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	aliasmock "github.com/consensys/quorum-key-manager/src/aliases/mock"
+	dbmock "github.com/consensys/quorum-key-manager/src/nodes/database/mock"
+	nodesmock "github.com/consensys/quorum-key-manager/src/nodes/mock"
 	mockstoremanager "github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func newInterceptor(ctrl *gomock.Controller) (*Interceptor, *mockstoremanager.MockStores, *aliasmock.MockAliases) {
+func newInterceptor(ctrl *gomock.Controller) (*Interceptor, *mockstoremanager.MockStores, *aliasmock.MockAliases, *dbmock.MockTransactions) {
 	stores := mockstoremanager.NewMockStores(ctrl)
 	aliases := aliasmock.NewMockAliases(ctrl)
-	i := New(stores, aliases, testutils.NewMockLogger(ctrl))
+	txDB := dbmock.NewMockTransactions(ctrl)
+	i := New("my-node", stores, aliases, nodesmock.NewMockNonces(ctrl), txDB, testutils.NewMockLogger(ctrl))
 
-	return i, stores, aliases
+	return i, stores, aliases, txDB
 }
 
 type testHandlerCase struct {
@@ -60,7 +63,7 @@ func TestPersonal(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	i, _, _ := newInterceptor(ctrl)
+	i, _, _, _ := newInterceptor(ctrl)
 	tests := []*testHandlerCase{
 		{
 			desc:             "Personal",
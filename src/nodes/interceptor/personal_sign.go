@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// personalSign ignores the optional password parameter as accounts are unlocked by QKM authorization
+func (i *Interceptor) personalSign(ctx context.Context, data hexutil.Bytes, account string) (*hexutil.Bytes, error) {
+	from, err := i.resolveAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := i.logger.With("from_account", from.Hex())
+	logger.Debug("signing message")
+
+	store, err := i.stores.EthereumByAddr(ctx, from, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := store.SignMessage(ctx, from, data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("message signed successfully")
+	return (*hexutil.Bytes)(&sig), nil
+}
+
+func (i *Interceptor) PersonalSign() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.personalSign)
+	return h
+}
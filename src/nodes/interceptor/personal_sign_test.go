@@ -0,0 +1,88 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+)
+
+func TestPersonalSign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &entities.UserInfo{
+		Username:    "username",
+		Roles:       []string{"role1", "role2"},
+		Permissions: []entities.Permission{"write:key", "read:key", "sign:key"},
+	}
+
+	session := proxynode.NewMockSession(ctrl)
+	i, stores, aliases, _ := newInterceptor(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = http.WithUserInfo(ctx, userInfo)
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Signature",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+				aliases.EXPECT().Parse("0x78e6e236592597c09d5c137c2af40aecd42d12a2").Return("", "", false)
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignMessage(gomock.Any(), expectedFrom, ethcommon.FromHex("0x2eadbe1f")).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f", "0x78e6e236592597c09d5c137c2af40aecd42d12a2", "password"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Signature with alias",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+				aliases.EXPECT().Parse("{{my-registry:my-account}}").Return("my-registry", "my-account", true)
+				aliases.EXPECT().ReplaceSimple(gomock.Any(), "{{my-registry:my-account}}", userInfo).Return("0x78e6e236592597c09d5c137c2af40aecd42d12a2", nil)
+				stores.EXPECT().EthereumByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignMessage(gomock.Any(), expectedFrom, ethcommon.FromHex("0x2eadbe1f")).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f", "{{my-registry:my-account}}"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Invalid account",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				aliases.EXPECT().Parse("my-account").Return("", "", false)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f", "my-account"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: invalid account"}},"id":null}`),
+		},
+		{
+			desc:    "Alias not found",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				aliases.EXPECT().Parse("{{my-registry:my-account}}").Return("my-registry", "my-account", true)
+				aliases.EXPECT().ReplaceSimple(gomock.Any(), "{{my-registry:my-account}}", userInfo).Return("", errors.NotFoundError("alias not found"))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f", "{{my-registry:my-account}}"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"ST100: alias not found"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
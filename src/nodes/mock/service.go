@@ -7,9 +7,11 @@ package mock
 import (
 	context "context"
 	entities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	entities0 "github.com/consensys/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockNodes is a mock of Nodes interface
@@ -78,3 +80,196 @@ func (mr *MockNodesMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNodes)(nil).List), ctx, userInfo)
 }
+
+// Session mocks base method
+func (m *MockNodes) Session(ctx context.Context, name string, userInfo *entities.UserInfo) (proxynode.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Session", ctx, name, userInfo)
+	ret0, _ := ret[0].(proxynode.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Session indicates an expected call of Session
+func (mr *MockNodesMockRecorder) Session(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MockNodes)(nil).Session), ctx, name, userInfo)
+}
+
+// MockNonces is a mock of Nonces interface
+type MockNonces struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoncesMockRecorder
+}
+
+// MockNoncesMockRecorder is the mock recorder for MockNonces
+type MockNoncesMockRecorder struct {
+	mock *MockNonces
+}
+
+// NewMockNonces creates a new mock instance
+func NewMockNonces(ctrl *gomock.Controller) *MockNonces {
+	mock := &MockNonces{ctrl: ctrl}
+	mock.recorder = &MockNoncesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNonces) EXPECT() *MockNoncesMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockNonces) Get(ctx context.Context, chainID, addr string, userInfo *entities.UserInfo) (*entities0.Nonce, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, chainID, addr, userInfo)
+	ret0, _ := ret[0].(*entities0.Nonce)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockNoncesMockRecorder) Get(ctx, chainID, addr, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNonces)(nil).Get), ctx, chainID, addr, userInfo)
+}
+
+// Next mocks base method
+func (m *MockNonces) Next(ctx context.Context, chainID, addr string, pendingNonce uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx, chainID, addr, pendingNonce)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next
+func (mr *MockNoncesMockRecorder) Next(ctx, chainID, addr, pendingNonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockNonces)(nil).Next), ctx, chainID, addr, pendingNonce)
+}
+
+// Release mocks base method
+func (m *MockNonces) Release(ctx context.Context, chainID, addr string, nonce uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, chainID, addr, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release
+func (mr *MockNoncesMockRecorder) Release(ctx, chainID, addr, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockNonces)(nil).Release), ctx, chainID, addr, nonce)
+}
+
+// Reset mocks base method
+func (m *MockNonces) Reset(ctx context.Context, chainID, addr string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, chainID, addr, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset
+func (mr *MockNoncesMockRecorder) Reset(ctx, chainID, addr, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockNonces)(nil).Reset), ctx, chainID, addr, userInfo)
+}
+
+// MockTransactions is a mock of Transactions interface
+type MockTransactions struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionsMockRecorder
+}
+
+// MockTransactionsMockRecorder is the mock recorder for MockTransactions
+type MockTransactionsMockRecorder struct {
+	mock *MockTransactions
+}
+
+// NewMockTransactions creates a new mock instance
+func NewMockTransactions(ctrl *gomock.Controller) *MockTransactions {
+	mock := &MockTransactions{ctrl: ctrl}
+	mock.recorder = &MockTransactionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactions) EXPECT() *MockTransactionsMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method
+func (m *MockTransactions) Cancel(ctx context.Context, hash string, userInfo *entities.UserInfo) (*entities0.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, hash, userInfo)
+	ret0, _ := ret[0].(*entities0.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel
+func (mr *MockTransactionsMockRecorder) Cancel(ctx, hash, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockTransactions)(nil).Cancel), ctx, hash, userInfo)
+}
+
+// Get mocks base method
+func (m *MockTransactions) Get(ctx context.Context, hash string, userInfo *entities.UserInfo) (*entities0.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, hash, userInfo)
+	ret0, _ := ret[0].(*entities0.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockTransactionsMockRecorder) Get(ctx, hash, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransactions)(nil).Get), ctx, hash, userInfo)
+}
+
+// ListPending mocks base method
+func (m *MockTransactions) ListPending(ctx context.Context, userInfo *entities.UserInfo) ([]*entities0.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, userInfo)
+	ret0, _ := ret[0].([]*entities0.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending
+func (mr *MockTransactionsMockRecorder) ListPending(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockTransactions)(nil).ListPending), ctx, userInfo)
+}
+
+// SpeedUp mocks base method
+func (m *MockTransactions) SpeedUp(ctx context.Context, hash string, userInfo *entities.UserInfo) (*entities0.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpeedUp", ctx, hash, userInfo)
+	ret0, _ := ret[0].(*entities0.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpeedUp indicates an expected call of SpeedUp
+func (mr *MockTransactionsMockRecorder) SpeedUp(ctx, hash, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpeedUp", reflect.TypeOf((*MockTransactions)(nil).SpeedUp), ctx, hash, userInfo)
+}
+
+// Track mocks base method
+func (m *MockTransactions) Track(ctx context.Context, maxAge time.Duration, batchSize uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", ctx, maxAge, batchSize)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track
+func (mr *MockTransactionsMockRecorder) Track(ctx, maxAge, batchSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockTransactions)(nil).Track), ctx, maxAge, batchSize)
+}
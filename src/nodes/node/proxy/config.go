@@ -82,13 +82,21 @@ func (cfg *DownstreamConfig) SetDefault() *DownstreamConfig {
 	return cfg
 }
 
+// DefaultMaxBatchSize is the maximum number of requests of a JSON-RPC batch if none is configured
+const DefaultMaxBatchSize = 100
+
 // Config is the cfg format for a Hashicorp Vault secret store
 type Config struct {
 	RPC           *DownstreamConfig `json:"rpc,omitempty" yaml:"rpc,omitempty"`
 	PrivTxManager *DownstreamConfig `json:"tessera,omitempty" yaml:"tessera,omitempty"`
+	MaxBatchSize  int               `json:"maxBatchSize,omitempty" yaml:"max_batch_size,omitempty" example:"100"`
 }
 
 func (cfg *Config) SetDefault() *Config {
+	if cfg.MaxBatchSize <= 0 {
+		cfg.MaxBatchSize = DefaultMaxBatchSize
+	}
+
 	if cfg.RPC == nil {
 		cfg.RPC = new(DownstreamConfig)
 	}
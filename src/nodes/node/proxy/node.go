@@ -1,8 +1,12 @@
 package proxynode
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/consensys/quorum-key-manager/src/infra/log"
@@ -31,11 +35,13 @@ type Node struct {
 
 	wsHandler   *websocket.Proxy
 	httpHandler http.Handler
+
+	maxBatchSize int
 }
 
 // New creates a Node
 func New(cfg *Config, logger log.Logger) (*Node, error) {
-	n := new(Node)
+	n := &Node{maxBatchSize: cfg.MaxBatchSize}
 	var err error
 	n.rpc, err = newhttpDownstream(cfg.RPC)
 	if err != nil {
@@ -84,24 +90,71 @@ func (n *Node) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 }
 
 func (n *Node) serveHTTP(rw http.ResponseWriter, req *http.Request) {
-	// Create ResponseWriter
-	rpcRw := jsonrpc.NewResponseWriter(rw)
-
-	// Parse request body
-	msg := new(jsonrpc.RequestMsg)
-	err := json.NewDecoder(req.Body).Decode(msg)
+	// Read request body
+	b, err := ioutil.ReadAll(req.Body)
 	req.Body.Close()
 	if err != nil {
-		_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+		_ = jsonrpc.WriteError(jsonrpc.NewResponseWriter(rw), jsonrpc.ParseError(err))
 		return
 	}
 
-	// Attach session to context
-	jsonrpcClient := n.newHTTPJSONRPCClient(req)
-	ctx := WithSession(req.Context(), n.newSession(jsonrpcClient, msg))
-
 	// Serve
-	n.handler().ServeRPC(rpcRw, msg.WithContext(ctx))
+	n.serveMsg(req.Context(), rw, b, n.newHTTPJSONRPCClient(req))
+}
+
+// serveMsg serves a JSON-RPC message body, each request of a batch being served individually
+// and their responses written in a single JSON array in the order of the requests
+func (n *Node) serveMsg(ctx context.Context, w io.Writer, b []byte, jsonrpcClient jsonrpc.Client) {
+	if !jsonrpc.IsBatch(b) {
+		rpcRw := jsonrpc.NewResponseWriter(w)
+
+		msg := new(jsonrpc.RequestMsg)
+		err := json.Unmarshal(b, msg)
+		if err != nil {
+			_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+			return
+		}
+
+		n.serveRPC(ctx, rpcRw, msg, jsonrpcClient)
+		return
+	}
+
+	var rawMsgs []json.RawMessage
+	err := json.Unmarshal(b, &rawMsgs)
+	if err != nil {
+		_ = jsonrpc.WriteError(jsonrpc.NewResponseWriter(w), jsonrpc.ParseError(err))
+		return
+	}
+
+	if len(rawMsgs) == 0 {
+		_ = jsonrpc.WriteError(jsonrpc.NewResponseWriter(w), jsonrpc.InvalidRequest(fmt.Errorf("empty batch")))
+		return
+	}
+
+	if n.maxBatchSize > 0 && len(rawMsgs) > n.maxBatchSize {
+		_ = jsonrpc.WriteError(jsonrpc.NewResponseWriter(w), jsonrpc.InvalidRequest(fmt.Errorf("batch of %d requests exceeds the maximum of %d", len(rawMsgs), n.maxBatchSize)))
+		return
+	}
+
+	batchRw := jsonrpc.NewBatchResponseWriter(w, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		msg := new(jsonrpc.RequestMsg)
+		err = json.Unmarshal(rawMsg, msg)
+		if err != nil {
+			_ = jsonrpc.WriteError(batchRw.Element(i), jsonrpc.InvalidRequest(err))
+			continue
+		}
+
+		n.serveRPC(ctx, batchRw.Element(i), msg, jsonrpcClient)
+	}
+
+	_ = batchRw.Flush()
+}
+
+// serveRPC attaches a session to the request context then handles the request
+func (n *Node) serveRPC(ctx context.Context, rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg, jsonrpcClient jsonrpc.Client) {
+	sess := n.newSession(jsonrpcClient, msg)
+	n.handler().ServeRPC(rw, msg.WithContext(WithSession(ctx, sess)))
 }
 
 func (n *Node) interceptWS(ctx context.Context, clientConn, serverConn *gorillawebsocket.Conn) (clientErrors, serverErrors <-chan error) {
@@ -123,27 +176,16 @@ func (n *Node) interceptWS(ctx context.Context, clientConn, serverConn *gorillaw
 				return
 			}
 
-			// Create ResponseWriter
-			w, err := clientConn.NextWriter(typ)
-			if err != nil {
-				continue
-			}
-			rpcRw := jsonrpc.NewResponseWriter(w)
+			// Handle message, a batch being answered in a single message once all its requests are served
+			buf := new(bytes.Buffer)
+			n.serveMsg(ctx, buf, b, jsonrpcClient)
 
-			// Unmarshal input message
-			msg := new(jsonrpc.RequestMsg)
-			err = json.Unmarshal(b, msg)
-			if err != nil {
-				_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+			// Batches made only of notifications have no response
+			if buf.Len() == 0 {
 				continue
 			}
 
-			// Create and attach session to context then handle message
-			sess := n.newSession(jsonrpcClient, msg)
-			n.handler().ServeRPC(rpcRw, msg.WithContext(WithSession(ctx, sess)))
-
-			// Close writer so message is sent through connection
-			w.Close()
+			_ = clientConn.WriteMessage(typ, buf.Bytes())
 		}
 	}()
 
@@ -165,6 +207,17 @@ func (n *Node) newSession(jsonrpcClient jsonrpc.Client, msg *jsonrpc.RequestMsg)
 	}
 }
 
+// NewSession creates a session to the downstream node which is not bound to an incoming request
+// so it can be used by background processes
+func (n *Node) NewSession() Session {
+	httpClient := httpclient.CombineDecorators(
+		httpclient.WithModifier(n.rpc.respModifier),
+		httpclient.WithPreparer(n.rpc.reqPreparer),
+	)(n.rpc.client)
+
+	return n.newSession(jsonrpc.NewHTTPClient(httpClient), &jsonrpc.RequestMsg{Version: "2.0"})
+}
+
 func (n *Node) newHTTPJSONRPCClient(req *http.Request) jsonrpc.Client {
 	httpClient := httpclient.CombineDecorators(
 		httpclient.WithModifier(n.rpc.respModifier),
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -87,6 +88,91 @@ func TestRPCNodeHTTP(t *testing.T) {
 	assert.Equal(t, expectedRespBody, rec.Body.Bytes()[:(rec.Body.Len()-1)], "WriteMsg should write correct body")
 }
 
+func TestRPCNodeHTTPBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcServer := httptest.NewServer(
+		http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rpcRw := jsonrpc.NewResponseWriter(rw)
+
+			msg := new(jsonrpc.RequestMsg)
+			err := json.NewDecoder(req.Body).Decode(msg)
+			req.Body.Close()
+			if err != nil {
+				_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+				return
+			}
+
+			jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(func(rpcRw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+				_ = jsonrpc.WriteResult(rpcRw, msg.Params)
+			})).ServeRPC(rpcRw, msg)
+		}),
+	)
+	defer rpcServer.Close()
+
+	cfg := (&Config{
+		RPC: &DownstreamConfig{
+			Addr: rpcServer.URL,
+		},
+		MaxBatchSize: 3,
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	err = n.Start(context.Background())
+	require.NoError(t, err, "Start must not error")
+	defer func() { _ = n.Stop(context.Background()) }()
+
+	t.Run("should serve every request of the batch in order", func(t *testing.T) {
+		body := `[{"jsonrpc":"2.0","method":"testMethod","params":"test-message-1","id":1},1,{"jsonrpc":"2.0","method":"testMethod","params":"test-message-2","id":"test-id"}]`
+		req, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, "StatusCode should be OK")
+
+		var resps []*jsonrpc.ResponseMsg
+		err := json.Unmarshal(rec.Body.Bytes(), &resps)
+		require.NoError(t, err, "Body must be a batch of responses")
+		require.Len(t, resps, 3, "Batch should have one response per request")
+
+		assertResponse(t, resps[0], "2.0", 1, "test-message-1")
+		require.NotNil(t, resps[1].Error, "Invalid element should get an error response")
+		assert.Equal(t, -32600, resps[1].Error.Code, "Error should be InvalidRequest")
+		assertResponse(t, resps[2], "2.0", "test-id", "test-message-2")
+	})
+
+	t.Run("should respond with a single error to an empty batch", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(`[]`))
+
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, req)
+
+		resp := new(jsonrpc.ResponseMsg)
+		err := json.Unmarshal(rec.Body.Bytes(), resp)
+		require.NoError(t, err, "Body must be a single response")
+		require.NotNil(t, resp.Error, "Empty batch should get an error response")
+		assert.Equal(t, -32600, resp.Error.Code, "Error should be InvalidRequest")
+	})
+
+	t.Run("should respond with a single error to a batch exceeding the maximum size", func(t *testing.T) {
+		body := `[{"jsonrpc":"2.0","method":"testMethod","id":1},{"jsonrpc":"2.0","method":"testMethod","id":2},{"jsonrpc":"2.0","method":"testMethod","id":3},{"jsonrpc":"2.0","method":"testMethod","id":4}]`
+		req, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, req)
+
+		resp := new(jsonrpc.ResponseMsg)
+		err := json.Unmarshal(rec.Body.Bytes(), resp)
+		require.NoError(t, err, "Body must be a single response")
+		require.NotNil(t, resp.Error, "Oversized batch should get an error response")
+		assert.Equal(t, -32600, resp.Error.Code, "Error should be InvalidRequest")
+	})
+}
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:    1024,
 	WriteBufferSize:   1024,
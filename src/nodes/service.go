@@ -2,8 +2,10 @@ package nodes
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	nodesentities "github.com/consensys/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 )
 
@@ -19,4 +21,41 @@ type Nodes interface {
 
 	// List returns a list of nodes
 	List(ctx context.Context, userInfo *entities.UserInfo) ([]string, error)
+
+	// Session returns a session to a node which is not bound to an incoming request
+	Session(ctx context.Context, name string, userInfo *entities.UserInfo) (proxynode.Session, error)
+}
+
+// Nonces Service manages the nonces of the Ethereum accounts sending transactions through the nodes
+type Nonces interface {
+	// Next returns the next nonce of an account on a chain given the pending nonce of the account on the node
+	Next(ctx context.Context, chainID, addr string, pendingNonce uint64) (uint64, error)
+
+	// Release rolls back the nonce allocated to a transaction that could not be sent, if it is the last one allocated
+	Release(ctx context.Context, chainID, addr string, nonce uint64) error
+
+	// Get returns the nonce of an account on a chain
+	Get(ctx context.Context, chainID, addr string, userInfo *entities.UserInfo) (*nodesentities.Nonce, error)
+
+	// Reset discards the nonce of an account on a chain
+	Reset(ctx context.Context, chainID, addr string, userInfo *entities.UserInfo) error
+}
+
+// Transactions Service journals the transactions sent through the nodes and follows them until they are mined
+type Transactions interface {
+	// Get returns a transaction by hash
+	Get(ctx context.Context, hash string, userInfo *entities.UserInfo) (*nodesentities.Transaction, error)
+
+	// ListPending returns the pending transactions of the tenant of the user
+	ListPending(ctx context.Context, userInfo *entities.UserInfo) ([]*nodesentities.Transaction, error)
+
+	// SpeedUp replaces a pending transaction by the same transaction with bumped fees
+	SpeedUp(ctx context.Context, hash string, userInfo *entities.UserInfo) (*nodesentities.Transaction, error)
+
+	// Cancel replaces a pending transaction by an empty transfer to its sender with bumped fees
+	Cancel(ctx context.Context, hash string, userInfo *entities.UserInfo) (*nodesentities.Transaction, error)
+
+	// Track updates the status of the journaled transactions from their receipts, by batches of batchSize transactions.
+	// Transactions older than maxAge are dropped
+	Track(ctx context.Context, maxAge time.Duration, batchSize uint64) error
 }
@@ -27,7 +27,7 @@ func (i *Nodes) Create(ctx context.Context, name string, config *proxynode.Confi
 	}
 
 	// Set interceptor on proxy node
-	prxNode.Handler = interceptor.New(i.storesService, i.aliases, i.logger)
+	prxNode.Handler = interceptor.New(name, i.storesService, i.aliases, i.nonces, i.txDB, i.logger)
 
 	// Start node
 	err = prxNode.Start(ctx)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/consensys/quorum-key-manager/src/aliases"
 	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
 	"github.com/consensys/quorum-key-manager/src/nodes/entities"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	"github.com/consensys/quorum-key-manager/src/stores"
@@ -18,6 +19,8 @@ type Nodes struct {
 	storesService stores.Stores
 	roles         auth.Roles
 	aliases       aliases.Aliases
+	nonces        nodes.Nonces
+	txDB          database.Transactions
 	mux           sync.RWMutex
 	nodes         map[string]*entities.Node
 	logger        log.Logger
@@ -25,11 +28,13 @@ type Nodes struct {
 
 var _ nodes.Nodes = &Nodes{}
 
-func New(storesService stores.Stores, rolesService auth.Roles, aliasesService aliases.Aliases, logger log.Logger) *Nodes {
+func New(storesService stores.Stores, rolesService auth.Roles, aliasesService aliases.Aliases, nonceService nodes.Nonces, txDB database.Transactions, logger log.Logger) *Nodes {
 	return &Nodes{
 		storesService: storesService,
 		roles:         rolesService,
 		aliases:       aliasesService,
+		nonces:        nonceService,
+		txDB:          txDB,
 		mux:           sync.RWMutex{},
 		nodes:         make(map[string]*entities.Node),
 		logger:        logger,
@@ -0,0 +1,17 @@
+package nodes
+
+import (
+	"context"
+
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+)
+
+func (i *Nodes) Session(ctx context.Context, name string, userInfo *authtypes.UserInfo) (proxynode.Session, error) {
+	node, err := i.Get(ctx, name, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return node.NewSession(), nil
+}
@@ -0,0 +1,35 @@
+package nonces
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+func (s *Nonces) Get(ctx context.Context, chainID, addr string, userInfo *auth.UserInfo) (*entities.Nonce, error) {
+	logger := s.logger.With("chain_id", chainID, "address", addr)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceNonce})
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkManager(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := s.nonceDB.FindOne(ctx, chainID, addr)
+	if err != nil {
+		errMessage := "failed to get nonce"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("nonce retrieved successfully")
+	return nonce, nil
+}
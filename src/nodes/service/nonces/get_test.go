@@ -0,0 +1,47 @@
+package nonces
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/nodes/database/mock"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockNonces(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	service := New(db, roles, testutils.NewMockLogger(ctrl))
+
+	t.Run("should get the nonce of an address successfully", func(t *testing.T) {
+		userInfo := &authentities.UserInfo{Permissions: []authentities.Permission{authentities.ReadNonce}}
+		stored := &entities.Nonce{ChainID: chainID, Address: address, Value: 5}
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userInfo.Permissions)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(stored, nil)
+
+		nonce, err := service.Get(ctx, chainID, address, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, nonce)
+	})
+
+	t.Run("should fail with ForbiddenError if the user belongs to a tenant", func(t *testing.T) {
+		userInfo := &authentities.UserInfo{Tenant: "tenantOne", Permissions: []authentities.Permission{authentities.ReadNonce}}
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userInfo.Permissions)
+
+		_, err := service.Get(ctx, chainID, address, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
@@ -0,0 +1,103 @@
+package nonces
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+func (s *Nonces) Next(ctx context.Context, chainID, addr string, pendingNonce uint64) (uint64, error) {
+	logger := s.logger.With("chain_id", chainID, "address", addr)
+
+	var next uint64
+	err := s.nonceDB.RunInTransaction(ctx, func(dbtx database.Nonces) error {
+		// Serializes the allocations of the account across replicas
+		err := dbtx.Lock(ctx, chainID, addr)
+		if err != nil {
+			return err
+		}
+
+		nonce, err := dbtx.FindOne(ctx, chainID, addr)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return err
+		}
+
+		if nonce == nil {
+			next = pendingNonce
+			_, err = dbtx.Insert(ctx, &entities.Nonce{ChainID: chainID, Address: addr, Value: next + 1})
+			return err
+		}
+
+		switch {
+		case nonce.Value < pendingNonce:
+			logger.Debug("nonce behind the node, resyncing", "nonce", nonce.Value, "pending_nonce", pendingNonce)
+			next = pendingNonce
+		case nonce.Value > pendingNonce && time.Since(nonce.UpdatedAt) > resyncAfter:
+			logger.Warn("nonce gap detected, resyncing", "nonce", nonce.Value, "pending_nonce", pendingNonce)
+			next = pendingNonce
+		default:
+			next = nonce.Value
+		}
+
+		nonce.Value = next + 1
+		_, err = dbtx.Update(ctx, nonce)
+		return err
+	})
+	if err != nil {
+		errMessage := "failed to allocate nonce"
+		logger.WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("nonce allocated successfully", "nonce", next)
+	return next, nil
+}
+
+// Release rolls back the nonce allocated to a transaction that could not be sent. The nonce is only rolled back if no
+// other nonce has been allocated since, otherwise the gap is left to be resynced with the node after resyncAfter, as
+// discarding the nonce would hand out again the nonces of transactions in flight
+func (s *Nonces) Release(ctx context.Context, chainID, addr string, allocated uint64) error {
+	logger := s.logger.With("chain_id", chainID, "address", addr, "nonce", allocated)
+
+	released := false
+	err := s.nonceDB.RunInTransaction(ctx, func(dbtx database.Nonces) error {
+		err := dbtx.Lock(ctx, chainID, addr)
+		if err != nil {
+			return err
+		}
+
+		nonce, err := dbtx.FindOne(ctx, chainID, addr)
+		if err != nil {
+			return err
+		}
+
+		if nonce.Value != allocated+1 {
+			return nil
+		}
+
+		nonce.Value = allocated
+		_, err = dbtx.Update(ctx, nonce)
+		if err != nil {
+			return err
+		}
+
+		released = true
+		return nil
+	})
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to release nonce"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if !released {
+		logger.Debug("nonce not released as others were allocated since, left to be resynced with the node")
+		return nil
+	}
+
+	logger.Debug("nonce released successfully")
+	return nil
+}
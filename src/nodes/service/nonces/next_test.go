@@ -0,0 +1,152 @@
+package nonces
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/nodes/database/mock"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chainID = "1337"
+	address = "0x664895b5fE3ddf049d2Fb508cfA03923859763C6"
+)
+
+func TestNext(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockNonces(ctrl)
+	service := New(db, mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Nonces) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should take the pending nonce of the node for a new account", func(t *testing.T) {
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(nil, errors.NotFoundError("error"))
+		db.EXPECT().Insert(gomock.Any(), &entities.Nonce{ChainID: chainID, Address: address, Value: 4}).Return(nil, nil)
+
+		nonce, err := service.Next(ctx, chainID, address, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), nonce)
+	})
+
+	t.Run("should allocate the stored nonce ahead of the node", func(t *testing.T) {
+		stored := &entities.Nonce{ChainID: chainID, Address: address, Value: 5, UpdatedAt: time.Now()}
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(stored, nil)
+		db.EXPECT().Update(gomock.Any(), stored).Return(stored, nil)
+
+		nonce, err := service.Next(ctx, chainID, address, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), nonce)
+		assert.Equal(t, uint64(6), stored.Value)
+	})
+
+	t.Run("should resync when the node is ahead of the stored nonce", func(t *testing.T) {
+		stored := &entities.Nonce{ChainID: chainID, Address: address, Value: 5, UpdatedAt: time.Now()}
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(stored, nil)
+		db.EXPECT().Update(gomock.Any(), stored).Return(stored, nil)
+
+		nonce, err := service.Next(ctx, chainID, address, 8)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), nonce)
+		assert.Equal(t, uint64(9), stored.Value)
+	})
+
+	t.Run("should resync after a gap", func(t *testing.T) {
+		stored := &entities.Nonce{ChainID: chainID, Address: address, Value: 5, UpdatedAt: time.Now().Add(-2 * resyncAfter)}
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(stored, nil)
+		db.EXPECT().Update(gomock.Any(), stored).Return(stored, nil)
+
+		nonce, err := service.Next(ctx, chainID, address, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), nonce)
+		assert.Equal(t, uint64(4), stored.Value)
+	})
+
+	t.Run("should fail with same error if Lock fails", func(t *testing.T) {
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(errors.PostgresError("error"))
+
+		_, err := service.Next(ctx, chainID, address, 3)
+
+		assert.True(t, errors.IsPostgresError(err))
+	})
+}
+
+func TestRelease(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockNonces(ctrl)
+	service := New(db, mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Nonces) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should roll back the last allocated nonce", func(t *testing.T) {
+		stored := &entities.Nonce{ChainID: chainID, Address: address, Value: 6}
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(stored, nil)
+		db.EXPECT().Update(gomock.Any(), stored).Return(stored, nil)
+
+		err := service.Release(ctx, chainID, address, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), stored.Value)
+	})
+
+	t.Run("should not roll back if other nonces were allocated since", func(t *testing.T) {
+		stored := &entities.Nonce{ChainID: chainID, Address: address, Value: 8}
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(stored, nil)
+
+		err := service.Release(ctx, chainID, address, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), stored.Value)
+	})
+
+	t.Run("should not fail if no nonce is stored", func(t *testing.T) {
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(nil, errors.NotFoundError("error"))
+
+		err := service.Release(ctx, chainID, address, 5)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if Update fails", func(t *testing.T) {
+		stored := &entities.Nonce{ChainID: chainID, Address: address, Value: 6}
+		db.EXPECT().Lock(gomock.Any(), chainID, address).Return(nil)
+		db.EXPECT().FindOne(gomock.Any(), chainID, address).Return(stored, nil)
+		db.EXPECT().Update(gomock.Any(), stored).Return(nil, fmt.Errorf("error"))
+
+		err := service.Release(ctx, chainID, address, 5)
+
+		assert.Error(t, err)
+	})
+}
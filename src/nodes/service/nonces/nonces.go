@@ -0,0 +1,43 @@
+package nonces
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
+)
+
+// resyncAfter is the time after which a nonce ahead of the pending nonce of the node is considered as a gap
+// (allocated nonces never received by the node) rather than transactions still being sent
+const resyncAfter = 30 * time.Second
+
+type Nonces struct {
+	nonceDB database.Nonces
+	roles   auth.Roles
+	logger  log.Logger
+}
+
+var _ nodes.Nonces = &Nonces{}
+
+func New(nonceDB database.Nonces, rolesService auth.Roles, logger log.Logger) *Nonces {
+	return &Nonces{
+		nonceDB: nonceDB,
+		roles:   rolesService,
+		logger:  logger,
+	}
+}
+
+// checkManager rejects the users of a tenant, the nonces of an address being shared by the accounts of all tenants
+func checkManager(userInfo *authentities.UserInfo, logger log.Logger) error {
+	if userInfo.Tenant != "" {
+		errMessage := "nonces can only be managed by users without tenant"
+		logger.Error(errMessage, "tenant", userInfo.Tenant)
+		return errors.ForbiddenError(errMessage)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package nonces
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *Nonces) Reset(ctx context.Context, chainID, addr string, userInfo *auth.UserInfo) error {
+	logger := s.logger.With("chain_id", chainID, "address", addr)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionDelete, Resource: auth.ResourceNonce})
+	if err != nil {
+		return err
+	}
+
+	err = checkManager(userInfo, logger)
+	if err != nil {
+		return err
+	}
+
+	err = s.nonceDB.Delete(ctx, chainID, addr)
+	if err != nil {
+		errMessage := "failed to reset nonce"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("nonce reset successfully")
+	return nil
+}
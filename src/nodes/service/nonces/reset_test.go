@@ -0,0 +1,43 @@
+package nonces
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/nodes/database/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReset(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockNonces(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	service := New(db, roles, testutils.NewMockLogger(ctrl))
+
+	t.Run("should reset the nonce of an address successfully", func(t *testing.T) {
+		userInfo := &authentities.UserInfo{Permissions: []authentities.Permission{authentities.DeleteNonce}}
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userInfo.Permissions)
+		db.EXPECT().Delete(gomock.Any(), chainID, address).Return(nil)
+
+		err := service.Reset(ctx, chainID, address, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with ForbiddenError if the user belongs to a tenant", func(t *testing.T) {
+		userInfo := &authentities.UserInfo{Tenant: "tenantOne", Permissions: []authentities.Permission{authentities.DeleteNonce}}
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userInfo.Permissions)
+
+		err := service.Reset(ctx, chainID, address, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
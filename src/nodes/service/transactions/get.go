@@ -0,0 +1,30 @@
+package transactions
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+func (s *Transactions) Get(ctx context.Context, hash string, userInfo *auth.UserInfo) (*entities.Transaction, error) {
+	logger := s.logger.With("tx_hash", hash)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceTransaction})
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.txDB.FindOne(ctx, hash, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to get transaction"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("transaction retrieved successfully")
+	return tx, nil
+}
@@ -0,0 +1,30 @@
+package transactions
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+)
+
+func (s *Transactions) ListPending(ctx context.Context, userInfo *auth.UserInfo) ([]*entities.Transaction, error) {
+	logger := s.logger.With("tenant", userInfo.Tenant)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceTransaction})
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.txDB.FindPending(ctx, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to list pending transactions"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("pending transactions listed successfully")
+	return txs, nil
+}
@@ -0,0 +1,209 @@
+package transactions
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func (s *Transactions) SpeedUp(ctx context.Context, hash string, userInfo *auth.UserInfo) (*entities.Transaction, error) {
+	return s.replace(ctx, hash, false, userInfo)
+}
+
+func (s *Transactions) Cancel(ctx context.Context, hash string, userInfo *auth.UserInfo) (*entities.Transaction, error) {
+	return s.replace(ctx, hash, true, userInfo)
+}
+
+// replace sends a transaction with the nonce of a pending transaction and bumped fees so that it is mined instead.
+// The replacement is either the same transaction or, to cancel it, an empty transfer to the sender
+func (s *Transactions) replace(ctx context.Context, hash string, cancel bool, userInfo *auth.UserInfo) (*entities.Transaction, error) {
+	logger := s.logger.With("tx_hash", hash, "cancel", cancel)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionWrite, Resource: auth.ResourceTransaction})
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.txDB.FindOne(ctx, hash, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to get transaction"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if tx.Status != entities.PendingTxStatus {
+		errMessage := "only pending transactions can be replaced"
+		logger.Error(errMessage, "status", tx.Status)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	if tx.Type != entities.LegacyTxType && tx.Type != entities.DynamicFeeTxType {
+		errMessage := "private transactions cannot be replaced"
+		logger.Error(errMessage, "type", tx.Type)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	pendingTx := new(types.Transaction)
+	err = pendingTx.UnmarshalBinary(tx.Raw)
+	if err != nil {
+		errMessage := "failed to decode journaled transaction"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.EncodingError(errMessage)
+	}
+
+	sess, err := s.nodes.Session(ctx, tx.NodeName, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	from := ethcommon.HexToAddress(tx.From)
+	replacementTx, err := s.replacementTx(ctx, sess, from, pendingTx, cancel)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := s.stores.EthereumByAddr(ctx, from, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, _ := new(big.Int).SetString(tx.ChainID, 10)
+	raw, err := store.SignTransaction(ctx, from, chainID, replacementTx)
+	if err != nil {
+		return nil, err
+	}
+
+	newHash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, raw)
+	if err != nil {
+		logger.WithError(err).Error("failed to send replacement transaction")
+		return nil, errors.BlockchainNodeError(err.Error())
+	}
+
+	newTx := &entities.Transaction{
+		Hash:      newHash.Hex(),
+		NodeName:  tx.NodeName,
+		ChainID:   tx.ChainID,
+		From:      tx.From,
+		Nonce:     tx.Nonce,
+		Raw:       raw,
+		Type:      tx.Type,
+		GasPrice:  tx.GasPrice,
+		GasFeeCap: tx.GasFeeCap,
+		GasTipCap: tx.GasTipCap,
+		Status:    entities.PendingTxStatus,
+		Tenant:    tx.Tenant,
+		Username:  userInfo.Username,
+	}
+	if tx.Type == entities.LegacyTxType {
+		newTx.GasPrice = replacementTx.GasPrice()
+	} else {
+		newTx.GasFeeCap = replacementTx.GasFeeCap()
+		newTx.GasTipCap = replacementTx.GasTipCap()
+	}
+
+	// As the replacement is already sent, failing to journal it is only logged
+	journaledTx, err := s.journalReplacement(ctx, tx, newTx)
+	if err != nil {
+		logger.WithError(err).Error("failed to journal replacement transaction", "replaced_by", newHash.Hex())
+	} else {
+		newTx = journaledTx
+	}
+
+	logger.Info("transaction replaced successfully", "replaced_by", newHash.Hex())
+	return newTx, nil
+}
+
+func (s *Transactions) replacementTx(ctx context.Context, sess proxynode.Session, from ethcommon.Address, pendingTx *types.Transaction, cancel bool) (*types.Transaction, error) {
+	to, value, data, gas, accessList := pendingTx.To(), pendingTx.Value(), pendingTx.Data(), pendingTx.Gas(), pendingTx.AccessList()
+	if cancel {
+		to, value, data, gas, accessList = &from, big.NewInt(0), nil, params.TxGas, nil
+	}
+
+	if pendingTx.Type() == types.LegacyTxType {
+		gasPrice, err := sess.EthCaller().Eth().GasPrice(ctx)
+		if err != nil {
+			s.logger.WithError(err).Error("failed to fetch gas price")
+			return nil, errors.BlockchainNodeError(err.Error())
+		}
+
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    pendingTx.Nonce(),
+			GasPrice: maxBig(bumpFee(pendingTx.GasPrice()), gasPrice),
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		}), nil
+	}
+
+	baseFee, err := sess.EthCaller().Eth().BaseFeePerGas(ctx, ethereum.LatestBlockNumber)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to retrieve base fee from latest block")
+		return nil, errors.BlockchainNodeError(err.Error())
+	}
+
+	gasTipCap := bumpFee(pendingTx.GasTipCap())
+	gasFeeCap := bumpFee(pendingTx.GasFeeCap())
+	if baseFee != nil {
+		gasFeeCap = maxBig(gasFeeCap, new(big.Int).Add(baseFee, gasTipCap))
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    pendingTx.ChainId(),
+		Nonce:      pendingTx.Nonce(),
+		GasTipCap:  gasTipCap,
+		GasFeeCap:  gasFeeCap,
+		Gas:        gas,
+		To:         to,
+		Value:      value,
+		Data:       data,
+		AccessList: accessList,
+	}), nil
+}
+
+// journalReplacement inserts the replacement transaction and marks the pending transaction as replaced by it
+func (s *Transactions) journalReplacement(ctx context.Context, tx, newTx *entities.Transaction) (*entities.Transaction, error) {
+	insertedTx, err := s.txDB.Insert(ctx, newTx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Status = entities.ReplacedTxStatus
+	tx.ReplacedBy = insertedTx.Hash
+	_, err = s.txDB.Update(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return insertedTx, nil
+}
+
+// bumpFee increases a fee by priceBump percent, rounded up, so that a node accepts the replacement
+func bumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+priceBump))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+
+	return bumped
+}
+
+func maxBig(x, y *big.Int) *big.Int {
+	if y != nil && y.Cmp(x) > 0 {
+		return y
+	}
+
+	return x
+}
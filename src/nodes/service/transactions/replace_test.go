@@ -0,0 +1,196 @@
+package transactions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/nodes/database/mock"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+	nodesmock "github.com/consensys/quorum-key-manager/src/nodes/mock"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	storesmock "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nodeName = "my-node"
+	txHash   = "0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"
+	newHash  = "0x8ed3fae3ed5a21cfe4fd5cbf50a3dd6c5d1a5cc48a8cc6f9e6a4d56cdb4b9e38"
+)
+
+var (
+	from = ethcommon.HexToAddress("0x664895b5fE3ddf049d2Fb508cfA03923859763C6")
+	to   = ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+)
+
+func TestReplace(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockTransactions(ctrl)
+	nodesService := nodesmock.NewMockNodes(ctrl)
+	stores := storesmock.NewMockStores(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	service := New(db, nodesService, stores, roles, testutils.NewMockLogger(ctrl))
+
+	session := proxynode.NewMockSession(ctrl)
+	caller := mockethereum.NewMockCaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	store := storesmock.NewMockEthStore(ctrl)
+	signedRaw := []byte("mysignature")
+
+	userInfo := &authentities.UserInfo{
+		Tenant:      "tenantOne",
+		Username:    "alice",
+		Permissions: []authentities.Permission{authentities.WriteTransaction},
+	}
+
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userInfo.Permissions).AnyTimes()
+	session.EXPECT().EthCaller().Return(caller).AnyTimes()
+	caller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+
+	t.Run("should speed up a pending legacy transaction", func(t *testing.T) {
+		pendingTx := newJournaledTx(t, types.NewTx(&types.LegacyTx{Nonce: 3, GasPrice: big.NewInt(100), Gas: 50000, To: &to, Value: big.NewInt(45), Data: []byte{1, 2}}))
+		pendingTx.Type = entities.LegacyTxType
+		pendingTx.GasPrice = big.NewInt(100)
+
+		db.EXPECT().FindOne(gomock.Any(), txHash, userInfo.Tenant).Return(pendingTx, nil)
+		nodesService.EXPECT().Session(gomock.Any(), nodeName, userInfo).Return(session, nil)
+		ethCaller.EXPECT().GasPrice(gomock.Any()).Return(big.NewInt(50), nil)
+		stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(store, nil)
+		store.EXPECT().SignTransaction(gomock.Any(), from, big.NewInt(1337), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *types.Transaction) ([]byte, error) {
+				assert.Equal(t, uint8(types.LegacyTxType), tx.Type())
+				assert.Equal(t, uint64(3), tx.Nonce())
+				assert.Equal(t, big.NewInt(110), tx.GasPrice())
+				assert.Equal(t, uint64(50000), tx.Gas())
+				assert.Equal(t, &to, tx.To())
+				assert.Equal(t, big.NewInt(45), tx.Value())
+				assert.Equal(t, []byte{1, 2}, tx.Data())
+				return signedRaw, nil
+			})
+		ethCaller.EXPECT().SendRawTransaction(gomock.Any(), signedRaw).Return(ethcommon.HexToHash(newHash), nil)
+		db.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *entities.Transaction) (*entities.Transaction, error) {
+			return tx, nil
+		})
+		db.EXPECT().Update(gomock.Any(), pendingTx).Return(pendingTx, nil)
+
+		newTx, err := service.SpeedUp(ctx, txHash, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, newHash, newTx.Hash)
+		assert.Equal(t, uint64(3), newTx.Nonce)
+		assert.Equal(t, big.NewInt(110), newTx.GasPrice)
+		assert.Equal(t, signedRaw, newTx.Raw)
+		assert.Equal(t, entities.PendingTxStatus, newTx.Status)
+		assert.Equal(t, "tenantOne", newTx.Tenant)
+		assert.Equal(t, entities.ReplacedTxStatus, pendingTx.Status)
+		assert.Equal(t, newHash, pendingTx.ReplacedBy)
+	})
+
+	t.Run("should cancel a pending dynamic fee transaction", func(t *testing.T) {
+		pendingTx := newJournaledTx(t, types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(1337), Nonce: 3, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(100), Gas: 50000, To: &to, Value: big.NewInt(45)}))
+		pendingTx.Type = entities.DynamicFeeTxType
+		pendingTx.GasTipCap = big.NewInt(2)
+		pendingTx.GasFeeCap = big.NewInt(100)
+
+		db.EXPECT().FindOne(gomock.Any(), txHash, userInfo.Tenant).Return(pendingTx, nil)
+		nodesService.EXPECT().Session(gomock.Any(), nodeName, userInfo).Return(session, nil)
+		ethCaller.EXPECT().BaseFeePerGas(gomock.Any(), ethereum.LatestBlockNumber).Return(big.NewInt(200), nil)
+		stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(store, nil)
+		store.EXPECT().SignTransaction(gomock.Any(), from, big.NewInt(1337), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *types.Transaction) ([]byte, error) {
+				assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+				assert.Equal(t, uint64(3), tx.Nonce())
+				assert.Equal(t, big.NewInt(3), tx.GasTipCap())
+				assert.Equal(t, big.NewInt(203), tx.GasFeeCap())
+				assert.Equal(t, uint64(21000), tx.Gas())
+				assert.Equal(t, &from, tx.To())
+				assert.Equal(t, big.NewInt(0), tx.Value())
+				assert.Empty(t, tx.Data())
+				return signedRaw, nil
+			})
+		ethCaller.EXPECT().SendRawTransaction(gomock.Any(), signedRaw).Return(ethcommon.HexToHash(newHash), nil)
+		db.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *entities.Transaction) (*entities.Transaction, error) {
+			return tx, nil
+		})
+		db.EXPECT().Update(gomock.Any(), pendingTx).Return(pendingTx, nil)
+
+		newTx, err := service.Cancel(ctx, txHash, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, newHash, newTx.Hash)
+		assert.Equal(t, big.NewInt(3), newTx.GasTipCap)
+		assert.Equal(t, big.NewInt(203), newTx.GasFeeCap)
+		assert.Equal(t, entities.ReplacedTxStatus, pendingTx.Status)
+	})
+
+	t.Run("should fail with InvalidParameterError if the transaction is not pending", func(t *testing.T) {
+		minedTx := &entities.Transaction{Hash: txHash, Type: entities.LegacyTxType, Status: entities.MinedTxStatus}
+		db.EXPECT().FindOne(gomock.Any(), txHash, userInfo.Tenant).Return(minedTx, nil)
+
+		newTx, err := service.SpeedUp(ctx, txHash, userInfo)
+
+		assert.Nil(t, newTx)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with NotSupportedError if the transaction is private", func(t *testing.T) {
+		privateTx := &entities.Transaction{Hash: txHash, Type: entities.PrivateTxType, Status: entities.PendingTxStatus}
+		db.EXPECT().FindOne(gomock.Any(), txHash, userInfo.Tenant).Return(privateTx, nil)
+
+		newTx, err := service.Cancel(ctx, txHash, userInfo)
+
+		assert.Nil(t, newTx)
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+
+	t.Run("should fail with the same error if the transaction is not found", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		db.EXPECT().FindOne(gomock.Any(), txHash, userInfo.Tenant).Return(nil, expectedErr)
+
+		newTx, err := service.SpeedUp(ctx, txHash, userInfo)
+
+		assert.Nil(t, newTx)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if the user cannot write transactions", func(t *testing.T) {
+		readOnlyUser := &authentities.UserInfo{Tenant: "tenantOne", Permissions: []authentities.Permission{authentities.ReadTransaction}}
+		roles.EXPECT().UserPermissions(gomock.Any(), readOnlyUser).Return(readOnlyUser.Permissions)
+
+		newTx, err := service.SpeedUp(ctx, txHash, readOnlyUser)
+
+		assert.Nil(t, newTx)
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
+
+func newJournaledTx(t *testing.T, tx *types.Transaction) *entities.Transaction {
+	raw, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	return &entities.Transaction{
+		Hash:     txHash,
+		NodeName: nodeName,
+		ChainID:  "1337",
+		From:     from.Hex(),
+		Nonce:    tx.Nonce(),
+		Raw:      raw,
+		Status:   entities.PendingTxStatus,
+		Tenant:   "tenantOne",
+		Username: "alice",
+	}
+}
@@ -0,0 +1,103 @@
+package transactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type sessionKey struct {
+	node   string
+	tenant string
+}
+
+func (s *Transactions) Track(ctx context.Context, maxAge time.Duration, batchSize uint64) error {
+	// Transactions evicted from the mempool never get a receipt, they are dropped once too old to be mined
+	expired, err := s.txDB.DropExpired(ctx, time.Now().Add(-maxAge))
+	if err != nil {
+		errMessage := "failed to drop expired transactions"
+		s.logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+	if len(expired) > 0 {
+		s.logger.Warn("transactions dropped without receipt", "tx_hashes", expired, "max_age", maxAge.String())
+	}
+
+	// Nodes are reached on behalf of the tenant which sent the transactions
+	sessions := make(map[sessionKey]proxynode.Session)
+	after := ""
+	for {
+		txs, err := s.txDB.FindTracked(ctx, after, batchSize)
+		if err != nil {
+			errMessage := "failed to find tracked transactions"
+			s.logger.WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+
+		for _, tx := range txs {
+			if tx.Hash > after {
+				after = tx.Hash
+			}
+
+			key := sessionKey{node: tx.NodeName, tenant: tx.Tenant}
+			sess, ok := sessions[key]
+			if !ok {
+				userInfo := &auth.UserInfo{Tenant: tx.Tenant, Username: tx.Username, Permissions: []auth.Permission{auth.ProxyNode}}
+				sess, err = s.nodes.Session(ctx, tx.NodeName, userInfo)
+				if err != nil {
+					s.logger.WithError(err).Warn("cannot reach node to track transactions", "node", tx.NodeName, "tenant", tx.Tenant)
+				}
+				sessions[key] = sess
+			}
+
+			if sess != nil {
+				s.trackTx(ctx, sess, tx)
+			}
+		}
+
+		if len(txs) == 0 || uint64(len(txs)) < batchSize {
+			return nil
+		}
+	}
+}
+
+// trackTx sets the status of a transaction once mined. The other transactions sharing its nonce can then no longer be mined
+func (s *Transactions) trackTx(ctx context.Context, sess proxynode.Session, tx *entities.Transaction) {
+	logger := s.logger.With("tx_hash", tx.Hash, "node", tx.NodeName)
+
+	receipt, err := sess.EthCaller().Eth().GetTransactionReceipt(ctx, ethcommon.HexToHash(tx.Hash))
+	if err != nil {
+		logger.WithError(err).Warn("failed to fetch transaction receipt")
+		return
+	}
+
+	if receipt == nil {
+		return
+	}
+
+	blockNumber := uint64(receipt.BlockNumber)
+	tx.BlockNumber = &blockNumber
+	tx.Status = entities.MinedTxStatus
+	if !receipt.Successful() {
+		tx.Status = entities.FailedTxStatus
+	}
+
+	_, err = s.txDB.Update(ctx, tx)
+	if err != nil {
+		logger.WithError(err).Error("failed to update transaction status")
+		return
+	}
+
+	err = s.txDB.DropOthers(ctx, tx)
+	if err != nil {
+		logger.WithError(err).Error("failed to drop transactions with the same nonce")
+		return
+	}
+
+	logger.Info("transaction mined", "status", tx.Status, "block_number", blockNumber)
+}
@@ -0,0 +1,166 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/nodes/database/mock"
+	"github.com/consensys/quorum-key-manager/src/nodes/entities"
+	nodesmock "github.com/consensys/quorum-key-manager/src/nodes/mock"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	storesmock "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrack(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockTransactions(ctrl)
+	nodesService := nodesmock.NewMockNodes(ctrl)
+	service := New(db, nodesService, storesmock.NewMockStores(ctrl), mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	session := proxynode.NewMockSession(ctrl)
+	caller := mockethereum.NewMockCaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	session.EXPECT().EthCaller().Return(caller).AnyTimes()
+	caller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+
+	maxAge := time.Hour
+	batchSize := uint64(3)
+	db.EXPECT().DropExpired(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	expectedUserInfo := &authentities.UserInfo{Tenant: "tenantOne", Username: "alice", Permissions: []authentities.Permission{authentities.ProxyNode}}
+
+	t.Run("should update the status of mined transactions and drop the ones sharing their nonce", func(t *testing.T) {
+		minedTx := &entities.Transaction{Hash: txHash, NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+		failedTx := &entities.Transaction{Hash: newHash, NodeName: nodeName, Status: entities.ReplacedTxStatus, Tenant: "tenantOne", Username: "alice"}
+		pendingTx := &entities.Transaction{Hash: "0xa1", NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+
+		db.EXPECT().FindTracked(gomock.Any(), "", batchSize).Return([]*entities.Transaction{minedTx, failedTx, pendingTx}, nil)
+		db.EXPECT().FindTracked(gomock.Any(), "0xa1", batchSize).Return([]*entities.Transaction{}, nil)
+		nodesService.EXPECT().Session(gomock.Any(), nodeName, expectedUserInfo).Return(session, nil)
+
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), ethcommon.HexToHash(txHash)).
+			Return(&ethereum.Receipt{BlockNumber: hexutil.Uint64(10), Status: hexutil.Uint64(ethereum.ReceiptStatusSuccessful)}, nil)
+		db.EXPECT().Update(gomock.Any(), minedTx).Return(minedTx, nil)
+		db.EXPECT().DropOthers(gomock.Any(), minedTx).Return(nil)
+
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), ethcommon.HexToHash(newHash)).
+			Return(&ethereum.Receipt{BlockNumber: hexutil.Uint64(11), Status: hexutil.Uint64(ethereum.ReceiptStatusFailed)}, nil)
+		db.EXPECT().Update(gomock.Any(), failedTx).Return(failedTx, nil)
+		db.EXPECT().DropOthers(gomock.Any(), failedTx).Return(nil)
+
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), ethcommon.HexToHash("0xa1")).Return(nil, nil)
+
+		err := service.Track(ctx, maxAge, batchSize)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.MinedTxStatus, minedTx.Status)
+		assert.Equal(t, uint64(10), *minedTx.BlockNumber)
+		assert.Equal(t, entities.FailedTxStatus, failedTx.Status)
+		assert.Equal(t, uint64(11), *failedTx.BlockNumber)
+		assert.Equal(t, entities.PendingTxStatus, pendingTx.Status)
+		assert.Nil(t, pendingTx.BlockNumber)
+	})
+
+	t.Run("should skip the transactions of an unreachable node", func(t *testing.T) {
+		tx1 := &entities.Transaction{Hash: txHash, NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+		tx2 := &entities.Transaction{Hash: newHash, NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+
+		db.EXPECT().FindTracked(gomock.Any(), "", batchSize).Return([]*entities.Transaction{tx1, tx2}, nil)
+		nodesService.EXPECT().Session(gomock.Any(), nodeName, expectedUserInfo).Return(nil, errors.NotFoundError("error"))
+
+		err := service.Track(ctx, maxAge, batchSize)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.PendingTxStatus, tx1.Status)
+		assert.Equal(t, entities.PendingTxStatus, tx2.Status)
+	})
+
+	t.Run("should keep tracking a transaction whose receipt cannot be fetched", func(t *testing.T) {
+		tx := &entities.Transaction{Hash: txHash, NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+
+		db.EXPECT().FindTracked(gomock.Any(), "", batchSize).Return([]*entities.Transaction{tx}, nil)
+		nodesService.EXPECT().Session(gomock.Any(), nodeName, expectedUserInfo).Return(session, nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), ethcommon.HexToHash(txHash)).Return(nil, fmt.Errorf("error"))
+
+		err := service.Track(ctx, maxAge, batchSize)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.PendingTxStatus, tx.Status)
+	})
+
+	t.Run("should track the transactions by batches", func(t *testing.T) {
+		tx1 := &entities.Transaction{Hash: "0xa1", NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+		tx2 := &entities.Transaction{Hash: "0xa3", NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+		tx3 := &entities.Transaction{Hash: "0xa2", NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+		tx4 := &entities.Transaction{Hash: "0xb1", NodeName: nodeName, Status: entities.PendingTxStatus, Tenant: "tenantOne", Username: "alice"}
+
+		db.EXPECT().FindTracked(gomock.Any(), "", uint64(3)).Return([]*entities.Transaction{tx1, tx2, tx3}, nil)
+		db.EXPECT().FindTracked(gomock.Any(), "0xa3", uint64(3)).Return([]*entities.Transaction{tx4}, nil)
+		nodesService.EXPECT().Session(gomock.Any(), nodeName, expectedUserInfo).Return(session, nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), gomock.Any()).Return(nil, nil).Times(4)
+
+		err := service.Track(ctx, maxAge, uint64(3))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with the same error if tracked transactions cannot be found", func(t *testing.T) {
+		expectedErr := errors.PostgresError("error")
+		db.EXPECT().FindTracked(gomock.Any(), "", batchSize).Return(nil, expectedErr)
+
+		err := service.Track(ctx, maxAge, batchSize)
+
+		assert.True(t, errors.IsPostgresError(err))
+	})
+
+	t.Run("should fail with the same error if expired transactions cannot be dropped", func(t *testing.T) {
+		db := mock2.NewMockTransactions(ctrl)
+		service := New(db, nodesService, storesmock.NewMockStores(ctrl), mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+		expectedErr := errors.PostgresError("error")
+		db.EXPECT().DropExpired(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := service.Track(ctx, maxAge, batchSize)
+
+		assert.True(t, errors.IsPostgresError(err))
+	})
+}
+
+func TestTrackExpired(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockTransactions(ctrl)
+	service := New(db, nodesmock.NewMockNodes(ctrl), storesmock.NewMockStores(ctrl), mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	t.Run("should drop the transactions older than the max age", func(t *testing.T) {
+		maxAge := time.Hour
+		db.EXPECT().DropExpired(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, before time.Time) ([]string, error) {
+				assert.WithinDuration(t, time.Now().Add(-maxAge), before, time.Minute)
+				return []string{txHash}, nil
+			})
+		db.EXPECT().FindTracked(gomock.Any(), "", uint64(10)).Return([]*entities.Transaction{}, nil)
+
+		err := service.Track(ctx, maxAge, 10)
+
+		require.NoError(t, err)
+	})
+}
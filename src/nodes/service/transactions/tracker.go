@@ -0,0 +1,86 @@
+package transactions
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/common"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+)
+
+// Tracker periodically updates the status of the journaled transactions until they are mined
+type Tracker struct {
+	txService nodes.Transactions
+	interval  time.Duration
+	maxAge    time.Duration
+	batchSize uint64
+	logger    log.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var _ common.Runnable = &Tracker{}
+
+func NewTracker(txService nodes.Transactions, interval, maxAge time.Duration, batchSize uint64, logger log.Logger) *Tracker {
+	return &Tracker{
+		txService: txService,
+		interval:  interval,
+		maxAge:    maxAge,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+func (t *Tracker) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	t.done = make(chan struct{})
+
+	go t.run(ctx)
+
+	t.logger.Info("transaction tracker started", "interval", t.interval.String(), "max_age", t.maxAge.String(), "batch_size", t.batchSize)
+	return nil
+}
+
+func (t *Tracker) Stop(ctx context.Context) error {
+	if t.cancel == nil {
+		return nil
+	}
+
+	t.cancel()
+
+	select {
+	case <-t.done:
+		t.logger.Info("transaction tracker stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *Tracker) Close() error {
+	return nil
+}
+
+func (t *Tracker) Error() error {
+	return nil
+}
+
+func (t *Tracker) run(ctx context.Context) {
+	defer close(t.done)
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Errors are logged by the service, tracking is retried on next tick
+			_ = t.txService.Track(ctx, t.maxAge, t.batchSize)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package transactions
+
+import (
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/database"
+	"github.com/consensys/quorum-key-manager/src/stores"
+)
+
+// priceBump is the minimum percentage by which the fees of a transaction are bumped to replace it
+const priceBump = 10
+
+type Transactions struct {
+	txDB   database.Transactions
+	nodes  nodes.Nodes
+	stores stores.Stores
+	roles  auth.Roles
+	logger log.Logger
+}
+
+var _ nodes.Transactions = &Transactions{}
+
+func New(txDB database.Transactions, nodesService nodes.Nodes, storesService stores.Stores, rolesService auth.Roles, logger log.Logger) *Transactions {
+	return &Transactions{
+		txDB:   txDB,
+		nodes:  nodesService,
+		stores: storesService,
+		roles:  rolesService,
+		logger: logger,
+	}
+}
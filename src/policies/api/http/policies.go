@@ -0,0 +1,197 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	auth "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/policies"
+	"github.com/consensys/quorum-key-manager/src/policies/api/types"
+	"github.com/gorilla/mux"
+)
+
+type PoliciesHandler struct {
+	policies policies.Policies
+}
+
+func NewPoliciesHandler(policiesService policies.Policies) *PoliciesHandler {
+	return &PoliciesHandler{policies: policiesService}
+}
+
+func (h *PoliciesHandler) Register(router *mux.Router) {
+	policyRouter := router.PathPrefix("/policies").Subrouter()
+
+	policyRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	policyRouter.Methods(http.MethodPost).Path("/{name}").HandlerFunc(h.create)
+	policyRouter.Methods(http.MethodGet).Path("/{name}").HandlerFunc(h.get)
+	policyRouter.Methods(http.MethodPatch).Path("/{name}").HandlerFunc(h.update)
+	policyRouter.Methods(http.MethodDelete).Path("/{name}").HandlerFunc(h.delete)
+}
+
+// @Summary      Creates a signing policy
+// @Description  Creates a policy restricting the transactions that the Ethereum accounts of a set of stores can sign. Policies apply to all tenants and can only be managed by users without tenant
+// @Tags         Policies
+// @Accept       json
+// @Produce      json
+// @Param        name     path      string                   true  "policy identifier"
+// @Param        request  body      types.PolicyRequest      true  "Create Policy Request"
+// @Success      200      {object}  types.PolicyResponse     "Policy data"
+// @Failure      400      {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401      {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403      {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      409      {object}  infrahttp.ErrorResponse  "Policy already exists"
+// @Failure      422      {object}  infrahttp.ErrorResponse  "Invalid parameters"
+// @Failure      500      {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /policies/{name} [post]
+func (h *PoliciesHandler) create(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	policyReq := &types.PolicyRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, policyReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	policy, err := policyReq.ToEntity(getName(r))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	policy, err = h.policies.Create(ctx, policy, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewPolicyResponse(policy))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Gets a signing policy
+// @Description  Gets a policy restricting the transactions that Ethereum accounts can sign
+// @Tags         Policies
+// @Produce      json
+// @Param        name  path      string                   true  "policy identifier"
+// @Success      200   {object}  types.PolicyResponse     "Policy data"
+// @Failure      401   {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403   {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404   {object}  infrahttp.ErrorResponse  "Policy not found"
+// @Failure      500   {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /policies/{name} [get]
+func (h *PoliciesHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	policy, err := h.policies.Get(ctx, getName(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewPolicyResponse(policy))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Lists signing policies
+// @Description  Lists the policies restricting the transactions that Ethereum accounts can sign
+// @Tags         Policies
+// @Produce      json
+// @Success      200  {array}   types.PolicyResponse     "List of policies"
+// @Failure      401  {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /policies [get]
+func (h *PoliciesHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	policies, err := h.policies.List(ctx, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewPoliciesResponse(policies))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Updates a signing policy
+// @Description  Replaces the stores, accounts and rules of a policy, empty stores or accounts applying it to all of them. Policies can only be managed by users without tenant
+// @Tags         Policies
+// @Accept       json
+// @Produce      json
+// @Param        name     path      string                   true  "policy identifier"
+// @Param        request  body      types.PolicyRequest      true  "Update Policy Request"
+// @Success      200      {object}  types.PolicyResponse     "Policy data"
+// @Failure      400      {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401      {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403      {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404      {object}  infrahttp.ErrorResponse  "Policy not found"
+// @Failure      422      {object}  infrahttp.ErrorResponse  "Invalid parameters"
+// @Failure      500      {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /policies/{name} [patch]
+func (h *PoliciesHandler) update(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	policyReq := &types.PolicyRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, policyReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	policy, err := policyReq.ToEntity(getName(r))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	policy, err = h.policies.Update(ctx, policy, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, types.NewPolicyResponse(policy))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Deletes a signing policy
+// @Description  Deletes a policy, the transactions of its accounts being no longer restricted by its rules. Policies can only be managed by users without tenant
+// @Tags         Policies
+// @Param        name  path  string  true  "policy identifier"
+// @Success      204   "Deleted successfully"
+// @Failure      401   {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403   {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404   {object}  infrahttp.ErrorResponse  "Policy not found"
+// @Failure      500   {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /policies/{name} [delete]
+func (h *PoliciesHandler) delete(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.policies.Delete(ctx, getName(r), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func getName(r *http.Request) string {
+	return mux.Vars(r)["name"]
+}
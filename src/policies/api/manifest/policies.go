@@ -0,0 +1,69 @@
+package manifest
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/json"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/policies"
+	"github.com/consensys/quorum-key-manager/src/policies/api/types"
+)
+
+type PoliciesHandler struct {
+	policies policies.Policies
+	userInfo *auth.UserInfo
+}
+
+func NewPoliciesHandler(policiesService policies.Policies) *PoliciesHandler {
+	return &PoliciesHandler{
+		policies: policiesService,
+		userInfo: newManifestUser(), // This handler always use the wildcard user because it's a manifest handler
+	}
+}
+
+// newManifestUser returns the wildcard user granted the policy permissions, which are not part of the wildcard permissions
+func newManifestUser() *auth.UserInfo {
+	userInfo := auth.NewWildcardUser()
+	userInfo.Permissions = append(userInfo.Permissions, auth.ReadPolicy, auth.WritePolicy, auth.DeletePolicy)
+	return userInfo
+}
+
+func (h *PoliciesHandler) Register(ctx context.Context, mnfs []entities.Manifest) error {
+	for _, mnf := range mnfs {
+		err := h.CreateOrUpdate(ctx, mnf.Name, mnf.Specs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateOrUpdate creates the policy of a manifest, or replaces it if it was created at a previous start, the manifest being the reference
+func (h *PoliciesHandler) CreateOrUpdate(ctx context.Context, name string, specs interface{}) error {
+	policyReq := &types.PolicyRequest{}
+	err := json.UnmarshalYAML(specs, policyReq)
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
+	policy, err := policyReq.ToEntity(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.policies.Get(ctx, name, h.userInfo)
+	switch {
+	case err != nil && errors.IsNotFoundError(err):
+		_, err = h.policies.Create(ctx, policy, h.userInfo)
+	case err == nil:
+		_, err = h.policies.Update(ctx, policy, h.userInfo)
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// PolicyRequest creates or replaces a policy, amounts being given in wei as decimal or hexadecimal strings
+type PolicyRequest struct {
+	Stores   []string     `json:"stores,omitempty" yaml:"stores,omitempty" example:"eth-accounts"`
+	Accounts []string     `json:"accounts,omitempty" yaml:"accounts,omitempty" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6"`
+	Rules    *PolicyRules `json:"rules" yaml:"rules" validate:"required"`
+}
+
+type PolicyRules struct {
+	AllowedTo         []string `json:"allowedTo,omitempty" yaml:"allowed_to,omitempty" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6,{my-registry:treasury}"`
+	FunctionSelectors []string `json:"functionSelectors,omitempty" yaml:"function_selectors,omitempty" example:"0xa9059cbb"`
+	MaxValue          string   `json:"maxValue,omitempty" yaml:"max_value,omitempty" example:"1000000000000000000"`
+	MaxDailyValue     string   `json:"maxDailyValue,omitempty" yaml:"max_daily_value,omitempty" example:"10000000000000000000"`
+	ChainIDs          []string `json:"chainIDs,omitempty" yaml:"chain_ids,omitempty" example:"1,1337"`
+	MaxGas            *uint64  `json:"maxGas,omitempty" yaml:"max_gas,omitempty" example:"300000"`
+	MaxGasPrice       string   `json:"maxGasPrice,omitempty" yaml:"max_gas_price,omitempty" example:"100000000000"`
+}
+
+type PolicyResponse struct {
+	Name      string       `json:"name" example:"treasury-limits"`
+	Stores    []string     `json:"stores,omitempty" example:"eth-accounts"`
+	Accounts  []string     `json:"accounts,omitempty" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6"`
+	Rules     *PolicyRules `json:"rules"`
+	CreatedAt time.Time    `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt time.Time    `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func (req *PolicyRequest) ToEntity(name string) (*entities.Policy, error) {
+	rules := &entities.Rules{
+		AllowedTo:         req.Rules.AllowedTo,
+		FunctionSelectors: req.Rules.FunctionSelectors,
+		MaxGas:            req.Rules.MaxGas,
+	}
+
+	var err error
+	if rules.MaxValue, err = parseAmount(entities.MaxValueRule, req.Rules.MaxValue); err != nil {
+		return nil, err
+	}
+	if rules.MaxDailyValue, err = parseAmount(entities.MaxDailyValueRule, req.Rules.MaxDailyValue); err != nil {
+		return nil, err
+	}
+	if rules.MaxGasPrice, err = parseAmount(entities.MaxGasPriceRule, req.Rules.MaxGasPrice); err != nil {
+		return nil, err
+	}
+	for _, chainID := range req.Rules.ChainIDs {
+		v, err := parseAmount(entities.ChainIDsRule, chainID)
+		if err != nil {
+			return nil, err
+		}
+		rules.ChainIDs = append(rules.ChainIDs, v)
+	}
+
+	return &entities.Policy{
+		Name:     name,
+		Stores:   req.Stores,
+		Accounts: req.Accounts,
+		Rules:    rules,
+	}, nil
+}
+
+func NewPolicyResponse(policy *entities.Policy) *PolicyResponse {
+	rules := &PolicyRules{
+		AllowedTo:         policy.Rules.AllowedTo,
+		FunctionSelectors: policy.Rules.FunctionSelectors,
+		MaxValue:          formatAmount(policy.Rules.MaxValue),
+		MaxDailyValue:     formatAmount(policy.Rules.MaxDailyValue),
+		MaxGas:            policy.Rules.MaxGas,
+		MaxGasPrice:       formatAmount(policy.Rules.MaxGasPrice),
+	}
+	for _, chainID := range policy.Rules.ChainIDs {
+		rules.ChainIDs = append(rules.ChainIDs, chainID.String())
+	}
+
+	return &PolicyResponse{
+		Name:      policy.Name,
+		Stores:    policy.Stores,
+		Accounts:  policy.Accounts,
+		Rules:     rules,
+		CreatedAt: policy.CreatedAt,
+		UpdatedAt: policy.UpdatedAt,
+	}
+}
+
+func NewPoliciesResponse(policies []*entities.Policy) []*PolicyResponse {
+	resp := make([]*PolicyResponse, 0, len(policies))
+	for _, policy := range policies {
+		resp = append(resp, NewPolicyResponse(policy))
+	}
+
+	return resp
+}
+
+// parseAmount parses a non-negative decimal or hexadecimal amount, an empty string leaving the rule unset
+func parseAmount(rule, s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	v, ok := math.ParseBig256(s)
+	if !ok || v.Sign() < 0 {
+		return nil, errors.InvalidParameterError("invalid %s %q, expected a non-negative decimal or hexadecimal number", rule, s)
+	}
+
+	return v, nil
+}
+
+func formatAmount(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+
+	return v.String()
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"github.com/consensys/quorum-key-manager/src/aliases"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/policies/api/http"
+	db "github.com/consensys/quorum-key-manager/src/policies/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/policies/service/policies"
+	"github.com/gorilla/mux"
+)
+
+func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, authService auth.Roles, aliasService aliases.Aliases) *policies.Policies {
+	// Data layer
+	policyRepository := db.NewPolicy(postgresClient)
+	spendingRepository := db.NewSpending(postgresClient)
+
+	// Business layer
+	policiesService := policies.New(policyRepository, spendingRepository, aliasService, authService, logger)
+
+	// Service layer
+	http.NewPoliciesHandler(policiesService).Register(router)
+
+	return policiesService
+}
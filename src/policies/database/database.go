@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type Policies interface {
+	// Insert inserts a policy
+	Insert(ctx context.Context, policy *entities.Policy) (*entities.Policy, error)
+	// FindOne gets a policy
+	FindOne(ctx context.Context, name string) (*entities.Policy, error)
+	// FindAll gets all the policies
+	FindAll(ctx context.Context) ([]*entities.Policy, error)
+	// Update updates a policy
+	Update(ctx context.Context, policy *entities.Policy) (*entities.Policy, error)
+	// Delete deletes a policy
+	Delete(ctx context.Context, name string) error
+}
+
+type Spendings interface {
+	// FindOne gets the value spent by an account on a day, zero if the account has not spent anything
+	FindOne(ctx context.Context, addr, day string) (*big.Int, error)
+	// FindNonce gets the value already counted in the spendings of an account for a nonce on a chain, zero if none
+	FindNonce(ctx context.Context, addr, chainID string, nonce uint64) (*big.Int, error)
+	// Add adds the value of a transaction to the spendings of an account on a day, unless the total would exceed the limit,
+	// and indicates whether it is within the limit. Only one transaction per nonce can be mined, so only the part of the
+	// value above the one already counted for the nonce on the chain is added
+	Add(ctx context.Context, addr, day, chainID string, nonce uint64, value, limit *big.Int) (bool, error)
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+)
+
+type Policy struct {
+	tableName struct{} `pg:"policies"` // nolint:unused,structcheck // reason
+
+	Name string `pg:",pk"`
+	// Stores and accounts are written even if empty on update, the policy then applying to all of them
+	Stores    []string `pg:",array,use_zero"`
+	Accounts  []string `pg:",array,use_zero"`
+	Rules     *Rules
+	CreatedAt time.Time `pg:"default:now()"`
+	UpdatedAt time.Time `pg:"default:now()"`
+}
+
+// Rules are stored as JSON, with amounts as decimal strings to keep their precision
+type Rules struct {
+	AllowedTo         []string `json:"allowed_to,omitempty"`
+	FunctionSelectors []string `json:"function_selectors,omitempty"`
+	MaxValue          string   `json:"max_value,omitempty"`
+	MaxDailyValue     string   `json:"max_daily_value,omitempty"`
+	ChainIDs          []string `json:"chain_ids,omitempty"`
+	MaxGas            *uint64  `json:"max_gas,omitempty"`
+	MaxGasPrice       string   `json:"max_gas_price,omitempty"`
+}
+
+func NewPolicy(policy *entities.Policy) *Policy {
+	rules := &Rules{
+		AllowedTo:         policy.Rules.AllowedTo,
+		FunctionSelectors: policy.Rules.FunctionSelectors,
+		MaxValue:          bigToString(policy.Rules.MaxValue),
+		MaxDailyValue:     bigToString(policy.Rules.MaxDailyValue),
+		MaxGas:            policy.Rules.MaxGas,
+		MaxGasPrice:       bigToString(policy.Rules.MaxGasPrice),
+	}
+	for _, chainID := range policy.Rules.ChainIDs {
+		rules.ChainIDs = append(rules.ChainIDs, chainID.String())
+	}
+
+	return &Policy{
+		Name:      policy.Name,
+		Stores:    policy.Stores,
+		Accounts:  policy.Accounts,
+		Rules:     rules,
+		CreatedAt: policy.CreatedAt,
+		UpdatedAt: policy.UpdatedAt,
+	}
+}
+
+func (p *Policy) ToEntity() *entities.Policy {
+	rules := &entities.Rules{
+		AllowedTo:         p.Rules.AllowedTo,
+		FunctionSelectors: p.Rules.FunctionSelectors,
+		MaxValue:          stringToBig(p.Rules.MaxValue),
+		MaxDailyValue:     stringToBig(p.Rules.MaxDailyValue),
+		MaxGas:            p.Rules.MaxGas,
+		MaxGasPrice:       stringToBig(p.Rules.MaxGasPrice),
+	}
+	for _, chainID := range p.Rules.ChainIDs {
+		rules.ChainIDs = append(rules.ChainIDs, stringToBig(chainID))
+	}
+
+	return &entities.Policy{
+		Name:      p.Name,
+		Stores:    p.Stores,
+		Accounts:  p.Accounts,
+		Rules:     rules,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+func bigToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
+
+func stringToBig(value string) *big.Int {
+	if value == "" {
+		return nil
+	}
+
+	v, _ := new(big.Int).SetString(value, 10)
+	return v
+}
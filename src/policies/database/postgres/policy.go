@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/policies/database"
+	"github.com/consensys/quorum-key-manager/src/policies/database/models"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+)
+
+type Policy struct {
+	pgClient postgres.Client
+}
+
+var _ database.Policies = &Policy{}
+
+func NewPolicy(pgClient postgres.Client) *Policy {
+	return &Policy{pgClient: pgClient}
+}
+
+func (r *Policy) Insert(ctx context.Context, policy *entities.Policy) (*entities.Policy, error) {
+	policyModel := models.NewPolicy(policy)
+
+	err := r.pgClient.Insert(ctx, policyModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return policyModel.ToEntity(), nil
+}
+
+func (r *Policy) FindOne(ctx context.Context, name string) (*entities.Policy, error) {
+	policyModel := &models.Policy{Name: name}
+
+	err := r.pgClient.SelectPK(ctx, policyModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return policyModel.ToEntity(), nil
+}
+
+func (r *Policy) FindAll(ctx context.Context) ([]*entities.Policy, error) {
+	var policyModels []*models.Policy
+
+	err := r.pgClient.Select(ctx, &policyModels)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*entities.Policy, 0, len(policyModels))
+	for _, policyModel := range policyModels {
+		policies = append(policies, policyModel.ToEntity())
+	}
+
+	return policies, nil
+}
+
+func (r *Policy) Update(ctx context.Context, policy *entities.Policy) (*entities.Policy, error) {
+	policyModel := models.NewPolicy(policy)
+	policyModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.UpdatePK(ctx, policyModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return policyModel.ToEntity(), nil
+}
+
+func (r *Policy) Delete(ctx context.Context, name string) error {
+	return r.pgClient.DeletePK(ctx, &models.Policy{Name: name})
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/policies/database"
+)
+
+// addSpendingQuery only updates the spendings of the day if the total stays within the limit, so that concurrent signatures cannot exceed it
+const addSpendingQuery = `
+INSERT INTO daily_spendings (address, day, value) VALUES (?, ?::date, ?::numeric)
+ON CONFLICT (address, day) DO UPDATE SET value = daily_spendings.value + EXCLUDED.value, updated_at = now()
+WHERE daily_spendings.value + EXCLUDED.value <= ?::numeric
+RETURNING value::text`
+
+// lockNonceQuery returns the value counted for a nonce, the row being locked until the end of the transaction so that
+// the signatures of the same nonce are counted one after the other
+const lockNonceQuery = `
+INSERT INTO spent_nonces (address, chain_id, nonce, value) VALUES (?, ?, ?, 0)
+ON CONFLICT (address, chain_id, nonce) DO UPDATE SET updated_at = now()
+RETURNING value::text`
+
+type Spending struct {
+	pgClient postgres.Client
+}
+
+var _ database.Spendings = &Spending{}
+
+func NewSpending(pgClient postgres.Client) *Spending {
+	return &Spending{pgClient: pgClient}
+}
+
+func (r *Spending) FindOne(ctx context.Context, addr, day string) (*big.Int, error) {
+	var value string
+	err := r.pgClient.QueryOne(ctx, &value, "SELECT value::text FROM daily_spendings WHERE address = ? AND day = ?::date", addr, day)
+	if err != nil && errors.IsNotFoundError(err) {
+		return big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	spent, _ := new(big.Int).SetString(value, 10)
+	return spent, nil
+}
+
+func (r *Spending) FindNonce(ctx context.Context, addr, chainID string, nonce uint64) (*big.Int, error) {
+	var value string
+	err := r.pgClient.QueryOne(ctx, &value, "SELECT value::text FROM spent_nonces WHERE address = ? AND chain_id = ? AND nonce = ?", addr, chainID, nonce)
+	if err != nil && errors.IsNotFoundError(err) {
+		return big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	counted, _ := new(big.Int).SetString(value, 10)
+	return counted, nil
+}
+
+func (r *Spending) Add(ctx context.Context, addr, day, chainID string, nonce uint64, value, limit *big.Int) (bool, error) {
+	withinLimit := false
+	err := r.pgClient.RunInTransaction(ctx, func(dbtx postgres.Client) error {
+		var counted string
+		err := dbtx.QueryOne(ctx, &counted, lockNonceQuery, addr, chainID, nonce)
+		if err != nil {
+			return err
+		}
+
+		countedValue, _ := new(big.Int).SetString(counted, 10)
+		extra := new(big.Int).Sub(value, countedValue)
+		if extra.Sign() <= 0 {
+			withinLimit = true
+			return nil
+		}
+		if extra.Cmp(limit) > 0 {
+			return nil
+		}
+
+		var total string
+		err = dbtx.QueryOne(ctx, &total, addSpendingQuery, addr, day, extra.String(), limit.String())
+		if err != nil && errors.IsNotFoundError(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = dbtx.QueryOne(
+			ctx,
+			&counted,
+			"UPDATE spent_nonces SET value = ?::numeric, updated_at = now() WHERE address = ? AND chain_id = ? AND nonce = ? RETURNING value::text",
+			value.String(), addr, chainID, nonce,
+		)
+		if err != nil {
+			return err
+		}
+
+		withinLimit = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return withinLimit, nil
+}
@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"math/big"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Rules checked by the signing policies, also used to name the violated rule in errors
+const (
+	AllowedToRule         = "allowed_to"
+	FunctionSelectorsRule = "function_selectors"
+	MaxValueRule          = "max_value"
+	MaxDailyValueRule     = "max_daily_value"
+	ChainIDsRule          = "chain_ids"
+	MaxGasRule            = "max_gas"
+	MaxGasPriceRule       = "max_gas_price"
+	// RawSigningRule is not configurable, accounts to which a policy applies can only sign checked payloads
+	RawSigningRule = "raw_signing"
+)
+
+// Policy restricts the transactions that the Ethereum accounts of a set of stores can sign
+type Policy struct {
+	Name string
+	// Stores the policy applies to, all the Ethereum stores if empty
+	Stores []string
+	// Accounts the policy applies to, all the accounts of the stores if empty
+	Accounts  []string
+	Rules     *Rules
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Rules are the constraints a transaction must satisfy to be signed, rules left empty are not enforced
+type Rules struct {
+	// AllowedTo are the addresses or aliases the transactions can be sent to
+	AllowedTo []string
+	// FunctionSelectors are the 4-byte selectors of the functions the transactions can call
+	FunctionSelectors []string
+	MaxValue          *big.Int
+	// MaxDailyValue caps the value signed by an account over a UTC day, across chains
+	MaxDailyValue *big.Int
+	ChainIDs      []*big.Int
+	MaxGas        *uint64
+	// MaxGasPrice caps the gas price of legacy transactions and the max fee per gas of dynamic fee transactions
+	MaxGasPrice *big.Int
+}
+
+// Transaction is a transaction to be signed, as checked by the signing policies
+type Transaction struct {
+	// ChainID is the chain the transaction is sent to, not bound by the signature of Quorum private transactions and nil if unknown
+	ChainID  *big.Int
+	Nonce    uint64
+	To       *ethcommon.Address
+	Value    *big.Int
+	Data     []byte
+	Gas      uint64
+	GasPrice *big.Int
+	// Private is set for Quorum private transactions, whose data is the hash of the private payload
+	Private bool
+}
+
+// AppliesTo indicates whether the policy applies to an account of a store
+func (p *Policy) AppliesTo(store string, addr ethcommon.Address) bool {
+	return (len(p.Stores) == 0 || contains(p.Stores, store)) && (len(p.Accounts) == 0 || contains(p.Accounts, addr.Hex()))
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
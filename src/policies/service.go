@@ -0,0 +1,34 @@
+package policies
+
+import (
+	"context"
+
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+//go:generate mockgen -source=service.go -destination=mock/service.go -package=mock
+
+// Policies handles the signing policies of Ethereum accounts
+type Policies interface {
+	// Create creates a policy, policies apply to all tenants and can only be managed by users without tenant
+	Create(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) (*entities.Policy, error)
+	// Get gets a policy
+	Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.Policy, error)
+	// List lists all the policies
+	List(ctx context.Context, userInfo *auth.UserInfo) ([]*entities.Policy, error)
+	// Update replaces the accounts and rules of a policy
+	Update(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) (*entities.Policy, error)
+	// Delete deletes a policy
+	Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error
+	// CheckTransaction verifies that a transaction to be signed by an account of a store complies with the policies applying to it
+	CheckTransaction(ctx context.Context, store string, addr ethcommon.Address, tx *entities.Transaction) error
+	// CheckRawSigning rejects the signature of raw data or hashes by an account of a store if policies apply to it, as they
+	// could be transactions signed without being checked
+	CheckRawSigning(ctx context.Context, store string, addr ethcommon.Address) error
+	// SpendTransaction adds the value of a transaction signed by an account of a store to its daily spendings, failing if a daily limit would be exceeded.
+	// Signatures are counted whether the transaction is sent or not, but transactions replacing one with the same nonce on
+	// the same chain (speed-ups, cancellations, signatures of the same transaction) only spend the part of their value above the replaced one
+	SpendTransaction(ctx context.Context, store string, addr ethcommon.Address, tx *entities.Transaction) error
+}
@@ -0,0 +1,246 @@
+package policies
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func (s *Policies) CheckTransaction(ctx context.Context, store string, addr ethcommon.Address, tx *entities.Transaction) error {
+	logger := s.logger.With("store", store, "address", addr.Hex())
+
+	policies, err := s.applying(ctx, store, addr, logger)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		err = s.checkRules(ctx, policy, addr, tx, logger)
+		if err != nil {
+			logger.WithError(err).Error("transaction rejected by policy", "policy", policy.Name)
+			return err
+		}
+	}
+
+	logger.Debug("transaction complies with policies", "policies", len(policies))
+	return nil
+}
+
+func (s *Policies) CheckRawSigning(ctx context.Context, store string, addr ethcommon.Address) error {
+	logger := s.logger.With("store", store, "address", addr.Hex())
+
+	policies, err := s.applying(ctx, store, addr, logger)
+	if err != nil {
+		return err
+	}
+
+	if len(policies) > 0 {
+		err = errors.PolicyViolationError(policies[0].Name, entities.RawSigningRule, "raw data and hashes cannot be signed by accounts with policies")
+		logger.WithError(err).Error("raw signing rejected by policy", "policy", policies[0].Name)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Policies) SpendTransaction(ctx context.Context, store string, addr ethcommon.Address, tx *entities.Transaction) error {
+	logger := s.logger.With("store", store, "address", addr.Hex())
+
+	if tx.Value == nil || tx.Value.Sign() == 0 {
+		return nil
+	}
+
+	policies, err := s.applying(ctx, store, addr, logger)
+	if err != nil {
+		return err
+	}
+
+	// The lowest daily limit is the only one that can be reached
+	var limiting *entities.Policy
+	for _, policy := range policies {
+		if policy.Rules.MaxDailyValue != nil && (limiting == nil || policy.Rules.MaxDailyValue.Cmp(limiting.Rules.MaxDailyValue) < 0) {
+			limiting = policy
+		}
+	}
+	if limiting == nil {
+		return nil
+	}
+
+	// Nonces are only unique per chain, the value of transactions sent to an unknown chain cannot be counted
+	if tx.ChainID == nil {
+		err = errors.PolicyViolationError(limiting.Name, entities.MaxDailyValueRule, "the chain of the transaction must be given to count its value")
+		logger.WithError(err).Error("transaction rejected by policy", "policy", limiting.Name)
+		return err
+	}
+
+	added, err := s.spendingsDB.Add(ctx, addr.Hex(), today(), tx.ChainID.String(), tx.Nonce, tx.Value, limiting.Rules.MaxDailyValue)
+	if err != nil {
+		errMessage := "failed to record daily spendings"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+	if !added {
+		err = errors.PolicyViolationError(limiting.Name, entities.MaxDailyValueRule, "value %s exceeds the daily value limit of %s", tx.Value, limiting.Rules.MaxDailyValue)
+		logger.WithError(err).Error("transaction rejected by policy", "policy", limiting.Name)
+		return err
+	}
+
+	logger.Debug("daily spendings recorded successfully", "value", tx.Value.String())
+	return nil
+}
+
+// applying returns the policies applying to an account of a store
+func (s *Policies) applying(ctx context.Context, store string, addr ethcommon.Address, logger log.Logger) ([]*entities.Policy, error) {
+	policies, err := s.db.FindAll(ctx)
+	if err != nil {
+		errMessage := "failed to get policies"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var applying []*entities.Policy
+	for _, policy := range policies {
+		if policy.AppliesTo(store, addr) {
+			applying = append(applying, policy)
+		}
+	}
+
+	return applying, nil
+}
+
+func (s *Policies) checkRules(ctx context.Context, policy *entities.Policy, addr ethcommon.Address, tx *entities.Transaction, logger log.Logger) error {
+	rules := policy.Rules
+
+	if len(rules.ChainIDs) > 0 {
+		// The signature of Quorum private transactions does not bind their chain
+		if tx.ChainID == nil || tx.Private {
+			return errors.PolicyViolationError(policy.Name, entities.ChainIDsRule, "the chain of Quorum private transactions cannot be checked")
+		}
+		if !containsBig(rules.ChainIDs, tx.ChainID) {
+			return errors.PolicyViolationError(policy.Name, entities.ChainIDsRule, "chain ID %s is not allowed", tx.ChainID)
+		}
+	}
+
+	if len(rules.AllowedTo) > 0 {
+		if tx.To == nil {
+			return errors.PolicyViolationError(policy.Name, entities.AllowedToRule, "contract deployments are not allowed")
+		}
+		if !s.isAllowedTo(ctx, rules.AllowedTo, *tx.To, logger) {
+			return errors.PolicyViolationError(policy.Name, entities.AllowedToRule, "recipient %s is not allowed", tx.To.Hex())
+		}
+	}
+
+	// Transfers without data are not function calls, unlike contract deployments which are rejected
+	if len(rules.FunctionSelectors) > 0 && (tx.To == nil || len(tx.Data) > 0) {
+		switch {
+		case tx.To == nil:
+			return errors.PolicyViolationError(policy.Name, entities.FunctionSelectorsRule, "contract deployments are not allowed")
+		case tx.Private:
+			return errors.PolicyViolationError(policy.Name, entities.FunctionSelectorsRule, "the function called by Quorum private transactions cannot be checked")
+		case len(tx.Data) < 4:
+			return errors.PolicyViolationError(policy.Name, entities.FunctionSelectorsRule, "transaction data is not a function call")
+		case !contains(rules.FunctionSelectors, hexutil.Encode(tx.Data[:4])):
+			return errors.PolicyViolationError(policy.Name, entities.FunctionSelectorsRule, "function selector %s is not allowed", hexutil.Encode(tx.Data[:4]))
+		}
+	}
+
+	value := tx.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	if rules.MaxValue != nil && value.Cmp(rules.MaxValue) > 0 {
+		return errors.PolicyViolationError(policy.Name, entities.MaxValueRule, "value %s exceeds the maximum of %s", value, rules.MaxValue)
+	}
+
+	// The daily spendings are only recorded once the transaction is signed, see SpendTransaction. A replacement of a
+	// transaction already signed only spends the part of its value above the one already counted for its nonce
+	if rules.MaxDailyValue != nil && value.Sign() > 0 {
+		if tx.ChainID == nil {
+			return errors.PolicyViolationError(policy.Name, entities.MaxDailyValueRule, "the chain of the transaction must be given to count its value")
+		}
+
+		counted, err := s.spendingsDB.FindNonce(ctx, addr.Hex(), tx.ChainID.String(), tx.Nonce)
+		if err != nil {
+			errMessage := "failed to get spendings of nonce"
+			logger.WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+
+		spent, err := s.spendingsDB.FindOne(ctx, addr.Hex(), today())
+		if err != nil {
+			errMessage := "failed to get daily spendings"
+			logger.WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+
+		extra := new(big.Int).Sub(value, counted)
+		if extra.Sign() > 0 && new(big.Int).Add(spent, extra).Cmp(rules.MaxDailyValue) > 0 {
+			return errors.PolicyViolationError(policy.Name, entities.MaxDailyValueRule, "value %s exceeds the daily value limit of %s, %s already spent today", value, rules.MaxDailyValue, spent)
+		}
+	}
+
+	if rules.MaxGas != nil && tx.Gas > *rules.MaxGas {
+		return errors.PolicyViolationError(policy.Name, entities.MaxGasRule, "gas %d exceeds the maximum of %d", tx.Gas, *rules.MaxGas)
+	}
+
+	if rules.MaxGasPrice != nil && tx.GasPrice != nil && tx.GasPrice.Cmp(rules.MaxGasPrice) > 0 {
+		return errors.PolicyViolationError(policy.Name, entities.MaxGasPriceRule, "gas price %s exceeds the maximum of %s", tx.GasPrice, rules.MaxGasPrice)
+	}
+
+	return nil
+}
+
+// isAllowedTo indicates whether a recipient is one of the allowed addresses or aliases, aliases failing to be resolved being skipped
+func (s *Policies) isAllowedTo(ctx context.Context, allowedTo []string, to ethcommon.Address, logger log.Logger) bool {
+	for _, allowed := range allowedTo {
+		values := []string{allowed}
+		if _, _, isAlias := s.aliases.Parse(allowed); isAlias {
+			var err error
+			values, err = s.aliases.Replace(ctx, values, s.aliasesUser)
+			if err != nil {
+				logger.WithError(err).Warn("failed to resolve allowed recipient alias", "alias", allowed)
+				continue
+			}
+		}
+
+		for _, value := range values {
+			if ethcommon.IsHexAddress(value) && ethcommon.HexToAddress(value) == to {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// today is the UTC day the daily spendings are recorded for
+func today() string {
+	return time.Now().UTC().Format("2006-01-02")
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsBig(list []*big.Int, v *big.Int) bool {
+	for _, item := range list {
+		if item.Cmp(v) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
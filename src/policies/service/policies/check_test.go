@@ -0,0 +1,278 @@
+package policies
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	aliasesmock "github.com/consensys/quorum-key-manager/src/aliases/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/policies/database/mock"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const storeName = "my-store"
+
+var (
+	from      = ethcommon.HexToAddress("0x664895b5fE3ddf049d2Fb508cfA03923859763C6")
+	to        = ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+	transfer  = []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01}
+	maxGas    = uint64(100000)
+	treasury  = "{my-registry:treasury}"
+	otherAddr = ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+)
+
+func TestCheckTransaction(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockPolicies(ctrl)
+	spendingsDB := mock2.NewMockSpendings(ctrl)
+	aliases := aliasesmock.NewMockAliases(ctrl)
+	service := New(db, spendingsDB, aliases, mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	aliases.EXPECT().Parse(gomock.Any()).DoAndReturn(func(alias string) (string, string, bool) {
+		return "my-registry", "treasury", alias == treasury
+	}).AnyTimes()
+
+	newTx := func() *entities.Transaction {
+		return &entities.Transaction{
+			ChainID:  big.NewInt(1337),
+			Nonce:    4,
+			To:       &to,
+			Value:    big.NewInt(1000),
+			Data:     transfer,
+			Gas:      21000,
+			GasPrice: big.NewInt(1000000000),
+		}
+	}
+
+	policy := &entities.Policy{
+		Name:   "limits",
+		Stores: []string{storeName},
+		Rules: &entities.Rules{
+			AllowedTo:         []string{to.Hex()},
+			FunctionSelectors: []string{"0xa9059cbb"},
+			MaxValue:          big.NewInt(5000),
+			MaxDailyValue:     big.NewInt(10000),
+			ChainIDs:          []*big.Int{big.NewInt(1337)},
+			MaxGas:            &maxGas,
+			MaxGasPrice:       big.NewInt(2000000000),
+		},
+	}
+
+	t.Run("should accept a transaction complying with all the rules", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{policy}, nil)
+		spendingsDB.EXPECT().FindNonce(gomock.Any(), from.Hex(), "1337", uint64(4)).Return(big.NewInt(0), nil)
+		spendingsDB.EXPECT().FindOne(gomock.Any(), from.Hex(), gomock.Any()).Return(big.NewInt(8000), nil)
+
+		err := service.CheckTransaction(ctx, storeName, from, newTx())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should ignore the policies of other stores and accounts", func(t *testing.T) {
+		otherStore := &entities.Policy{Name: "other-store", Stores: []string{"other-store"}, Rules: &entities.Rules{MaxValue: big.NewInt(0)}}
+		otherAccount := &entities.Policy{Name: "other-account", Accounts: []string{otherAddr.Hex()}, Rules: &entities.Rules{MaxValue: big.NewInt(0)}}
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{otherStore, otherAccount}, nil)
+
+		err := service.CheckTransaction(ctx, storeName, from, newTx())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should accept a recipient resolved from an alias", func(t *testing.T) {
+		aliasPolicy := &entities.Policy{Name: "aliases", Rules: &entities.Rules{AllowedTo: []string{otherAddr.Hex(), treasury}}}
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{aliasPolicy}, nil)
+		aliases.EXPECT().Replace(gomock.Any(), []string{treasury}, gomock.Any()).Return([]string{to.Hex()}, nil)
+
+		err := service.CheckTransaction(ctx, storeName, from, newTx())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a transaction exceeding the remaining daily value", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{policy}, nil)
+		spendingsDB.EXPECT().FindNonce(gomock.Any(), from.Hex(), "1337", uint64(4)).Return(big.NewInt(0), nil)
+		spendingsDB.EXPECT().FindOne(gomock.Any(), from.Hex(), gomock.Any()).Return(big.NewInt(9001), nil)
+
+		err := service.CheckTransaction(ctx, storeName, from, newTx())
+
+		assert.Equal(t, entities.MaxDailyValueRule, errors.FromError(err).GetData()["rule"])
+	})
+
+	t.Run("should accept the replacement of a transaction whose value is already counted", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{policy}, nil)
+		spendingsDB.EXPECT().FindNonce(gomock.Any(), from.Hex(), "1337", uint64(4)).Return(big.NewInt(1000), nil)
+		spendingsDB.EXPECT().FindOne(gomock.Any(), from.Hex(), gomock.Any()).Return(big.NewInt(9500), nil)
+
+		err := service.CheckTransaction(ctx, storeName, from, newTx())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject the replacement of a transaction if its additional value exceeds the remaining daily value", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{policy}, nil)
+		spendingsDB.EXPECT().FindNonce(gomock.Any(), from.Hex(), "1337", uint64(4)).Return(big.NewInt(400), nil)
+		spendingsDB.EXPECT().FindOne(gomock.Any(), from.Hex(), gomock.Any()).Return(big.NewInt(9500), nil)
+
+		err := service.CheckTransaction(ctx, storeName, from, newTx())
+
+		assert.Equal(t, entities.MaxDailyValueRule, errors.FromError(err).GetData()["rule"])
+	})
+
+	t.Run("should reject a transaction with value to an unknown chain if a daily value limit applies", func(t *testing.T) {
+		dailyPolicy := &entities.Policy{Name: "daily", Rules: &entities.Rules{MaxDailyValue: big.NewInt(10000)}}
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{dailyPolicy}, nil)
+
+		tx := newTx()
+		tx.ChainID = nil
+		err := service.CheckTransaction(ctx, storeName, from, tx)
+
+		assert.Equal(t, entities.MaxDailyValueRule, errors.FromError(err).GetData()["rule"])
+	})
+
+	t.Run("should reject a transaction violating a rule and name the rule", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			modify func(tx *entities.Transaction)
+			rule   string
+		}{
+			{"chain", func(tx *entities.Transaction) { tx.ChainID = big.NewInt(1) }, entities.ChainIDsRule},
+			{"private chain", func(tx *entities.Transaction) { tx.Private = true }, entities.ChainIDsRule},
+			{"recipient", func(tx *entities.Transaction) { tx.To = &otherAddr }, entities.AllowedToRule},
+			{"deployment", func(tx *entities.Transaction) { tx.To = nil }, entities.AllowedToRule},
+			{"selector", func(tx *entities.Transaction) { tx.Data = []byte{0x09, 0x5e, 0xa7, 0xb3} }, entities.FunctionSelectorsRule},
+			{"value", func(tx *entities.Transaction) { tx.Value = big.NewInt(5001) }, entities.MaxValueRule},
+			{"gas", func(tx *entities.Transaction) { tx.Gas = 100001 }, entities.MaxGasRule},
+			{"gas price", func(tx *entities.Transaction) { tx.GasPrice = big.NewInt(2000000001) }, entities.MaxGasPriceRule},
+		}
+
+		for _, tc := range testCases {
+			db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{policy}, nil)
+			spendingsDB.EXPECT().FindNonce(gomock.Any(), from.Hex(), gomock.Any(), uint64(4)).Return(big.NewInt(0), nil).AnyTimes()
+			spendingsDB.EXPECT().FindOne(gomock.Any(), from.Hex(), gomock.Any()).Return(big.NewInt(0), nil).AnyTimes()
+
+			tx := newTx()
+			tc.modify(tx)
+			err := service.CheckTransaction(ctx, storeName, from, tx)
+
+			require.Error(t, err, tc.name)
+			assert.True(t, errors.IsPolicyViolationError(err), tc.name)
+			assert.True(t, errors.IsForbiddenError(err), tc.name)
+			assert.Equal(t, map[string]interface{}{"policy": "limits", "rule": tc.rule}, errors.FromError(err).GetData(), tc.name)
+		}
+	})
+
+	t.Run("should accept transfers without data when function selectors are restricted", func(t *testing.T) {
+		selectorPolicy := &entities.Policy{Name: "selectors", Rules: &entities.Rules{FunctionSelectors: []string{"0xa9059cbb"}}}
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{selectorPolicy}, nil)
+
+		tx := newTx()
+		tx.Data = nil
+		err := service.CheckTransaction(ctx, storeName, from, tx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with the same error if policies cannot be fetched", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return(nil, errors.PostgresError("error"))
+
+		err := service.CheckTransaction(ctx, storeName, from, newTx())
+
+		assert.True(t, errors.IsPostgresError(err))
+	})
+}
+
+func TestCheckRawSigning(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockPolicies(ctrl)
+	service := New(db, mock2.NewMockSpendings(ctrl), aliasesmock.NewMockAliases(ctrl), mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	t.Run("should accept raw signing by an account without policies", func(t *testing.T) {
+		otherAccount := &entities.Policy{Name: "other-account", Accounts: []string{otherAddr.Hex()}, Rules: &entities.Rules{}}
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{otherAccount}, nil)
+
+		err := service.CheckRawSigning(ctx, storeName, from)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject raw signing by an account with policies", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return([]*entities.Policy{{Name: "limits", Stores: []string{storeName}, Rules: &entities.Rules{}}}, nil)
+
+		err := service.CheckRawSigning(ctx, storeName, from)
+
+		assert.True(t, errors.IsPolicyViolationError(err))
+	})
+}
+
+func TestSpendTransaction(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockPolicies(ctrl)
+	spendingsDB := mock2.NewMockSpendings(ctrl)
+	service := New(db, spendingsDB, aliasesmock.NewMockAliases(ctrl), mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	tx := &entities.Transaction{ChainID: big.NewInt(1337), Nonce: 4, To: &to, Value: big.NewInt(1000)}
+	policies := []*entities.Policy{
+		{Name: "high", Rules: &entities.Rules{MaxDailyValue: big.NewInt(50000)}},
+		{Name: "low", Rules: &entities.Rules{MaxDailyValue: big.NewInt(10000)}},
+		{Name: "no-daily-limit", Rules: &entities.Rules{}},
+	}
+
+	t.Run("should add the value to the spendings within the lowest daily limit", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return(policies, nil)
+		spendingsDB.EXPECT().Add(gomock.Any(), from.Hex(), gomock.Any(), "1337", uint64(4), tx.Value, big.NewInt(10000)).Return(true, nil)
+
+		err := service.SpendTransaction(ctx, storeName, from, tx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject the transaction if the daily limit is reached", func(t *testing.T) {
+		db.EXPECT().FindAll(gomock.Any()).Return(policies, nil)
+		spendingsDB.EXPECT().Add(gomock.Any(), from.Hex(), gomock.Any(), "1337", uint64(4), tx.Value, big.NewInt(10000)).Return(false, nil)
+
+		err := service.SpendTransaction(ctx, storeName, from, tx)
+
+		assert.Equal(t, map[string]interface{}{"policy": "low", "rule": entities.MaxDailyValueRule}, errors.FromError(err).GetData())
+	})
+
+	t.Run("should record the spendings of Quorum private transactions on their chain", func(t *testing.T) {
+		privateTx := &entities.Transaction{ChainID: big.NewInt(1337), Nonce: 4, To: &to, Value: big.NewInt(1000), Private: true}
+		db.EXPECT().FindAll(gomock.Any()).Return(policies, nil)
+		spendingsDB.EXPECT().Add(gomock.Any(), from.Hex(), gomock.Any(), "1337", uint64(4), privateTx.Value, big.NewInt(10000)).Return(true, nil)
+
+		err := service.SpendTransaction(ctx, storeName, from, privateTx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject transactions with value to an unknown chain", func(t *testing.T) {
+		privateTx := &entities.Transaction{Nonce: 4, To: &to, Value: big.NewInt(1000), Private: true}
+		db.EXPECT().FindAll(gomock.Any()).Return(policies, nil)
+
+		err := service.SpendTransaction(ctx, storeName, from, privateTx)
+
+		assert.Equal(t, map[string]interface{}{"policy": "low", "rule": entities.MaxDailyValueRule}, errors.FromError(err).GetData())
+	})
+
+	t.Run("should not record transactions without value", func(t *testing.T) {
+		err := service.SpendTransaction(ctx, storeName, from, &entities.Transaction{To: &to, Value: big.NewInt(0)})
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,41 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) Create(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) (*entities.Policy, error) {
+	logger := s.logger.With("name", policy.Name)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionWrite, Resource: auth.ResourcePolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkManager(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.normalize(policy)
+	if err != nil {
+		logger.WithError(err).Error("invalid policy")
+		return nil, err
+	}
+
+	p, err := s.db.Insert(ctx, policy)
+	if err != nil {
+		errMessage := "failed to create policy"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("policy created successfully")
+	return p, nil
+}
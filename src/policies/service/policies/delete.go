@@ -0,0 +1,34 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *Policies) Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error {
+	logger := s.logger.With("name", name)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionDelete, Resource: auth.ResourcePolicy})
+	if err != nil {
+		return err
+	}
+
+	err = checkManager(userInfo, logger)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.Delete(ctx, name)
+	if err != nil {
+		errMessage := "failed to delete policy"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("policy deleted successfully")
+	return nil
+}
@@ -0,0 +1,30 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.Policy, error) {
+	logger := s.logger.With("name", name)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourcePolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.db.FindOne(ctx, name)
+	if err != nil {
+		errMessage := "failed to get policy"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("policy retrieved successfully")
+	return policy, nil
+}
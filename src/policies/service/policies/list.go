@@ -0,0 +1,28 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) List(ctx context.Context, userInfo *auth.UserInfo) ([]*entities.Policy, error) {
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourcePolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := s.db.FindAll(ctx)
+	if err != nil {
+		errMessage := "failed to list policies"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	s.logger.Debug("policies listed successfully")
+	return policies, nil
+}
@@ -0,0 +1,85 @@
+package policies
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/aliases"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/policies"
+	"github.com/consensys/quorum-key-manager/src/policies/database"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+var selectorRegexp = regexp.MustCompile(`^0x[0-9a-fA-F]{8}$`)
+
+type Policies struct {
+	db          database.Policies
+	spendingsDB database.Spendings
+	aliases     aliases.Aliases
+	roles       auth.Roles
+	logger      log.Logger
+	// aliasesUser resolves the aliases of the allowed recipients, policies being set by administrators for all tenants
+	aliasesUser *authentities.UserInfo
+}
+
+var _ policies.Policies = &Policies{}
+
+func New(db database.Policies, spendingsDB database.Spendings, aliasService aliases.Aliases, rolesService auth.Roles, logger log.Logger) *Policies {
+	return &Policies{
+		db:          db,
+		spendingsDB: spendingsDB,
+		aliases:     aliasService,
+		roles:       rolesService,
+		logger:      logger,
+		aliasesUser: authentities.NewWildcardUser(),
+	}
+}
+
+// checkManager rejects the users of a tenant, policies applying to the accounts of all tenants
+func checkManager(userInfo *authentities.UserInfo, logger log.Logger) error {
+	if userInfo.Tenant != "" {
+		errMessage := "policies can only be managed by users without tenant"
+		logger.Error(errMessage, "tenant", userInfo.Tenant)
+		return errors.ForbiddenError(errMessage)
+	}
+
+	return nil
+}
+
+// normalize validates the accounts and rules of a policy and formats them as they are compared when checking transactions
+func (s *Policies) normalize(policy *entities.Policy) error {
+	if policy.Rules == nil {
+		policy.Rules = &entities.Rules{}
+	}
+
+	for i, account := range policy.Accounts {
+		if !ethcommon.IsHexAddress(account) {
+			return errors.InvalidParameterError("invalid account %q", account)
+		}
+		policy.Accounts[i] = ethcommon.HexToAddress(account).Hex()
+	}
+
+	for _, to := range policy.Rules.AllowedTo {
+		if _, _, isAlias := s.aliases.Parse(to); !isAlias && !ethcommon.IsHexAddress(to) {
+			return errors.InvalidParameterError("invalid allowed recipient %q, expected an address or an alias", to)
+		}
+	}
+
+	for i, selector := range policy.Rules.FunctionSelectors {
+		if !selectorRegexp.MatchString(selector) {
+			return errors.InvalidParameterError("invalid function selector %q, expected 4 bytes in hexadecimal", selector)
+		}
+		policy.Rules.FunctionSelectors[i] = strings.ToLower(selector)
+	}
+
+	if policy.Rules.MaxGas != nil && *policy.Rules.MaxGas == 0 {
+		return errors.InvalidParameterError("%s must be greater than zero", entities.MaxGasRule)
+	}
+
+	return nil
+}
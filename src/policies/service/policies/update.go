@@ -0,0 +1,47 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+)
+
+func (s *Policies) Update(ctx context.Context, policy *entities.Policy, userInfo *auth.UserInfo) (*entities.Policy, error) {
+	logger := s.logger.With("name", policy.Name)
+
+	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionWrite, Resource: auth.ResourcePolicy})
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkManager(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.normalize(policy)
+	if err != nil {
+		logger.WithError(err).Error("invalid policy")
+		return nil, err
+	}
+
+	current, err := s.Get(ctx, policy.Name, userInfo)
+	if err != nil {
+		return nil, err
+	}
+	policy.CreatedAt = current.CreatedAt
+
+	p, err := s.db.Update(ctx, policy)
+	if err != nil {
+		errMessage := "failed to update policy"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("policy updated successfully")
+	return p, nil
+}
@@ -0,0 +1,31 @@
+package clef
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func (c *Clef) accountList(ctx context.Context) ([]ethcommon.Address, error) {
+	c.logger.Debug("listing ETH accounts")
+
+	addresses, err := c.stores.ListAllAccounts(ctx, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Clef returns an empty list rather than null when there is no account
+	if addresses == nil {
+		addresses = []ethcommon.Address{}
+	}
+
+	c.logger.Debug("ETH accounts fetched successfully")
+	return addresses, nil
+}
+
+func (c *Clef) AccountList() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(c.accountList)
+	return h
+}
@@ -0,0 +1,60 @@
+package clef
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAccountList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := entities.NewWildcardUser()
+	c, stores := newClef(ctrl)
+	ctx := http.WithUserInfo(context.TODO(), userInfo)
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Accounts",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().ListAllAccounts(gomock.Any(), userInfo).Return([]ethcommon.Address{ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")}, nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_list","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":["0x78e6e236592597c09d5c137c2af40aecd42d12a2"],"error":null,"id":null}`),
+		},
+		{
+			desc:    "No accounts",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().ListAllAccounts(gomock.Any(), userInfo).Return(nil, nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_list","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":[],"error":null,"id":null}`),
+		},
+		{
+			desc:    "Error listing accounts",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().ListAllAccounts(gomock.Any(), userInfo).Return(nil, errors.PostgresError("error"))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_list","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"CN600: error"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package clef
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+// Content types supported by account_signData
+const (
+	TextPlainContentType         = "text/plain"
+	ApplicationCliqueContentType = "application/x-clique-header"
+	DataTypedContentType         = "data/typed"
+)
+
+func (c *Clef) accountSignData(ctx context.Context, contentType string, addr ethcommon.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	logger := c.logger.With("from_account", addr.Hex(), "content_type", contentType)
+	logger.Debug("signing data")
+
+	store, err := c.stores.EthereumByAddr(ctx, addr, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var sig []byte
+	switch contentType {
+	case TextPlainContentType:
+		sig, err = store.SignMessage(ctx, addr, data)
+	case DataTypedContentType:
+		typedData := &core.TypedData{}
+		err = json.Unmarshal(data, typedData)
+		if err != nil {
+			errMessage := "invalid typed data"
+			logger.WithError(err).Error(errMessage)
+			return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+		}
+
+		sig, err = store.SignTypedData(ctx, addr, typedData)
+	case ApplicationCliqueContentType:
+		header := &types.Header{}
+		err = rlp.DecodeBytes(data, header)
+		if err != nil {
+			errMessage := "invalid clique header"
+			logger.WithError(err).Error(errMessage)
+			return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+		}
+
+		// Clique seals keep the recovery ID in the 0/1 form
+		sig, err = store.Sign(ctx, addr, clique.CliqueRLP(header))
+	default:
+		errMessage := "content type not supported"
+		logger.Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.NotSupportedError(errMessage))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("data signed successfully")
+	return sig, nil
+}
+
+func (c *Clef) AccountSignData() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(c.accountSignData)
+	return h
+}
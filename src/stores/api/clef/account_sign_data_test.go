@@ -0,0 +1,71 @@
+package clef
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAccountSignData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := entities.NewWildcardUser()
+	c, stores := newClef(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := http.WithUserInfo(context.TODO(), userInfo)
+	from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+	header := &types.Header{Number: ethcommon.Big1, Extra: make([]byte, 97)}
+	encodedHeader, _ := rlp.EncodeToBytes(header)
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Text",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignMessage(gomock.Any(), from, ethcommon.FromHex("0x2eadbe1f")).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_signData","params":["text/plain","0x78e6e236592597c09d5c137c2af40aecd42d12a2","0x2eadbe1f"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Clique header",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().Sign(gomock.Any(), from, clique.CliqueRLP(header)).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_signData","params":["application/x-clique-header","0x78e6e236592597c09d5c137c2af40aecd42d12a2","` + hexutil.Encode(encodedHeader) + `"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Unsupported content type",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_signData","params":["data/validator","0x78e6e236592597c09d5c137c2af40aecd42d12a2","0x2eadbe1f"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR200: content type not supported"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
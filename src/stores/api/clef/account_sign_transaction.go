@@ -0,0 +1,105 @@
+package clef
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SignTxArgs are the transaction arguments sent by Clef clients, the chain ID being provided by the client
+type SignTxArgs struct {
+	ethereum.SendTxMsg
+	ChainID *big.Int
+}
+
+func (args *SignTxArgs) UnmarshalJSON(b []byte) error {
+	err := json.Unmarshal(b, &args.SendTxMsg)
+	if err != nil {
+		return err
+	}
+
+	raw := &struct {
+		ChainID *hexutil.Big `json:"chainId"`
+	}{}
+	err = json.Unmarshal(b, raw)
+	if err != nil {
+		return err
+	}
+
+	args.ChainID = (*big.Int)(raw.ChainID)
+	return nil
+}
+
+// SignTransactionResult is the result of account_signTransaction, raw being the RLP encoded signed transaction
+type SignTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+func (c *Clef) accountSignTransaction(ctx context.Context, args *SignTxArgs) (*SignTransactionResult, error) {
+	logger := c.logger.With("from_account", args.From.Hex())
+	logger.Debug("signing ETH transaction")
+
+	if args.Gas == nil {
+		errMessage := "gas not specified"
+		logger.Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	if args.Nonce == nil {
+		errMessage := "nonce not specified"
+		logger.Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	if args.ChainID == nil && !args.IsPrivate() {
+		errMessage := "chainId not specified"
+		logger.Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	if args.Data == nil {
+		args.Data = &[]byte{}
+	}
+
+	store, err := c.stores.EthereumByAddr(ctx, args.From, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []byte
+	switch {
+	case args.IsPrivate():
+		raw, err = store.SignPrivate(ctx, args.From, args.ChainID, args.TxDataQuorum())
+	case args.IsLegacy():
+		raw, err = store.SignTransaction(ctx, args.From, args.ChainID, args.TxData(types.LegacyTxType, args.ChainID))
+	default:
+		raw, err = store.SignTransaction(ctx, args.From, args.ChainID, args.TxData(types.DynamicFeeTxType, args.ChainID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx := new(types.Transaction)
+	err = tx.UnmarshalBinary(raw)
+	if err != nil {
+		errMessage := "failed to decode signed transaction"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.EncodingError(errMessage)
+	}
+
+	logger.Info("ETH transaction signed successfully")
+	return &SignTransactionResult{Raw: raw, Tx: tx}, nil
+}
+
+func (c *Clef) AccountSignTransaction() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(c.accountSignTransaction)
+	return h
+}
@@ -0,0 +1,97 @@
+package clef
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountSignTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := entities.NewWildcardUser()
+	c, stores := newClef(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := http.WithUserInfo(context.TODO(), userInfo)
+
+	privKey, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(privKey.PublicKey)
+	to := ethcommon.HexToAddress("0xd46e8dd67c5d32be8058bb8eb970870f07244567")
+	chainID := big.NewInt(1337)
+
+	t.Run("should sign legacy transaction successfully", func(t *testing.T) {
+		expectedTx := types.NewTx(&types.LegacyTx{
+			Nonce:    5,
+			GasPrice: big.NewInt(1000),
+			Gas:      21000,
+			To:       &to,
+			Value:    big.NewInt(10),
+			Data:     []byte{},
+		})
+		signedTx, _ := types.SignTx(expectedTx, types.NewEIP155Signer(chainID), privKey)
+		raw, _ := signedTx.MarshalBinary()
+
+		stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil)
+		accountsStore.EXPECT().SignTransaction(gomock.Any(), from, chainID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *types.Transaction) ([]byte, error) {
+				assert.Equal(t, expectedTx.Hash(), tx.Hash())
+				return raw, nil
+			})
+
+		reqBody := `{"jsonrpc":"2.0","method":"account_signTransaction","params":[{"from":"` + from.Hex() + `","to":"` + to.Hex() + `","gas":"0x5208","gasPrice":"0x3e8","value":"0xa","nonce":"0x5","chainId":"0x539"}]}`
+		resp := serveRPC(t, c, ctx, reqBody)
+
+		require.Nil(t, resp.Error)
+		result := &SignTransactionResult{}
+		require.NoError(t, resp.UnmarshalResult(result))
+		assert.Equal(t, hexutil.Bytes(raw), result.Raw)
+		assert.Equal(t, signedTx.Hash(), result.Tx.Hash())
+	})
+
+	t.Run("should fail with invalid params if chainId is missing", func(t *testing.T) {
+		reqBody := `{"jsonrpc":"2.0","method":"account_signTransaction","params":[{"from":"` + from.Hex() + `","to":"` + to.Hex() + `","gas":"0x5208","gasPrice":"0x3e8","nonce":"0x5"}]}`
+		resp := serveRPC(t, c, ctx, reqBody)
+
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, -32602, resp.Error.Code)
+	})
+
+	t.Run("should fail with invalid params if gas is missing", func(t *testing.T) {
+		reqBody := `{"jsonrpc":"2.0","method":"account_signTransaction","params":[{"from":"` + from.Hex() + `","to":"` + to.Hex() + `","gasPrice":"0x3e8","nonce":"0x5","chainId":"0x539"}]}`
+		resp := serveRPC(t, c, ctx, reqBody)
+
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, -32602, resp.Error.Code)
+	})
+}
+
+func serveRPC(t *testing.T, h jsonrpc.Handler, ctx context.Context, reqBody string) *jsonrpc.ResponseMsg {
+	rec := httptest.NewRecorder()
+
+	msg := new(jsonrpc.RequestMsg)
+	require.NoError(t, json.Unmarshal([]byte(reqBody), msg))
+
+	h.ServeRPC(jsonrpc.NewResponseWriter(rec), msg.WithContext(ctx))
+	require.Equal(t, nethttp.StatusOK, rec.Code)
+
+	resp := new(jsonrpc.ResponseMsg)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+
+	return resp
+}
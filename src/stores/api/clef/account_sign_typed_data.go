@@ -0,0 +1,34 @@
+package clef
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+func (c *Clef) accountSignTypedData(ctx context.Context, addr ethcommon.Address, typedData *core.TypedData) (hexutil.Bytes, error) {
+	logger := c.logger.With("from_account", addr.Hex())
+	logger.Debug("signing typed data")
+
+	store, err := c.stores.EthereumByAddr(ctx, addr, http.UserInfoFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := store.SignTypedData(ctx, addr, typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("typed data signed successfully")
+	return sig, nil
+}
+
+func (c *Clef) AccountSignTypedData() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(c.accountSignTypedData)
+	return h
+}
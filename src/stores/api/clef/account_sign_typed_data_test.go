@@ -0,0 +1,55 @@
+package clef
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAccountSignTypedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := entities.NewWildcardUser()
+	c, stores := newClef(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := http.WithUserInfo(context.TODO(), userInfo)
+	from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+	typedData := `{"types":{"EIP712Domain":[{"name":"name","type":"string"}],"Mail":[{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{"name":"Ether Mail"},"message":{"contents":"Hello"}}`
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Typed data",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignTypedData(gomock.Any(), from, gomock.Any()).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_signTypedData","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",` + typedData + `]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Account not found",
+			handler: c,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().EthereumByAddr(gomock.Any(), from, userInfo).Return(nil, errors.NotFoundError("account not found"))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_signTypedData","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2",` + typedData + `]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"ST100: account not found"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package clef
+
+import (
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+)
+
+func (c *Clef) accountVersion() (string, error) {
+	return ExternalAPIVersion, nil
+}
+
+func (c *Clef) AccountVersion() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(c.accountVersion)
+	return h
+}
@@ -0,0 +1,75 @@
+package clef
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/gorilla/mux"
+)
+
+// ExternalAPIVersion is the version of the Clef external API implemented
+const ExternalAPIVersion = "6.1.0"
+
+// Clef serves the Clef external API so that geth and GoQuorum nodes started with --signer can delegate signing
+type Clef struct {
+	stores  stores.Stores
+	handler jsonrpc.Handler
+	logger  log.Logger
+}
+
+func New(storesConnector stores.Stores, logger log.Logger) *Clef {
+	c := &Clef{
+		stores: storesConnector,
+		logger: logger,
+	}
+
+	c.handler = c.newHandler()
+
+	return c
+}
+
+func (c *Clef) Register(router *mux.Router) {
+	router.Methods(http.MethodPost).Path("/clef").HandlerFunc(c.serveHTTP)
+}
+
+func (c *Clef) ServeRPC(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+	c.handler.ServeRPC(rw, msg)
+}
+
+func (c *Clef) newHandler() jsonrpc.Handler {
+	// Only JSON-RPC v2 is supported
+	router := jsonrpc.NewRouter().DefaultHandler(jsonrpc.NotSupportedVersionHandler())
+	v2Router := router.Version("2.0").Subrouter().DefaultHandler(jsonrpc.MethodNotFoundHandler())
+
+	v2Router.Method("account_version").Handle(c.AccountVersion())
+	v2Router.Method("account_list").Handle(c.AccountList())
+	v2Router.Method("account_signTransaction").Handle(c.AccountSignTransaction())
+	v2Router.Method("account_signData").Handle(c.AccountSignData())
+	v2Router.Method("account_signTypedData").Handle(c.AccountSignTypedData())
+
+	return jsonrpc.LoggedHandler(jsonrpc.DefaultRWHandler(router), c.logger)
+}
+
+// @Summary      Clef external API
+// @Description  JSON-RPC endpoint implementing the Clef external API (account_version, account_list, account_signTransaction, account_signData, account_signTypedData)
+// @Tags         Clef
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  jsonrpc.ResponseMsg  "JSON-RPC response"
+// @Router       /clef [post]
+func (c *Clef) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	rpcRw := jsonrpc.NewResponseWriter(rw)
+
+	msg := new(jsonrpc.RequestMsg)
+	err := json.NewDecoder(req.Body).Decode(msg)
+	req.Body.Close()
+	if err != nil {
+		_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+		return
+	}
+
+	c.ServeRPC(rpcRw, msg.WithContext(req.Context()))
+}
@@ -0,0 +1,101 @@
+package clef
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mockstoremanager "github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClef(ctrl *gomock.Controller) (*Clef, *mockstoremanager.MockStores) {
+	stores := mockstoremanager.NewMockStores(ctrl)
+	c := New(stores, testutils.NewMockLogger(ctrl))
+
+	return c, stores
+}
+
+type testHandlerCase struct {
+	desc string
+
+	ctx context.Context
+
+	prepare func()
+	handler jsonrpc.Handler
+
+	reqBody          []byte
+	expectedRespBody []byte
+}
+
+func assertHandlerScenario(t *testing.T, tt *testHandlerCase) {
+	if tt.prepare != nil {
+		tt.prepare()
+	}
+
+	rec := httptest.NewRecorder()
+	rw := jsonrpc.NewResponseWriter(rec)
+
+	msg := new(jsonrpc.RequestMsg)
+	err := json.Unmarshal(tt.reqBody, msg)
+	require.NoError(t, err, "Unmarshal must not error")
+
+	tt.handler.ServeRPC(rw, msg.WithContext(tt.ctx))
+
+	assert.Equal(t, http.StatusOK, rec.Code, "Response code should be correct")
+	assert.Equal(t, string(tt.expectedRespBody), string(rec.Body.Bytes()[:(rec.Body.Len()-1)]), "Response body should be correct")
+}
+
+func TestAccountVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c, _ := newClef(ctrl)
+	tests := []*testHandlerCase{
+		{
+			desc:             "Version",
+			handler:          c,
+			ctx:              context.Background(),
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_version","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"6.1.0","error":null,"id":null}`),
+		},
+		{
+			desc:             "Unknown method",
+			handler:          c,
+			ctx:              context.Background(),
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"account_new","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method not found","data":null},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c, _ := newClef(ctrl)
+	router := mux.NewRouter()
+	c.Register(router)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/clef", strings.NewReader(`{"jsonrpc":"2.0","method":"account_version","params":[],"id":1}`))
+
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"jsonrpc":"2.0","result":"6.1.0","error":null,"id":1}`+"\n", rec.Body.String())
+}
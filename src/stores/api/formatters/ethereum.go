@@ -144,12 +144,17 @@ func FormatEthAccResponse(ethAcc *entities.ETHAccount) *types.EthAccountResponse
 		Address:             ethAcc.Address,
 		PublicKey:           ethAcc.PublicKey,
 		CompressedPublicKey: ethAcc.CompressedPublicKey,
+		DerivationIndex:     ethAcc.DerivationIndex,
 		Tags:                ethAcc.Tags,
 		CreatedAt:           ethAcc.Metadata.CreatedAt,
 		UpdatedAt:           ethAcc.Metadata.UpdatedAt,
 		Disabled:            ethAcc.Metadata.Disabled,
 	}
 
+	if !ethAcc.Metadata.ExpireAt.IsZero() {
+		resp.ExpireAt = &ethAcc.Metadata.ExpireAt
+	}
+
 	if !ethAcc.Metadata.DeletedAt.IsZero() {
 		resp.DeletedAt = &ethAcc.Metadata.DeletedAt
 	}
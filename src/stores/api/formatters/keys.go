@@ -15,11 +15,16 @@ func FormatKeyResponse(key *entities.Key) *types.KeyResponse {
 		SigningAlgorithm: string(key.Algo.Type),
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
+		Version:          key.Metadata.Version,
 		Disabled:         key.Metadata.Disabled,
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
 	}
 
+	if !key.Metadata.ExpireAt.IsZero() {
+		resp.ExpireAt = &key.Metadata.ExpireAt
+	}
+
 	if !key.Metadata.DeletedAt.IsZero() {
 		resp.DeletedAt = &key.Metadata.DeletedAt
 	}
@@ -16,6 +16,10 @@ func FormatSecretResponse(secret *entities.Secret) *types.SecretResponse {
 		UpdatedAt: secret.Metadata.UpdatedAt,
 	}
 
+	if !secret.Metadata.ExpireAt.IsZero() {
+		resp.ExpireAt = &secret.Metadata.ExpireAt
+	}
+
 	if !secret.Metadata.DeletedAt.IsZero() {
 		resp.DeletedAt = &secret.Metadata.DeletedAt
 	}
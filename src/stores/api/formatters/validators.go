@@ -0,0 +1,68 @@
+package formatters
+
+import (
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func FormatSlashingProtectionInterchangeRequest(request *types.SlashingProtectionInterchange) *entities.SlashingProtectionInterchange {
+	interchange := &entities.SlashingProtectionInterchange{
+		GenesisValidatorsRoot: request.Metadata.GenesisValidatorsRoot,
+	}
+
+	for _, validator := range request.Data {
+		history := &entities.ValidatorSigningHistory{PublicKey: validator.PublicKey}
+		for _, block := range validator.SignedBlocks {
+			history.SignedBlocks = append(history.SignedBlocks, &entities.SignedBlock{
+				Slot:        block.Slot,
+				SigningRoot: block.SigningRoot,
+			})
+		}
+		for _, attestation := range validator.SignedAttestations {
+			history.SignedAttestations = append(history.SignedAttestations, &entities.SignedAttestation{
+				SourceEpoch: attestation.SourceEpoch,
+				TargetEpoch: attestation.TargetEpoch,
+				SigningRoot: attestation.SigningRoot,
+			})
+		}
+
+		interchange.Data = append(interchange.Data, history)
+	}
+
+	return interchange
+}
+
+func FormatSlashingProtectionInterchangeResponse(interchange *entities.SlashingProtectionInterchange) *types.SlashingProtectionInterchange {
+	resp := &types.SlashingProtectionInterchange{
+		Metadata: &types.SlashingProtectionMetadata{
+			InterchangeFormatVersion: types.SlashingProtectionInterchangeVersion,
+			GenesisValidatorsRoot:    interchange.GenesisValidatorsRoot,
+		},
+		Data: []*types.ValidatorSigningHistory{},
+	}
+
+	for _, validator := range interchange.Data {
+		history := &types.ValidatorSigningHistory{
+			PublicKey:          validator.PublicKey,
+			SignedBlocks:       []*types.SignedBlockRecord{},
+			SignedAttestations: []*types.SignedAttestationRecord{},
+		}
+		for _, block := range validator.SignedBlocks {
+			history.SignedBlocks = append(history.SignedBlocks, &types.SignedBlockRecord{
+				Slot:        block.Slot,
+				SigningRoot: block.SigningRoot,
+			})
+		}
+		for _, attestation := range validator.SignedAttestations {
+			history.SignedAttestations = append(history.SignedAttestations, &types.SignedAttestationRecord{
+				SourceEpoch: attestation.SourceEpoch,
+				TargetEpoch: attestation.TargetEpoch,
+				SigningRoot: attestation.SigningRoot,
+			})
+		}
+
+		resp.Data = append(resp.Data, history)
+	}
+
+	return resp
+}
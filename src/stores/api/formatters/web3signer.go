@@ -0,0 +1,211 @@
+package formatters
+
+import (
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/eth2"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+)
+
+// FormatWeb3SignerSigningRoot computes the signing root of an eth2 signing request from its typed payload, so that the domain
+// of the signature always matches the type of the request. The genesis fork version is used for builder registrations
+func FormatWeb3SignerSigningRoot(request *types.Web3SignerEth2SignRequest, genesisForkVersion eth2.Version) ([]byte, error) {
+	var objectRoot eth2.Root
+	var domainType eth2.DomainType
+	var epoch uint64
+	var err error
+
+	switch request.Type {
+	case types.Web3SignerBlock, types.Web3SignerBlockV2:
+		header, err := Web3SignerBlockHeader(request)
+		if err != nil {
+			return nil, err
+		}
+
+		objectRoot, domainType, epoch = formatBlockHeader(header).HashTreeRoot(), eth2.DomainBeaconProposer, eth2.EpochAtSlot(header.Slot)
+	case types.Web3SignerAttestation:
+		if request.Attestation == nil {
+			return nil, errors.InvalidFormatError("attestation is required")
+		}
+
+		data := formatAttestationData(request.Attestation)
+		objectRoot, domainType, epoch = data.HashTreeRoot(), eth2.DomainBeaconAttester, data.Target.Epoch
+	case types.Web3SignerAggregationSlot:
+		if request.AggregationSlot == nil {
+			return nil, errors.InvalidFormatError("aggregation_slot is required")
+		}
+
+		slot := request.AggregationSlot.Slot
+		objectRoot, domainType, epoch = eth2.Uint64Root(slot), eth2.DomainSelectionProof, eth2.EpochAtSlot(slot)
+	case types.Web3SignerAggregateAndProof:
+		if request.AggregateAndProof == nil {
+			return nil, errors.InvalidFormatError("aggregate_and_proof is required")
+		}
+
+		aggregateAndProof := &eth2.AggregateAndProof{
+			AggregatorIndex: request.AggregateAndProof.AggregatorIndex,
+			Aggregate: eth2.Attestation{
+				AggregationBits: request.AggregateAndProof.Aggregate.AggregationBits,
+				Data:            *formatAttestationData(request.AggregateAndProof.Aggregate.Data),
+				Signature:       request.AggregateAndProof.Aggregate.Signature,
+			},
+			SelectionProof: request.AggregateAndProof.SelectionProof,
+		}
+		objectRoot, err = aggregateAndProof.HashTreeRoot()
+		domainType, epoch = eth2.DomainAggregateAndProof, eth2.EpochAtSlot(aggregateAndProof.Aggregate.Data.Slot)
+	case types.Web3SignerDeposit:
+		if request.Deposit == nil {
+			return nil, errors.InvalidFormatError("deposit is required")
+		}
+
+		deposit := &eth2.DepositMessage{
+			PublicKey:             request.Deposit.PublicKey,
+			WithdrawalCredentials: toRoot(request.Deposit.WithdrawalCredentials),
+			Amount:                request.Deposit.Amount,
+		}
+		objectRoot, err = deposit.HashTreeRoot()
+		if err != nil {
+			return nil, errors.InvalidFormatError(err.Error())
+		}
+
+		// Deposits are valid across forks, their domain only depends on the genesis fork version
+		domain := eth2.ComputeDomain(eth2.DomainDeposit, toVersion(request.Deposit.GenesisForkVersion), eth2.Root{})
+		return signingRoot(eth2.ComputeSigningRoot(objectRoot, domain)), nil
+	case types.Web3SignerRandaoReveal:
+		if request.RandaoReveal == nil {
+			return nil, errors.InvalidFormatError("randao_reveal is required")
+		}
+
+		epoch = request.RandaoReveal.Epoch
+		objectRoot, domainType = eth2.Uint64Root(epoch), eth2.DomainRandao
+	case types.Web3SignerVoluntaryExit:
+		if request.VoluntaryExit == nil {
+			return nil, errors.InvalidFormatError("voluntary_exit is required")
+		}
+
+		exit := &eth2.VoluntaryExit{Epoch: request.VoluntaryExit.Epoch, ValidatorIndex: request.VoluntaryExit.ValidatorIndex}
+		objectRoot, domainType, epoch = exit.HashTreeRoot(), eth2.DomainVoluntaryExit, exit.Epoch
+	case types.Web3SignerSyncCommitteeMessage:
+		if request.SyncCommitteeMessage == nil {
+			return nil, errors.InvalidFormatError("sync_committee_message is required")
+		}
+
+		message := request.SyncCommitteeMessage
+		objectRoot, domainType, epoch = toRoot(message.BeaconBlockRoot), eth2.DomainSyncCommittee, eth2.EpochAtSlot(message.Slot)
+	case types.Web3SignerSyncCommitteeSelectionProof:
+		if request.SyncAggregatorSelectionData == nil {
+			return nil, errors.InvalidFormatError("sync_aggregator_selection_data is required")
+		}
+
+		data := &eth2.SyncAggregatorSelectionData{
+			Slot:              request.SyncAggregatorSelectionData.Slot,
+			SubcommitteeIndex: request.SyncAggregatorSelectionData.SubcommitteeIndex,
+		}
+		objectRoot, domainType, epoch = data.HashTreeRoot(), eth2.DomainSyncCommitteeSelectionProof, eth2.EpochAtSlot(data.Slot)
+	case types.Web3SignerSyncCommitteeContributionAndProof:
+		if request.ContributionAndProof == nil {
+			return nil, errors.InvalidFormatError("contribution_and_proof is required")
+		}
+
+		contribution := request.ContributionAndProof.Contribution
+		contributionAndProof := &eth2.ContributionAndProof{
+			AggregatorIndex: request.ContributionAndProof.AggregatorIndex,
+			Contribution: eth2.SyncCommitteeContribution{
+				Slot:              contribution.Slot,
+				BeaconBlockRoot:   toRoot(contribution.BeaconBlockRoot),
+				SubcommitteeIndex: contribution.SubcommitteeIndex,
+				AggregationBits:   contribution.AggregationBits,
+				Signature:         contribution.Signature,
+			},
+			SelectionProof: request.ContributionAndProof.SelectionProof,
+		}
+		objectRoot, err = contributionAndProof.HashTreeRoot()
+		domainType, epoch = eth2.DomainContributionAndProof, eth2.EpochAtSlot(contribution.Slot)
+	case types.Web3SignerValidatorRegistration:
+		if request.ValidatorRegistration == nil {
+			return nil, errors.InvalidFormatError("validator_registration is required")
+		}
+
+		registration := &eth2.ValidatorRegistration{
+			FeeRecipient: request.ValidatorRegistration.FeeRecipient,
+			GasLimit:     request.ValidatorRegistration.GasLimit,
+			Timestamp:    request.ValidatorRegistration.Timestamp,
+			PublicKey:    request.ValidatorRegistration.PublicKey,
+		}
+		objectRoot, err = registration.HashTreeRoot()
+		if err != nil {
+			return nil, errors.InvalidFormatError(err.Error())
+		}
+
+		// Builder registrations are valid across forks and chains of the same network
+		domain := eth2.ComputeDomain(eth2.DomainApplicationBuilder, genesisForkVersion, eth2.Root{})
+		return signingRoot(eth2.ComputeSigningRoot(objectRoot, domain)), nil
+	default:
+		return nil, errors.InvalidFormatError("signing request type %q is not supported", request.Type)
+	}
+	if err != nil {
+		return nil, errors.InvalidFormatError(err.Error())
+	}
+
+	if request.ForkInfo == nil {
+		return nil, errors.InvalidFormatError("fork_info is required")
+	}
+
+	fork := &eth2.Fork{
+		PreviousVersion: toVersion(request.ForkInfo.Fork.PreviousVersion),
+		CurrentVersion:  toVersion(request.ForkInfo.Fork.CurrentVersion),
+		Epoch:           request.ForkInfo.Fork.Epoch,
+	}
+	domain := eth2.ComputeDomain(domainType, fork.Version(epoch), toRoot(request.ForkInfo.GenesisValidatorsRoot))
+	return signingRoot(eth2.ComputeSigningRoot(objectRoot, domain)), nil
+}
+
+// Web3SignerBlockHeader returns the header of the block of a block signing request
+func Web3SignerBlockHeader(request *types.Web3SignerEth2SignRequest) (*types.Web3SignerBlockHeader, error) {
+	switch {
+	case request.Block != nil:
+		return request.Block, nil
+	case request.BeaconBlock != nil && request.BeaconBlock.BlockHeader != nil:
+		return request.BeaconBlock.BlockHeader, nil
+	case request.BeaconBlock != nil && request.BeaconBlock.Block != nil:
+		return request.BeaconBlock.Block, nil
+	default:
+		return nil, errors.InvalidFormatError("block or beacon_block is required")
+	}
+}
+
+func formatBlockHeader(header *types.Web3SignerBlockHeader) *eth2.BeaconBlockHeader {
+	return &eth2.BeaconBlockHeader{
+		Slot:          header.Slot,
+		ProposerIndex: header.ProposerIndex,
+		ParentRoot:    toRoot(header.ParentRoot),
+		StateRoot:     toRoot(header.StateRoot),
+		BodyRoot:      toRoot(header.BodyRoot),
+	}
+}
+
+func formatAttestationData(data *types.Web3SignerAttestationData) *eth2.AttestationData {
+	return &eth2.AttestationData{
+		Slot:            data.Slot,
+		Index:           data.Index,
+		BeaconBlockRoot: toRoot(data.BeaconBlockRoot),
+		Source:          eth2.Checkpoint{Epoch: data.Source.Epoch, Root: toRoot(data.Source.Root)},
+		Target:          eth2.Checkpoint{Epoch: data.Target.Epoch, Root: toRoot(data.Target.Root)},
+	}
+}
+
+// toRoot and toVersion expect lengths checked by the request validation
+func toRoot(b []byte) eth2.Root {
+	var root eth2.Root
+	copy(root[:], b)
+	return root
+}
+
+func toVersion(b []byte) eth2.Version {
+	var version eth2.Version
+	copy(version[:], b)
+	return version
+}
+
+func signingRoot(root eth2.Root) []byte {
+	return root[:]
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -39,13 +40,19 @@ func (h *EthHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodPost).Path("").HandlerFunc(h.create)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodPost).Path("/import").HandlerFunc(h.importAccount)
+	r.Methods(http.MethodPost).Path("/import-mnemonic").HandlerFunc(h.importMnemonic)
 	r.Methods(http.MethodPost).Path("/{address}/sign-transaction").HandlerFunc(h.signTransaction)
 	r.Methods(http.MethodPost).Path("/{address}/sign-quorum-private-transaction").HandlerFunc(h.signPrivateTransaction)
 	r.Methods(http.MethodPost).Path("/{address}/sign-eea-transaction").HandlerFunc(h.signEEATransaction)
 	r.Methods(http.MethodPost).Path("/{address}/sign-typed-data").HandlerFunc(h.signTypedData)
 	r.Methods(http.MethodPost).Path("/{address}/sign-typed-data-hash").HandlerFunc(h.SignTypedDataHash)
 	r.Methods(http.MethodPost).Path("/{address}/sign-message").HandlerFunc(h.signMessage)
+	r.Methods(http.MethodPost).Path("/{address}/encrypt").HandlerFunc(h.encrypt)
+	r.Methods(http.MethodPost).Path("/{address}/decrypt").HandlerFunc(h.decrypt)
+	r.Methods(http.MethodPost).Path("/{address}/export").HandlerFunc(h.export)
 	r.Methods(http.MethodPut).Path("/{address}/restore").HandlerFunc(h.restore)
+	r.Methods(http.MethodPut).Path("/{address}/enable").HandlerFunc(h.enable)
+	r.Methods(http.MethodPut).Path("/{address}/disable").HandlerFunc(h.disable)
 	r.Methods(http.MethodPatch).Path("/{address}").HandlerFunc(h.update)
 	r.Methods(http.MethodGet).Path("/{address}").HandlerFunc(h.getOne)
 	r.Methods(http.MethodDelete).Path("/{address}").HandlerFunc(h.delete)
@@ -89,7 +96,7 @@ func (h *EthHandler) create(rw http.ResponseWriter, request *http.Request) {
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{Tags: createReq.Tags})
+	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{Tags: createReq.Tags, ExpireAt: createReq.ExpireAt})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -103,7 +110,7 @@ func (h *EthHandler) create(rw http.ResponseWriter, request *http.Request) {
 }
 
 // @Summary      Import an Ethereum Account
-// @Description  Import an ECDSA Secp256k1 key representing an Ethereum account
+// @Description  Import an ECDSA Secp256k1 key representing an Ethereum account, either as a raw private key or as a keystore V3 JSON and its passphrase
 // @Accept       json
 // @Produce      json
 // @Tags         Ethereum
@@ -139,7 +146,14 @@ func (h *EthHandler) importAccount(rw http.ResponseWriter, request *http.Request
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Import(ctx, keyID, importReq.PrivateKey, &entities.Attributes{Tags: importReq.Tags})
+	attr := &entities.Attributes{Tags: importReq.Tags, ExpireAt: importReq.ExpireAt}
+
+	var ethAcc *entities.ETHAccount
+	if importReq.Keystore != nil {
+		ethAcc, err = ethStore.ImportKeystore(ctx, keyID, importReq.Keystore, importReq.Passphrase, attr)
+	} else {
+		ethAcc, err = ethStore.Import(ctx, keyID, importReq.PrivateKey, attr)
+	}
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -152,6 +166,57 @@ func (h *EthHandler) importAccount(rw http.ResponseWriter, request *http.Request
 	}
 }
 
+// @Summary      Import a mnemonic into an HD wallet Ethereum store
+// @Description  Set the seed of an HD wallet Ethereum store from a BIP-39 mnemonic and derive its first accounts, to recover the store from a backup
+// @Accept       json
+// @Produce      json
+// @Tags         Ethereum
+// @Param        storeName  path      string                          true  "Store ID"
+// @Param        request    body      types.ImportEthMnemonicRequest  true  "Import mnemonic request"
+// @Success      200        {array}   types.EthAccountResponse        "Derived Ethereum Accounts"
+// @Failure      400        {object}  infrahttp.ErrorResponse         "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse         "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse         "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse         "Store not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse         "A different seed is already set"
+// @Failure      422        {object}  infrahttp.ErrorResponse         "Invalid mnemonic"
+// @Failure      501        {object}  infrahttp.ErrorResponse         "Not an HD wallet store"
+// @Failure      500        {object}  infrahttp.ErrorResponse         "Internal server error"
+// @Router       /stores/{storeName}/ethereum/import-mnemonic [post]
+func (h *EthHandler) importMnemonic(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	importReq := &types.ImportEthMnemonicRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, importReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	ethAccs, err := ethStore.ImportMnemonic(ctx, importReq.Mnemonic, importReq.Passphrase, importReq.NumAccounts, &entities.Attributes{Tags: importReq.Tags, ExpireAt: importReq.ExpireAt})
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	resp := []*types.EthAccountResponse{}
+	for _, ethAcc := range ethAccs {
+		resp = append(resp, formatters.FormatEthAccResponse(ethAcc))
+	}
+
+	err = infrahttp.WriteJSON(rw, resp)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Update an Ethereum Account
 // @Description  Update an Ethereum Account metadata
 // @Accept       json
@@ -183,7 +248,11 @@ func (h *EthHandler) update(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	ethAcc, err := ethStore.Update(ctx, getAddress(request), &entities.Attributes{Tags: updateReq.Tags})
+	ethAcc, err := ethStore.Update(ctx, getAddress(request), &entities.Attributes{
+		Tags:          updateReq.Tags,
+		ExpireAt:      updateReq.ExpireAt,
+		ClearExpireAt: updateReq.ClearExpireAt,
+	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -242,6 +311,141 @@ func (h *EthHandler) signMessage(rw http.ResponseWriter, request *http.Request)
 	}
 }
 
+// @Summary      Encrypt data
+// @Description  Encrypt data (ECIES) to the public key of an existing Ethereum Account
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                   true  "Store ID"
+// @Param        address    path      string                   true  "Ethereum address"
+// @Param        request    body      types.EncryptRequest     true  "Encrypt request"
+// @Success      200        {string}  string                   "Encrypted data"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Account not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Not supported by the store"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/encrypt [post]
+func (h *EthHandler) encrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	encryptReq := &types.EncryptRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, encryptReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	ciphertext, err := ethStore.Encrypt(ctx, getAddress(request), encryptReq.Data)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(hexutil.Encode(ciphertext)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Decrypt data
+// @Description  Decrypt data (ECIES) previously encrypted to an existing Ethereum Account
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                   true  "Store ID"
+// @Param        address    path      string                   true  "Ethereum address"
+// @Param        request    body      types.DecryptRequest     true  "Decrypt request"
+// @Success      200        {string}  string                   "Decrypted data"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Account not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Not supported by the store"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/decrypt [post]
+func (h *EthHandler) decrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	decryptReq := &types.DecryptRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, decryptReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	plaintext, err := ethStore.Decrypt(ctx, getAddress(request), decryptReq.Data)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(hexutil.Encode(plaintext)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Export an Ethereum Account
+// @Description  Export an Ethereum Account as a keystore V3 JSON encrypted with the given passphrase. Requires the export:ethereum permission and a key store holding the key material
+// @Tags         Ethereum
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                         true  "Store ID"
+// @Param        address    path      string                         true  "Ethereum address"
+// @Param        request    body      types.ExportEthAccountRequest  true  "Export request"
+// @Success      200        {object}  object                         "Keystore V3 JSON"
+// @Failure      400        {object}  infrahttp.ErrorResponse        "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse        "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse        "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse        "Store/Account not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse        "Not supported by the store"
+// @Failure      500        {object}  infrahttp.ErrorResponse        "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/export [post]
+func (h *EthHandler) export(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	exportReq := &types.ExportEthAccountRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, exportReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	keyJSON, err := ethStore.Export(ctx, getAddress(request), exportReq.Passphrase)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, json.RawMessage(keyJSON))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Sign Typed Data Hash (EIP-712)
 // @Description  Sign Typed Data, following EIP-712, using identified Ethereum Account
 // @Tags         Ethereum
@@ -457,7 +661,7 @@ func (h *EthHandler) signPrivateTransaction(rw http.ResponseWriter, request *htt
 		return
 	}
 
-	signature, err := ethStore.SignPrivate(ctx, getAddress(request), formatters.FormatPrivateTransaction(signPrivateReq))
+	signature, err := ethStore.SignPrivate(ctx, getAddress(request), signPrivateReq.ChainID.ToInt(), formatters.FormatPrivateTransaction(signPrivateReq))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -651,6 +855,66 @@ func (h *EthHandler) restore(rw http.ResponseWriter, request *http.Request) {
 	rw.WriteHeader(http.StatusNoContent)
 }
 
+// @Summary      Enable Ethereum Account
+// @Description  Enable an Ethereum Account. Disabled accounts cannot be used to sign, encrypt or decrypt
+// @Tags         Ethereum
+// @Accept       json
+// @Param        storeName  path  string  true  "Store ID"
+// @Param        address    path  string  true  "Ethereum address"
+// @Success      204        "Enabled successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Account not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/enable [put]
+func (h *EthHandler) enable(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = ethStore.Enable(ctx, getAddress(request))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Disable Ethereum Account
+// @Description  Disable an Ethereum Account. Disabled accounts cannot be used to sign, encrypt or decrypt
+// @Tags         Ethereum
+// @Accept       json
+// @Param        storeName  path  string  true  "Store ID"
+// @Param        address    path  string  true  "Ethereum address"
+// @Success      204        "Disabled successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Account not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/ethereum/{address}/disable [put]
+func (h *EthHandler) disable(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	ethStore, err := h.stores.Ethereum(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = ethStore.Disable(ctx, getAddress(request))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
 func getAddress(request *http.Request) ethcommon.Address {
 	return ethcommon.HexToAddress(mux.Vars(request)["address"])
 }
@@ -20,8 +20,11 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/api/types/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
@@ -68,7 +71,7 @@ func (s *ethHandlerTestSuite) SetupTest() {
 	s.stores.EXPECT().Ethereum(gomock.Any(), ethStoreName, ethUserInfo).Return(s.ethStore, nil).AnyTimes()
 
 	s.router = mux.NewRouter()
-	NewStoresHandler(s.stores).Register(s.router)
+	NewStoresHandler(s.stores, "").Register(s.router)
 }
 
 func (s *ethHandlerTestSuite) TearDownTest() {
@@ -221,6 +224,63 @@ func (s *ethHandlerTestSuite) TestImport() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should execute request with keystore successfully", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		importEthAccountRequest.Keystore = fakeKeystore(importEthAccountRequest.PrivateKey, "my-passphrase")
+		importEthAccountRequest.PrivateKey = nil
+		importEthAccountRequest.Passphrase = "my-passphrase"
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		acc := testutils2.FakeETHAccount()
+
+		s.ethStore.EXPECT().ImportKeystore(
+			gomock.Any(),
+			importEthAccountRequest.KeyID,
+			[]byte(importEthAccountRequest.Keystore),
+			importEthAccountRequest.Passphrase,
+			&entities.Attributes{
+				Tags: importEthAccountRequest.Tags,
+			}).Return(acc, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatEthAccResponse(acc)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 422 if keystore passphrase is wrong", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		importEthAccountRequest.Keystore = fakeKeystore(importEthAccountRequest.PrivateKey, "my-passphrase")
+		importEthAccountRequest.PrivateKey = nil
+		importEthAccountRequest.Passphrase = "wrong-passphrase"
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().ImportKeystore(gomock.Any(), gomock.Any(), gomock.Any(), "wrong-passphrase", gomock.Any()).Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusUnprocessableEntity, rw.Code)
+	})
+
+	s.Run("should fail with 400 if both private key and keystore are set", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		importEthAccountRequest.Keystore = fakeKeystore(importEthAccountRequest.PrivateKey, "my-passphrase")
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
@@ -236,6 +296,62 @@ func (s *ethHandlerTestSuite) TestImport() {
 	})
 }
 
+func (s *ethHandlerTestSuite) TestImportMnemonic() {
+	s.Run("should execute request successfully", func() {
+		importMnemonicRequest := testutils.FakeImportEthMnemonicRequest()
+		requestBytes, _ := json.Marshal(importMnemonicRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import-mnemonic", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		acc := testutils2.FakeETHAccount()
+		index := uint32(0)
+		acc.DerivationIndex = &index
+
+		s.ethStore.EXPECT().ImportMnemonic(
+			gomock.Any(),
+			importMnemonicRequest.Mnemonic,
+			importMnemonicRequest.Passphrase,
+			importMnemonicRequest.NumAccounts,
+			&entities.Attributes{
+				Tags: importMnemonicRequest.Tags,
+			}).Return([]*entities.ETHAccount{acc}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := []*apiTypes.EthAccountResponse{formatters.FormatEthAccResponse(acc)}
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if number of accounts is missing", func() {
+		importMnemonicRequest := testutils.FakeImportEthMnemonicRequest()
+		importMnemonicRequest.NumAccounts = 0
+		requestBytes, _ := json.Marshal(importMnemonicRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import-mnemonic", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
+	s.Run("should fail with correct error code if use case fails", func() {
+		importMnemonicRequest := testutils.FakeImportEthMnemonicRequest()
+		requestBytes, _ := json.Marshal(importMnemonicRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import-mnemonic", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().ImportMnemonic(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.NotSupportedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotImplemented, rw.Code)
+	})
+}
+
 func (s *ethHandlerTestSuite) TestUpdate() {
 	s.Run("should execute request successfully", func() {
 		updateEthAccountRequest := testutils.FakeUpdateEthAccountRequest()
@@ -333,6 +449,81 @@ func (s *ethHandlerTestSuite) TestSignMessage() {
 	})
 }
 
+func (s *ethHandlerTestSuite) TestEncrypt() {
+	s.Run("should execute request successfully", func() {
+		encryptRequest := testutils.FakeEncryptRequest()
+		requestBytes, _ := json.Marshal(encryptRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/encrypt", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		ciphertext := []byte("ciphertext")
+		s.ethStore.EXPECT().Encrypt(gomock.Any(), ethcommon.HexToAddress(accAddress), []byte(encryptRequest.Data)).Return(ciphertext, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(ciphertext), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *ethHandlerTestSuite) TestDecrypt() {
+	s.Run("should execute request successfully", func() {
+		decryptRequest := testutils.FakeDecryptRequest()
+		requestBytes, _ := json.Marshal(decryptRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/decrypt", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		plaintext := []byte("plaintext")
+		s.ethStore.EXPECT().Decrypt(gomock.Any(), ethcommon.HexToAddress(accAddress), []byte(decryptRequest.Data)).Return(plaintext, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(plaintext), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *ethHandlerTestSuite) TestExport() {
+	s.Run("should execute request successfully", func() {
+		exportRequest := testutils.FakeExportEthAccountRequest()
+		requestBytes, _ := json.Marshal(exportRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/export", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		keyJSON := fakeKeystore(hexutil.MustDecode("0xdb337ca3295e4050586793f252e641f3b3a83739018fa4cce01a81ca920e7e1c"), exportRequest.Passphrase)
+		s.ethStore.EXPECT().Export(gomock.Any(), ethcommon.HexToAddress(accAddress), exportRequest.Passphrase).Return(keyJSON, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), string(keyJSON)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if passphrase is missing", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/export", ethStoreName, accAddress), bytes.NewReader([]byte("{}"))).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
+	s.Run("should fail with correct error code if use case fails", func() {
+		requestBytes, _ := json.Marshal(testutils.FakeExportEthAccountRequest())
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/export", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Export(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.NotSupportedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotImplemented, rw.Code)
+	})
+}
+
 func (s *ethHandlerTestSuite) TestSignTransaction() {
 	s.Run("should execute request successfully with default type DYNAMIC_FEE", func() {
 		signTransactionRequest := testutils.FakeSignETHTransactionRequest("")
@@ -421,7 +612,7 @@ func (s *ethHandlerTestSuite) TestSignPrivateTransaction() {
 		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/sign-quorum-private-transaction", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
 
 		signedRaw := []byte("signedRaw")
-		s.ethStore.EXPECT().SignPrivate(gomock.Any(), ethcommon.HexToAddress(accAddress), gomock.Any()).Return(signedRaw, nil)
+		s.ethStore.EXPECT().SignPrivate(gomock.Any(), ethcommon.HexToAddress(accAddress), gomock.Any(), gomock.Any()).Return(signedRaw, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 
@@ -437,7 +628,7 @@ func (s *ethHandlerTestSuite) TestSignPrivateTransaction() {
 		rw := httptest.NewRecorder()
 		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/sign-quorum-private-transaction", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
 
-		s.ethStore.EXPECT().SignPrivate(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.HashicorpVaultError("error"))
+		s.ethStore.EXPECT().SignPrivate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.HashicorpVaultError("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(s.T(), http.StatusFailedDependency, rw.Code)
@@ -663,3 +854,46 @@ func (s *ethHandlerTestSuite) TestRestore() {
 		assert.Equal(s.T(), http.StatusFailedDependency, rw.Code)
 	})
 }
+
+func (s *ethHandlerTestSuite) TestEnableDisable() {
+	s.Run("should enable account successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/%s/ethereum/%s/enable", ethStoreName, accAddress), nil).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Enable(gomock.Any(), ethcommon.HexToAddress(accAddress)).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should disable account successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/%s/ethereum/%s/disable", ethStoreName, accAddress), nil).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Disable(gomock.Any(), ethcommon.HexToAddress(accAddress)).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should fail with correct error code if use case fails", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/%s/ethereum/%s/disable", ethStoreName, accAddress), nil).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Disable(gomock.Any(), gomock.Any()).Return(errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
+
+func fakeKeystore(privKey []byte, passphrase string) []byte {
+	ecdsaKey, _ := crypto.ToECDSA(privKey)
+	keyJSON, _ := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(ecdsaKey.PublicKey),
+		PrivateKey: ecdsaKey,
+	}, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+
+	return keyJSON
+}
@@ -20,22 +20,29 @@ import (
 )
 
 type KeysHandler struct {
-	stores stores.Stores
+	stores    stores.Stores
+	eth2Store string
 }
 
-func NewKeysHandler(storesConnector stores.Stores) *KeysHandler {
+func NewKeysHandler(storesConnector stores.Stores, eth2Store string) *KeysHandler {
 	return &KeysHandler{
-		stores: storesConnector,
+		stores:    storesConnector,
+		eth2Store: eth2Store,
 	}
 }
 
 func (h *KeysHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodPost).Path("/{id}/import").HandlerFunc(h.importKey)
 	r.Methods(http.MethodPost).Path("/{id}/sign").HandlerFunc(h.sign)
+	r.Methods(http.MethodPost).Path("/{id}/encrypt").HandlerFunc(h.encrypt)
+	r.Methods(http.MethodPost).Path("/{id}/decrypt").HandlerFunc(h.decrypt)
+	r.Methods(http.MethodPost).Path("/{id}/rotate").HandlerFunc(h.rotate)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.getOne)
 	r.Methods(http.MethodPatch).Path("/{id}").HandlerFunc(h.update)
 	r.Methods(http.MethodPut).Path("/{id}/restore").HandlerFunc(h.restore)
+	r.Methods(http.MethodPut).Path("/{id}/enable").HandlerFunc(h.enable)
+	r.Methods(http.MethodPut).Path("/{id}/disable").HandlerFunc(h.disable)
 	r.Methods(http.MethodPost).Path("/{id}").HandlerFunc(h.create)
 
 	r.Methods(http.MethodDelete).Path("/{id}").HandlerFunc(h.delete)
@@ -81,7 +88,8 @@ func (h *KeysHandler) create(rw http.ResponseWriter, request *http.Request) {
 			EllipticCurve: entities2.Curve(createKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: createKeyRequest.Tags,
+			Tags:     createKeyRequest.Tags,
+			ExpireAt: createKeyRequest.ExpireAt,
 		})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -135,7 +143,8 @@ func (h *KeysHandler) importKey(rw http.ResponseWriter, request *http.Request) {
 			EllipticCurve: entities2.Curve(importKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: importKeyRequest.Tags,
+			Tags:     importKeyRequest.Tags,
+			ExpireAt: importKeyRequest.ExpireAt,
 		})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -174,7 +183,14 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	storeName := StoreNameFromContext(ctx)
+	if h.eth2Store != "" && storeName == h.eth2Store {
+		// Validator keys must not sign arbitrary data, which would bypass the slashing protection of the Web3Signer API
+		infrahttp.WriteHTTPErrorResponse(rw, errors.ForbiddenError("keys of the eth2 validator store can only sign through the Web3Signer API"))
+		return
+	}
+
+	keyStore, err := h.stores.Key(ctx, storeName, auth.UserInfoFromContext(ctx))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -193,6 +209,96 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+// @Summary      Encrypt random payload
+// @Description  Encrypt a random payload using the selected key
+// @Tags         Keys
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                             true  "Store identifier"
+// @Param        id         path      string                             true  "Key identifier"
+// @Param        request    body      types.EncryptBase64PayloadRequest  true  "Encryption request"
+// @Success      200        {string}  string                             "ciphertext in base64"
+// @Failure      400        {object}  infrahttp.ErrorResponse            "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse            "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse            "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse            "Store/Key not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse            "Not supported by the key or store"
+// @Failure      500        {object}  infrahttp.ErrorResponse            "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/encrypt [post]
+func (h *KeysHandler) encrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	encryptRequest := &types.EncryptBase64PayloadRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, encryptRequest)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	ciphertext, err := keyStore.Encrypt(ctx, getID(request), encryptRequest.Data, nil)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(base64.StdEncoding.EncodeToString(ciphertext)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Decrypt payload
+// @Description  Decrypt a payload previously encrypted using the selected key
+// @Tags         Keys
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                             true  "Store identifier"
+// @Param        id         path      string                             true  "Key identifier"
+// @Param        request    body      types.DecryptBase64PayloadRequest  true  "Decryption request"
+// @Success      200        {string}  string                             "plaintext in base64"
+// @Failure      400        {object}  infrahttp.ErrorResponse            "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse            "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse            "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse            "Store/Key not found"
+// @Failure      501        {object}  infrahttp.ErrorResponse            "Not supported by the key or store"
+// @Failure      500        {object}  infrahttp.ErrorResponse            "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/decrypt [post]
+func (h *KeysHandler) decrypt(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	decryptRequest := &types.DecryptBase64PayloadRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, decryptRequest)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	plaintext, err := keyStore.Decrypt(ctx, getID(request), decryptRequest.Data, nil)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(base64.StdEncoding.EncodeToString(plaintext)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Get key by ID
 // @Description  Retrieve a key by its ID
 // @Tags         Keys
@@ -200,6 +306,7 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 // @Produce      json
 // @Param        storeName  path      string                   true   "Store identifier"
 // @Param        id         path      string                   true   "Key identifier"
+// @Param        version    query     string                   false  "key version"
 // @Param        deleted    query     bool                     false  "filter by only deleted keys"
 // @Success      200        {object}  types.KeyResponse        "Key data"
 // @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
@@ -219,7 +326,12 @@ func (h *KeysHandler) getOne(rw http.ResponseWriter, request *http.Request) {
 	getDeleted := request.URL.Query().Get("deleted")
 	var key *entities.Key
 	if getDeleted == "" {
-		key, err = keyStore.Get(ctx, getID(request))
+		version := request.URL.Query().Get("version")
+		if version == "" {
+			key, err = keyStore.Get(ctx, getID(request))
+		} else {
+			key, err = keyStore.GetVersion(ctx, getID(request), version)
+		}
 	} else {
 		key, err = keyStore.GetDeleted(ctx, getID(request))
 	}
@@ -266,7 +378,9 @@ func (h *KeysHandler) update(rw http.ResponseWriter, request *http.Request) {
 	}
 
 	key, err := keyStore.Update(ctx, getID(request), &entities.Attributes{
-		Tags: updateRequest.Tags,
+		Tags:          updateRequest.Tags,
+		ExpireAt:      updateRequest.ExpireAt,
+		ClearExpireAt: updateRequest.ClearExpireAt,
 	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -280,6 +394,43 @@ func (h *KeysHandler) update(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+// @Summary      Rotate a key
+// @Description  Create a new version of a key under the same ID and make it the active signing version
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Success      200        {object}  types.KeyResponse        "Rotated key data"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse  "Key used by an Ethereum account"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Key rotation not supported by the store"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/rotate [post]
+func (h *KeysHandler) rotate(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	key, err := keyStore.Rotate(ctx, getID(request), nil, nil)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatKeyResponse(key))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Restore a soft-deleted key
 // @Description  Restore a soft-deleted key by its ID
 // @Tags         Keys
@@ -311,6 +462,68 @@ func (h *KeysHandler) restore(rw http.ResponseWriter, request *http.Request) {
 	rw.WriteHeader(http.StatusNoContent)
 }
 
+// @Summary      Enable a key
+// @Description  Enable a key by its ID. Disabled keys cannot be used to sign, encrypt or decrypt
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path  string  true  "Store identifier"
+// @Param        id         path  string  true  "Key identifier"
+// @Success      204        "Enabled successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/enable [put]
+func (h *KeysHandler) enable(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = keyStore.Enable(ctx, getID(request))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Disable a key
+// @Description  Disable a key by its ID. Disabled keys cannot be used to sign, encrypt or decrypt
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path  string  true  "Store identifier"
+// @Param        id         path  string  true  "Key identifier"
+// @Success      204        "Disabled successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/disable [put]
+func (h *KeysHandler) disable(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = keyStore.Disable(ctx, getID(request))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
 // @Summary      List Key ids
 // @Description  List key's IDs allocated on targeted Store
 // @Tags         Keys
@@ -66,7 +66,7 @@ func (s *keysHandlerTestSuite) SetupTest() {
 
 	s.router = mux.NewRouter()
 	s.ctx = authapi.WithUserInfo(context.Background(), keyUserInfo)
-	NewStoresHandler(s.stores).Register(s.router)
+	NewStoresHandler(s.stores, "").Register(s.router)
 }
 
 func (s *keysHandlerTestSuite) TearDownTest() {
@@ -259,6 +259,84 @@ func (s *keysHandlerTestSuite) TestSign() {
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
 	})
+
+	s.Run("should fail with 403 to sign with a key of the eth2 validator store", func() {
+		signPayloadRequest := testutils.FakeSignBase64PayloadRequest()
+		requestBytes, _ := json.Marshal(signPayloadRequest)
+
+		router := mux.NewRouter()
+		NewStoresHandler(s.stores, keyStoreName).Register(router)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/sign", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusForbidden, rw.Code)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestEncrypt() {
+	s.Run("should execute request successfully", func() {
+		encryptRequest := testutils.FakeEncryptBase64PayloadRequest()
+		requestBytes, _ := json.Marshal(encryptRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/encrypt", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		ciphertext := []byte("ciphertext")
+		s.keyStore.EXPECT().Encrypt(gomock.Any(), keyID, encryptRequest.Data, nil).Return(ciphertext, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), base64.StdEncoding.EncodeToString(ciphertext), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
+	s.Run("should fail with correct error code if use case fails", func() {
+		encryptRequest := testutils.FakeEncryptBase64PayloadRequest()
+		requestBytes, _ := json.Marshal(encryptRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/encrypt", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Encrypt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.NotSupportedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotImplemented, rw.Code)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestDecrypt() {
+	s.Run("should execute request successfully", func() {
+		decryptRequest := testutils.FakeDecryptBase64PayloadRequest()
+		requestBytes, _ := json.Marshal(decryptRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/decrypt", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		plaintext := []byte("plaintext")
+		s.keyStore.EXPECT().Decrypt(gomock.Any(), keyID, decryptRequest.Data, nil).Return(plaintext, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), base64.StdEncoding.EncodeToString(plaintext), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
+	s.Run("should fail with correct error code if use case fails", func() {
+		decryptRequest := testutils.FakeDecryptBase64PayloadRequest()
+		requestBytes, _ := json.Marshal(decryptRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/decrypt", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Decrypt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
 }
 
 func (s *keysHandlerTestSuite) TestGet() {
@@ -277,6 +355,21 @@ func (s *keysHandlerTestSuite) TestGet() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should execute request with version successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/stores/KeyStore/keys/%s?version=1", keyID), nil).WithContext(s.ctx)
+
+		key := testutils2.FakeKey()
+		s.keyStore.EXPECT().GetVersion(gomock.Any(), keyID, "1").Return(key, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatKeyResponse(key)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		rw := httptest.NewRecorder()
@@ -289,6 +382,69 @@ func (s *keysHandlerTestSuite) TestGet() {
 	})
 }
 
+func (s *keysHandlerTestSuite) TestRotate() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/rotate", keyID), nil).WithContext(s.ctx)
+
+		key := testutils2.FakeKey()
+		key.Metadata.Version = "2"
+		s.keyStore.EXPECT().Rotate(gomock.Any(), keyID, nil, nil).Return(key, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatKeyResponse(key)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 501 if rotation is not supported", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/rotate", keyID), nil).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Rotate(gomock.Any(), keyID, nil, nil).Return(nil, errors.NotSupportedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotImplemented, rw.Code)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestEnableDisable() {
+	s.Run("should enable key successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/KeyStore/keys/%s/enable", keyID), nil).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Enable(gomock.Any(), keyID).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should disable key successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/KeyStore/keys/%s/disable", keyID), nil).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Disable(gomock.Any(), keyID).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should fail with 409 when signing with a disabled key", func() {
+		signPayloadRequest := testutils.FakeSignBase64PayloadRequest()
+		requestBytes, _ := json.Marshal(signPayloadRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/sign", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Sign(gomock.Any(), keyID, gomock.Any(), gomock.Any()).Return(nil, errors.StatusConflictError("key is disabled"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusConflict, rw.Code)
+	})
+}
+
 func (s *keysHandlerTestSuite) TestList() {
 	s.Run("should execute request successfully", func() {
 		rw := httptest.NewRecorder()
@@ -29,6 +29,8 @@ func NewSecretsHandler(storesConnector stores.Stores) *SecretsHandler {
 func (h *SecretsHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodDelete).Path("/{id}/destroy").HandlerFunc(h.destroy)
 	r.Methods(http.MethodPut).Path("/{id}/restore").HandlerFunc(h.restore)
+	r.Methods(http.MethodPut).Path("/{id}/enable").HandlerFunc(h.enable)
+	r.Methods(http.MethodPut).Path("/{id}/disable").HandlerFunc(h.disable)
 	r.Methods(http.MethodPost).Path("/{id}").HandlerFunc(h.set)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.getOne)
@@ -68,7 +70,8 @@ func (h *SecretsHandler) set(rw http.ResponseWriter, request *http.Request) {
 	}
 
 	secret, err := secretStore.Set(ctx, id, setSecretRequest.Value, &entities.Attributes{
-		Tags: setSecretRequest.Tags,
+		Tags:     setSecretRequest.Tags,
+		ExpireAt: setSecretRequest.ExpireAt,
 	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -275,3 +278,69 @@ func (h *SecretsHandler) restore(rw http.ResponseWriter, request *http.Request)
 
 	rw.WriteHeader(http.StatusNoContent)
 }
+
+// @Summary      Enable a secret
+// @Description  Enable all versions of a secret by ID. The value of a disabled secret cannot be read
+// @Tags         Secrets
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path  string  true  "Store ID"
+// @Param        id         path  string  true  "Secret ID"
+// @Success      204        "Enabled successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Secret not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/secrets/{id}/enable [put]
+func (h *SecretsHandler) enable(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	id := mux.Vars(request)["id"]
+
+	secretStore, err := h.stores.Secret(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = secretStore.Enable(ctx, id)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Disable a secret
+// @Description  Disable all versions of a secret by ID. The value of a disabled secret cannot be read
+// @Tags         Secrets
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path  string  true  "Store ID"
+// @Param        id         path  string  true  "Secret ID"
+// @Success      204        "Disabled successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Secret not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/secrets/{id}/disable [put]
+func (h *SecretsHandler) disable(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	id := mux.Vars(request)["id"]
+
+	secretStore, err := h.stores.Secret(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = secretStore.Disable(ctx, id)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -63,7 +63,7 @@ func (s *secretsHandlerTestSuite) SetupTest() {
 	s.ctx = authapi.WithUserInfo(context.Background(), secretUserInfo)
 
 	s.router = mux.NewRouter()
-	NewStoresHandler(s.stores).Register(s.router)
+	NewStoresHandler(s.stores, "").Register(s.router)
 }
 
 func (s *secretsHandlerTestSuite) TearDownTest() {
@@ -246,6 +246,38 @@ func (s *secretsHandlerTestSuite) TestRestore() {
 	})
 }
 
+func (s *secretsHandlerTestSuite) TestEnableDisable() {
+	s.Run("should enable secret successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/SecretStore/secrets/%s/enable", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().Enable(gomock.Any(), secretID).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should disable secret successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/SecretStore/secrets/%s/disable", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().Disable(gomock.Any(), secretID).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should fail with 409 when getting a disabled secret", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/stores/SecretStore/secrets/%s", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().Get(gomock.Any(), secretID, "").Return(nil, errors.StatusConflictError("secret is disabled"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusConflict, rw.Code)
+	})
+}
+
 func (s *secretsHandlerTestSuite) TestDestroy() {
 	s.Run("should execute request successfully with version", func() {
 		version := "1"
@@ -11,17 +11,19 @@ import (
 )
 
 type StoresHandler struct {
-	secrets *SecretsHandler
-	keys    *KeysHandler
-	eth     *EthHandler
+	secrets    *SecretsHandler
+	keys       *KeysHandler
+	eth        *EthHandler
+	validators *ValidatorsHandler
 }
 
-// NewStoresHandler creates a http.Handler to be served on /stores
-func NewStoresHandler(s stores.Stores) *StoresHandler {
+// NewStoresHandler creates a http.Handler to be served on /stores, the keys of the eth2 store can only sign through the Web3Signer API
+func NewStoresHandler(s stores.Stores, eth2Store string) *StoresHandler {
 	return &StoresHandler{
-		secrets: NewSecretsHandler(s),
-		keys:    NewKeysHandler(s),
-		eth:     NewEthHandler(s),
+		secrets:    NewSecretsHandler(s),
+		keys:       NewKeysHandler(s, eth2Store),
+		eth:        NewEthHandler(s),
+		validators: NewValidatorsHandler(s),
 	}
 }
 
@@ -44,6 +46,10 @@ func (h *StoresHandler) Register(router *mux.Router) {
 	// Register ethereum handler on /stores/{storeName}/ethereum
 	ethSubrouter := storeSubrouter.PathPrefix("/ethereum").Subrouter()
 	h.eth.Register(ethSubrouter)
+
+	// Register validators handler on /stores/{storeName}/validators
+	validatorsSubrouter := storeSubrouter.PathPrefix("/validators").Subrouter()
+	h.validators.Register(validatorsSubrouter)
 }
 
 func storeSelector(h http.Handler) http.Handler {
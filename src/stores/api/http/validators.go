@@ -0,0 +1,204 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	auth "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+)
+
+type ValidatorsHandler struct {
+	stores stores.Stores
+}
+
+func NewValidatorsHandler(storesConnector stores.Stores) *ValidatorsHandler {
+	return &ValidatorsHandler{
+		stores: storesConnector,
+	}
+}
+
+func (h *ValidatorsHandler) Register(r *mux.Router) {
+	r.Methods(http.MethodPost).Path("/slashing-protection/import").HandlerFunc(h.importSlashingProtection)
+	r.Methods(http.MethodGet).Path("/slashing-protection/export").HandlerFunc(h.exportSlashingProtection)
+	r.Methods(http.MethodPost).Path("/{id}/sign-block").HandlerFunc(h.signBlock)
+	r.Methods(http.MethodPost).Path("/{id}/sign-attestation").HandlerFunc(h.signAttestation)
+}
+
+// @Summary      Sign a block proposal
+// @Description  Sign the signing root of a block proposal using a BLS12-381 key, after checking the slashing protection data of the validator
+// @Tags         Validators
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                   true  "Key store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Param        request    body      types.SignBlockRequest   true  "Sign block request"
+// @Success      200        {string}  string                   "BLS signature"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse  "Slashable block proposal"
+// @Failure      422        {object}  infrahttp.ErrorResponse  "Not a BLS12-381 key"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/validators/{id}/sign-block [post]
+func (h *ValidatorsHandler) signBlock(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	signBlockReq := &types.SignBlockRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, signBlockReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	validatorStore, err := h.stores.Validator(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	signature, err := validatorStore.SignBlock(ctx, getID(request), &entities.SignedBlock{
+		Slot:        signBlockReq.Slot,
+		SigningRoot: signBlockReq.SigningRoot,
+	})
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(hexutil.Encode(signature)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Sign an attestation
+// @Description  Sign the signing root of an attestation using a BLS12-381 key, after checking the slashing protection data of the validator
+// @Tags         Validators
+// @Accept       json
+// @Produce      plain
+// @Param        storeName  path      string                        true  "Key store identifier"
+// @Param        id         path      string                        true  "Key identifier"
+// @Param        request    body      types.SignAttestationRequest  true  "Sign attestation request"
+// @Success      200        {string}  string                        "BLS signature"
+// @Failure      400        {object}  infrahttp.ErrorResponse       "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse       "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse       "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse       "Store/Key not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse       "Slashable attestation"
+// @Failure      422        {object}  infrahttp.ErrorResponse       "Invalid epochs or not a BLS12-381 key"
+// @Failure      500        {object}  infrahttp.ErrorResponse       "Internal server error"
+// @Router       /stores/{storeName}/validators/{id}/sign-attestation [post]
+func (h *ValidatorsHandler) signAttestation(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	signAttestationReq := &types.SignAttestationRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, signAttestationReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	validatorStore, err := h.stores.Validator(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	signature, err := validatorStore.SignAttestation(ctx, getID(request), &entities.SignedAttestation{
+		SourceEpoch: signAttestationReq.SourceEpoch,
+		TargetEpoch: signAttestationReq.TargetEpoch,
+		SigningRoot: signAttestationReq.SigningRoot,
+	})
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, err = rw.Write([]byte(hexutil.Encode(signature)))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Import slashing protection data
+// @Description  Import the slashing protection data of a set of validators, in the EIP-3076 interchange format
+// @Tags         Validators
+// @Accept       json
+// @Param        storeName  path  string                               true  "Key store identifier"
+// @Param        request    body  types.SlashingProtectionInterchange  true  "EIP-3076 interchange data"
+// @Success      204        "Slashing protection data imported successfully"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      422        {object}  infrahttp.ErrorResponse  "Genesis validators root mismatch"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/validators/slashing-protection/import [post]
+func (h *ValidatorsHandler) importSlashingProtection(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	interchangeReq := &types.SlashingProtectionInterchange{}
+	err := jsonutils.UnmarshalBody(request.Body, interchangeReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	validatorStore, err := h.stores.Validator(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = validatorStore.ImportSlashingProtection(ctx, formatters.FormatSlashingProtectionInterchangeRequest(interchangeReq))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Export slashing protection data
+// @Description  Export the slashing protection data of all the validators of the store, in the EIP-3076 interchange format
+// @Tags         Validators
+// @Produce      json
+// @Param        storeName  path      string                               true  "Key store identifier"
+// @Success      200        {object}  types.SlashingProtectionInterchange  "EIP-3076 interchange data"
+// @Failure      401        {object}  infrahttp.ErrorResponse              "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse              "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse              "Store not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse              "Internal server error"
+// @Router       /stores/{storeName}/validators/slashing-protection/export [get]
+func (h *ValidatorsHandler) exportSlashingProtection(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	validatorStore, err := h.stores.Validator(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	interchange, err := validatorStore.ExportSlashingProtection(ctx)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatSlashingProtectionInterchangeResponse(interchange))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
@@ -0,0 +1,213 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authapi "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type validatorsHandlerTestSuite struct {
+	suite.Suite
+
+	ctrl           *gomock.Controller
+	stores         *mock.MockStores
+	validatorStore *mock.MockValidatorStore
+	router         *mux.Router
+	ctx            context.Context
+}
+
+func TestValidatorsHandler(t *testing.T) {
+	s := new(validatorsHandlerTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *validatorsHandlerTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+
+	s.stores = mock.NewMockStores(s.ctrl)
+	s.validatorStore = mock.NewMockValidatorStore(s.ctrl)
+
+	s.stores.EXPECT().Validator(gomock.Any(), keyStoreName, keyUserInfo).Return(s.validatorStore, nil).AnyTimes()
+
+	s.router = mux.NewRouter()
+	s.ctx = authapi.WithUserInfo(context.Background(), keyUserInfo)
+	NewStoresHandler(s.stores, "").Register(s.router)
+}
+
+func (s *validatorsHandlerTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *validatorsHandlerTestSuite) TestSignBlock() {
+	s.Run("should execute request successfully", func() {
+		signBlockRequest := testutils.FakeSignBlockRequest()
+		requestBytes, _ := json.Marshal(signBlockRequest)
+		signature := []byte("signature")
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/"+keyID+"/sign-block", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().SignBlock(gomock.Any(), keyID, &entities.SignedBlock{
+			Slot:        signBlockRequest.Slot,
+			SigningRoot: signBlockRequest.SigningRoot,
+		}).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(signature), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if signing root is invalid", func() {
+		signBlockRequest := testutils.FakeSignBlockRequest()
+		signBlockRequest.SigningRoot = hexutil.MustDecode("0xfeee")
+		requestBytes, _ := json.Marshal(signBlockRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/"+keyID+"/sign-block", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 409 if block is slashable", func() {
+		requestBytes, _ := json.Marshal(testutils.FakeSignBlockRequest())
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/"+keyID+"/sign-block", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().SignBlock(gomock.Any(), keyID, gomock.Any()).Return(nil, errors.StatusConflictError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusConflict, rw.Code)
+	})
+}
+
+func (s *validatorsHandlerTestSuite) TestSignAttestation() {
+	s.Run("should execute request successfully", func() {
+		signAttestationRequest := testutils.FakeSignAttestationRequest()
+		requestBytes, _ := json.Marshal(signAttestationRequest)
+		signature := []byte("signature")
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/"+keyID+"/sign-attestation", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().SignAttestation(gomock.Any(), keyID, &entities.SignedAttestation{
+			SourceEpoch: signAttestationRequest.SourceEpoch,
+			TargetEpoch: signAttestationRequest.TargetEpoch,
+			SigningRoot: signAttestationRequest.SigningRoot,
+		}).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(signature), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if signing root is missing", func() {
+		signAttestationRequest := testutils.FakeSignAttestationRequest()
+		signAttestationRequest.SigningRoot = nil
+		requestBytes, _ := json.Marshal(signAttestationRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/"+keyID+"/sign-attestation", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
+	s.Run("should fail with correct error code if use case fails", func() {
+		requestBytes, _ := json.Marshal(testutils.FakeSignAttestationRequest())
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/"+keyID+"/sign-attestation", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().SignAttestation(gomock.Any(), keyID, gomock.Any()).Return(nil, errors.PostgresError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusFailedDependency, rw.Code)
+	})
+}
+
+func (s *validatorsHandlerTestSuite) TestImportSlashingProtection() {
+	s.Run("should execute request successfully", func() {
+		interchange := testutils.FakeSlashingProtectionInterchange()
+		requestBytes, _ := json.Marshal(interchange)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/slashing-protection/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().ImportSlashingProtection(gomock.Any(), formatters.FormatSlashingProtectionInterchangeRequest(interchange)).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should fail with 400 if interchange format version is not supported", func() {
+		interchange := testutils.FakeSlashingProtectionInterchange()
+		interchange.Metadata.InterchangeFormatVersion = "4"
+		requestBytes, _ := json.Marshal(interchange)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/slashing-protection/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 422 if genesis validators root does not match", func() {
+		requestBytes, _ := json.Marshal(testutils.FakeSlashingProtectionInterchange())
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/KeyStore/validators/slashing-protection/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().ImportSlashingProtection(gomock.Any(), gomock.Any()).Return(errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusUnprocessableEntity, rw.Code)
+	})
+}
+
+func (s *validatorsHandlerTestSuite) TestExportSlashingProtection() {
+	s.Run("should execute request successfully", func() {
+		interchange := formatters.FormatSlashingProtectionInterchangeRequest(testutils.FakeSlashingProtectionInterchange())
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/validators/slashing-protection/export", nil).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().ExportSlashingProtection(gomock.Any()).Return(interchange, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(testutils.FakeSlashingProtectionInterchange())
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with correct error code if use case fails", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/validators/slashing-protection/export", nil).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().ExportSlashingProtection(gomock.Any()).Return(nil, errors.PostgresError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusFailedDependency, rw.Code)
+	})
+}
@@ -0,0 +1,339 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/eth2"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	auth "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gorilla/mux"
+)
+
+// Web3SignerHandler exposes the Web3Signer REST API so that clients supporting Web3Signer (Besu, Teku...)
+// can use the key manager as external signer. Eth1 requests are served by an Ethereum store and eth2 requests by a key store
+type Web3SignerHandler struct {
+	stores             stores.Stores
+	eth1Store          string
+	eth2Store          string
+	genesisForkVersion eth2.Version
+}
+
+func NewWeb3SignerHandler(storesConnector stores.Stores, eth1Store, eth2Store string, genesisForkVersion eth2.Version) *Web3SignerHandler {
+	return &Web3SignerHandler{
+		stores:             storesConnector,
+		eth1Store:          eth1Store,
+		eth2Store:          eth2Store,
+		genesisForkVersion: genesisForkVersion,
+	}
+}
+
+func (h *Web3SignerHandler) Register(r *mux.Router) {
+	r.Methods(http.MethodGet).Path("/upcheck").HandlerFunc(h.upcheck)
+
+	if h.eth1Store != "" {
+		r.Methods(http.MethodGet).Path("/api/v1/eth1/publicKeys").HandlerFunc(h.eth1PublicKeys)
+		r.Methods(http.MethodPost).Path("/api/v1/eth1/sign/{identifier}").HandlerFunc(h.eth1Sign)
+	}
+
+	if h.eth2Store != "" {
+		r.Methods(http.MethodGet).Path("/api/v1/eth2/publicKeys").HandlerFunc(h.eth2PublicKeys)
+		r.Methods(http.MethodPost).Path("/api/v1/eth2/sign/{identifier}").HandlerFunc(h.eth2Sign)
+	}
+}
+
+// @Summary      Web3Signer upcheck
+// @Description  Web3Signer compatible liveness check
+// @Tags         Web3Signer
+// @Produce      plain
+// @Success      200  {string}  string  "OK"
+// @Router       /upcheck [get]
+func (h *Web3SignerHandler) upcheck(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain")
+	_, _ = rw.Write([]byte("OK"))
+}
+
+// @Summary      List eth1 public keys
+// @Description  List the public keys of the Ethereum accounts of the Web3Signer eth1 store
+// @Tags         Web3Signer
+// @Produce      json
+// @Success      200  {array}   string                   "List of public keys"
+// @Failure      401  {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api/v1/eth1/publicKeys [get]
+func (h *Web3SignerHandler) eth1PublicKeys(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	ethStore, err := h.stores.Ethereum(ctx, h.eth1Store, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	addresses, err := ethStore.List(ctx, 0, 0)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	publicKeys := []string{}
+	for _, address := range addresses {
+		account, err := ethStore.Get(ctx, address)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+
+		// Web3Signer identifies secp256k1 keys by their 64 bytes public key, without the 0x04 prefix
+		publicKeys = append(publicKeys, hexutil.Encode(account.PublicKey[1:]))
+	}
+
+	err = infrahttp.WriteJSON(rw, publicKeys)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Sign with an eth1 key
+// @Description  Sign the Keccak-256 hash of the data with the Ethereum account identified by its public key or address
+// @Tags         Web3Signer
+// @Accept       json
+// @Produce      plain
+// @Param        identifier  path      string                           true  "Public key or address of the account"
+// @Param        request     body      types.Web3SignerEth1SignRequest  true  "Data to sign"
+// @Success      200         {string}  string                           "Signature"
+// @Failure      400         {object}  infrahttp.ErrorResponse          "Invalid request format"
+// @Failure      401         {object}  infrahttp.ErrorResponse          "Unauthorized"
+// @Failure      403         {object}  infrahttp.ErrorResponse          "Forbidden"
+// @Failure      404         {object}  infrahttp.ErrorResponse          "Store/Account not found"
+// @Failure      500         {object}  infrahttp.ErrorResponse          "Internal server error"
+// @Router       /api/v1/eth1/sign/{identifier} [post]
+func (h *Web3SignerHandler) eth1Sign(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	signReq := &types.Web3SignerEth1SignRequest{}
+	err := jsonutils.UnmarshalBodyLenient(request.Body, signReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	address, err := eth1Address(mux.Vars(request)["identifier"])
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	ethStore, err := h.stores.Ethereum(ctx, h.eth1Store, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	signature, err := ethStore.Sign(ctx, address, signReq.Data)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+	// Web3Signer returns signatures with a recovery ID of 27 or 28
+	signature[crypto.RecoveryIDOffset] += 27
+
+	writeWeb3SignerSignature(rw, request, signature)
+}
+
+// @Summary      List eth2 public keys
+// @Description  List the public keys of the BLS12-381 validator keys of the Web3Signer eth2 store
+// @Tags         Web3Signer
+// @Produce      json
+// @Success      200  {array}   string                   "List of public keys"
+// @Failure      401  {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api/v1/eth2/publicKeys [get]
+func (h *Web3SignerHandler) eth2PublicKeys(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	validatorStore, err := h.stores.Validator(ctx, h.eth2Store, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	keys, err := validatorStore.List(ctx)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	publicKeys := []string{}
+	for _, key := range keys {
+		publicKeys = append(publicKeys, hexutil.Encode(key.PublicKey))
+	}
+
+	err = infrahttp.WriteJSON(rw, publicKeys)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Sign with an eth2 key
+// @Description  Sign the typed payload with the BLS12-381 validator key identified by its public key. The signing root is computed from the payload and must match the one provided, if any. Blocks must be given by their header. Block proposals and attestations are checked against the slashing protection data of the validator
+// @Tags         Web3Signer
+// @Accept       json
+// @Produce      plain,json
+// @Param        identifier  path      string                           true  "Public key of the validator"
+// @Param        request     body      types.Web3SignerEth2SignRequest  true  "Signing request"
+// @Success      200         {string}  string                           "Signature"
+// @Failure      400         {object}  infrahttp.ErrorResponse          "Invalid request format"
+// @Failure      401         {object}  infrahttp.ErrorResponse          "Unauthorized"
+// @Failure      403         {object}  infrahttp.ErrorResponse          "Forbidden"
+// @Failure      404         {object}  infrahttp.ErrorResponse          "Store/Validator not found"
+// @Failure      412         {string}  string                           "Signing operation failed due to slashing protection rules"
+// @Failure      500         {object}  infrahttp.ErrorResponse          "Internal server error"
+// @Router       /api/v1/eth2/sign/{identifier} [post]
+func (h *Web3SignerHandler) eth2Sign(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	userInfo := auth.UserInfoFromContext(ctx)
+
+	signReq := &types.Web3SignerEth2SignRequest{}
+	err := jsonutils.UnmarshalBodyLenient(request.Body, signReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	pubKey, err := hexutil.Decode(mux.Vars(request)["identifier"])
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError("invalid validator public key"))
+		return
+	}
+
+	validatorStore, err := h.stores.Validator(ctx, h.eth2Store, userInfo)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	key, err := getValidatorKeyByPublicKey(ctx, validatorStore, pubKey)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	signature, err := h.signEth2(ctx, userInfo, validatorStore, key, signReq)
+	if err != nil {
+		writeWeb3SignerErrorResponse(rw, err)
+		return
+	}
+
+	writeWeb3SignerSignature(rw, request, signature)
+}
+
+// signEth2 signs the signing root computed from the typed payload of the request, applying slashing protection to block proposals and attestations
+func (h *Web3SignerHandler) signEth2(ctx context.Context, userInfo *authentities.UserInfo, validatorStore stores.ValidatorStore, key *entities.Key, signReq *types.Web3SignerEth2SignRequest) ([]byte, error) {
+	signingRoot, err := formatters.FormatWeb3SignerSigningRoot(signReq, h.genesisForkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(signReq.SigningRoot) > 0 && !bytes.Equal(signReq.SigningRoot, signingRoot) {
+		return nil, errors.InvalidFormatError("signing root does not match the signing request")
+	}
+
+	switch signReq.Type {
+	case types.Web3SignerBlock, types.Web3SignerBlockV2:
+		header, err := formatters.Web3SignerBlockHeader(signReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return validatorStore.SignBlock(ctx, key.ID, &entities.SignedBlock{Slot: header.Slot, SigningRoot: signingRoot})
+	case types.Web3SignerAttestation:
+		return validatorStore.SignAttestation(ctx, key.ID, &entities.SignedAttestation{
+			SourceEpoch: signReq.Attestation.Source.Epoch,
+			TargetEpoch: signReq.Attestation.Target.Epoch,
+			SigningRoot: signingRoot,
+		})
+	default:
+		keyStore, err := h.stores.Key(ctx, h.eth2Store, userInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		return keyStore.Sign(ctx, key.ID, signingRoot, key.Algo)
+	}
+}
+
+func getValidatorKeyByPublicKey(ctx context.Context, validatorStore stores.ValidatorStore, pubKey []byte) (*entities.Key, error) {
+	keys, err := validatorStore.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if bytes.Equal(key.PublicKey, pubKey) {
+			return key, nil
+		}
+	}
+
+	return nil, errors.NotFoundError("validator not found")
+}
+
+// eth1Address accepts a 64 bytes public key as returned by the Web3Signer API, a 65 bytes uncompressed public key or an address
+func eth1Address(identifier string) (ethcommon.Address, error) {
+	identifierBytes, err := hexutil.Decode(identifier)
+	if err != nil {
+		return ethcommon.Address{}, errors.InvalidFormatError("invalid identifier")
+	}
+
+	switch len(identifierBytes) {
+	case ethcommon.AddressLength:
+		return ethcommon.BytesToAddress(identifierBytes), nil
+	case 64:
+		identifierBytes = append([]byte{4}, identifierBytes...)
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(identifierBytes)
+	if err != nil {
+		return ethcommon.Address{}, errors.InvalidFormatError("invalid identifier")
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// writeWeb3SignerErrorResponse maps slashing protection violations to 412 as Web3Signer does
+func writeWeb3SignerErrorResponse(rw http.ResponseWriter, err error) {
+	if errors.IsStatusConflictError(err) {
+		http.Error(rw, "Signing operation failed due to slashing protection rules", http.StatusPreconditionFailed)
+		return
+	}
+
+	infrahttp.WriteHTTPErrorResponse(rw, err)
+}
+
+func writeWeb3SignerSignature(rw http.ResponseWriter, request *http.Request, signature []byte) {
+	if strings.Contains(request.Header.Get("Accept"), "application/json") {
+		_ = infrahttp.WriteJSON(rw, &types.Web3SignerSignResponse{Signature: hexutil.Encode(signature)})
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/plain")
+	_, _ = rw.Write([]byte(hexutil.Encode(signature)))
+}
@@ -0,0 +1,336 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/eth2"
+	authapi "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	web3SignerEth1Store = "Eth1Store"
+	web3SignerEth2Store = "Eth2Store"
+)
+
+type web3SignerHandlerTestSuite struct {
+	suite.Suite
+
+	ctrl           *gomock.Controller
+	stores         *mock.MockStores
+	ethStore       *mock.MockEthStore
+	keyStore       *mock.MockKeyStore
+	validatorStore *mock.MockValidatorStore
+	router         *mux.Router
+	ctx            context.Context
+	validatorKey   *entities.Key
+}
+
+func TestWeb3SignerHandler(t *testing.T) {
+	s := new(web3SignerHandlerTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *web3SignerHandlerTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+
+	s.stores = mock.NewMockStores(s.ctrl)
+	s.ethStore = mock.NewMockEthStore(s.ctrl)
+	s.keyStore = mock.NewMockKeyStore(s.ctrl)
+	s.validatorStore = mock.NewMockValidatorStore(s.ctrl)
+
+	s.stores.EXPECT().Ethereum(gomock.Any(), web3SignerEth1Store, keyUserInfo).Return(s.ethStore, nil).AnyTimes()
+	s.stores.EXPECT().Key(gomock.Any(), web3SignerEth2Store, keyUserInfo).Return(s.keyStore, nil).AnyTimes()
+	s.stores.EXPECT().Validator(gomock.Any(), web3SignerEth2Store, keyUserInfo).Return(s.validatorStore, nil).AnyTimes()
+
+	s.validatorKey = testutils2.FakeKey()
+	s.validatorKey.PublicKey = hexutil.MustDecode("0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed")
+	s.validatorKey.Algo = &entities2.Algorithm{Type: entities2.Bls, EllipticCurve: entities2.Bls12381}
+
+	s.router = mux.NewRouter()
+	s.ctx = authapi.WithUserInfo(context.Background(), keyUserInfo)
+	NewWeb3SignerHandler(s.stores, web3SignerEth1Store, web3SignerEth2Store, eth2.Version{}).Register(s.router)
+}
+
+func (s *web3SignerHandlerTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *web3SignerHandlerTestSuite) TestUpcheck() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/upcheck", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), "OK", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *web3SignerHandlerTestSuite) TestEth1PublicKeys() {
+	s.Run("should execute request successfully", func() {
+		account := testutils2.FakeETHAccount()
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/api/v1/eth1/publicKeys", nil).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().List(gomock.Any(), uint64(0), uint64(0)).Return([]common.Address{account.Address}, nil)
+		s.ethStore.EXPECT().Get(gomock.Any(), account.Address).Return(account, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]string{hexutil.Encode(account.PublicKey[1:])})
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *web3SignerHandlerTestSuite) TestEth1Sign() {
+	account := testutils2.FakeETHAccount()
+	pubKey, _ := crypto.UnmarshalPubkey(account.PublicKey)
+	address := crypto.PubkeyToAddress(*pubKey)
+
+	s.Run("should execute request successfully using the public key as identifier", func() {
+		requestBytes, _ := json.Marshal(&types.Web3SignerEth1SignRequest{Data: hexutil.MustDecode("0xfeee")})
+		signature := make([]byte, 65)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth1/sign/"+hexutil.Encode(account.PublicKey[1:]), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Sign(gomock.Any(), address, hexutil.MustDecode("0xfeee")).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedSignature := make([]byte, 65)
+		expectedSignature[64] = 27
+		assert.Equal(s.T(), hexutil.Encode(expectedSignature), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should execute request successfully using the address as identifier", func() {
+		requestBytes, _ := json.Marshal(&types.Web3SignerEth1SignRequest{Data: hexutil.MustDecode("0xfeee")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth1/sign/"+address.Hex(), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Sign(gomock.Any(), address, hexutil.MustDecode("0xfeee")).Return(make([]byte, 65), nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if identifier is invalid", func() {
+		requestBytes, _ := json.Marshal(&types.Web3SignerEth1SignRequest{Data: hexutil.MustDecode("0xfeee")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth1/sign/0xfeee", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 404 if account is not found", func() {
+		requestBytes, _ := json.Marshal(&types.Web3SignerEth1SignRequest{Data: hexutil.MustDecode("0xfeee")})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth1/sign/"+address.Hex(), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Sign(gomock.Any(), address, gomock.Any()).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *web3SignerHandlerTestSuite) TestEth2PublicKeys() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/api/v1/eth2/publicKeys", nil).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]string{hexutil.Encode(s.validatorKey.PublicKey)})
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *web3SignerHandlerTestSuite) TestEth2Sign() {
+	root := "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"
+	forkInfo := `"fork_info":{"fork":{"previous_version":"0x00001020","current_version":"0x01001020","epoch":"36660"},"genesis_validators_root":"0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"}`
+	blockHeader := `{"slot":"81952","proposer_index":"4666","parent_root":"` + root + `","state_root":"` + root + `","body_root":"` + root + `"}`
+	attestation := `{"slot":"32","index":"0","beacon_block_root":"` + root + `","source":{"epoch":"1","root":"` + root + `"},"target":{"epoch":"2","root":"` + root + `"}}`
+	signature := []byte("signature")
+
+	signingRoot := func(requestBody string) []byte {
+		signReq := &types.Web3SignerEth2SignRequest{}
+		_ = json.Unmarshal([]byte(requestBody), signReq)
+		signingRoot, err := formatters.FormatWeb3SignerSigningRoot(signReq, eth2.Version{})
+		s.Require().NoError(err)
+		return signingRoot
+	}
+
+	s.Run("should sign block successfully", func() {
+		requestBody := `{"type":"BLOCK_V2",` + forkInfo + `,"beacon_block":{"version":"BELLATRIX","block_header":` + blockHeader + `}}`
+		expectedSigningRoot := signingRoot(requestBody)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+		s.validatorStore.EXPECT().SignBlock(gomock.Any(), s.validatorKey.ID, &entities.SignedBlock{Slot: 81952, SigningRoot: expectedSigningRoot}).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(signature), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should sign attestation with a matching signing root successfully and return JSON", func() {
+		requestBody := `{"type":"ATTESTATION",` + forkInfo + `,"attestation":` + attestation + `}`
+		expectedSigningRoot := signingRoot(requestBody)
+		requestBody = `{"type":"ATTESTATION","signingRoot":"` + hexutil.Encode(expectedSigningRoot) + `",` + forkInfo + `,"attestation":` + attestation + `}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+		httpRequest.Header.Set("Accept", "application/json")
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+		s.validatorStore.EXPECT().SignAttestation(gomock.Any(), s.validatorKey.ID, &entities.SignedAttestation{SourceEpoch: 1, TargetEpoch: 2, SigningRoot: expectedSigningRoot}).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(&types.Web3SignerSignResponse{Signature: hexutil.Encode(signature)})
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should sign randao reveal successfully", func() {
+		requestBody := `{"type":"RANDAO_REVEAL",` + forkInfo + `,"randao_reveal":{"epoch":"3"}}`
+		expectedSigningRoot := signingRoot(requestBody)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+		s.keyStore.EXPECT().Sign(gomock.Any(), s.validatorKey.ID, expectedSigningRoot, s.validatorKey.Algo).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), hexutil.Encode(signature), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if the signing root of a block is sent with another type", func() {
+		blockSigningRoot := signingRoot(`{"type":"BLOCK_V2",` + forkInfo + `,"beacon_block":{"version":"BELLATRIX","block_header":` + blockHeader + `}}`)
+		requestBody := `{"type":"AGGREGATION_SLOT","signingRoot":"` + hexutil.Encode(blockSigningRoot) + `",` + forkInfo + `,"aggregation_slot":{"slot":"81952"}}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 412 if block is slashable", func() {
+		requestBody := `{"type":"BLOCK",` + forkInfo + `,"block":` + blockHeader + `}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+		s.validatorStore.EXPECT().SignBlock(gomock.Any(), s.validatorKey.ID, gomock.Any()).Return(nil, errors.StatusConflictError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusPreconditionFailed, rw.Code)
+	})
+
+	s.Run("should fail with 400 if block is missing", func() {
+		requestBody := `{"type":"BLOCK_V2",` + forkInfo + `}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 400 if a full block is sent without its body root", func() {
+		requestBody := `{"type":"BLOCK_V2",` + forkInfo + `,"beacon_block":{"version":"ALTAIR","block":{"slot":"81952","proposer_index":"4666","parent_root":"` + root + `","state_root":"` + root + `","body":{}}}}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 400 if fork info is missing", func() {
+		requestBody := `{"type":"RANDAO_REVEAL","randao_reveal":{"epoch":"3"}}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{s.validatorKey}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 400 if type is not supported", func() {
+		requestBody := `{"type":"UNKNOWN","signingRoot":"` + root + `"}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 404 if validator is not found", func() {
+		requestBody := `{"type":"RANDAO_REVEAL",` + forkInfo + `,"randao_reveal":{"epoch":"3"}}`
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/api/v1/eth2/sign/"+hexutil.Encode(s.validatorKey.PublicKey), bytes.NewReader([]byte(requestBody))).WithContext(s.ctx)
+
+		s.validatorStore.EXPECT().List(gomock.Any()).Return([]*entities.Key{}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
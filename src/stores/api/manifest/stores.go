@@ -68,7 +68,15 @@ func (h *StoresHandler) CreateKey(ctx context.Context, name string, allowedTenan
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.stores.CreateKey(ctx, name, createReq.Vault, createReq.SecretStore, allowedTenants, h.userInfo)
+	var envelopeEncryption *entities.EnvelopeEncryption
+	if createReq.EnvelopeEncryption != nil {
+		envelopeEncryption = &entities.EnvelopeEncryption{
+			KeyStore: createReq.EnvelopeEncryption.KeyStore,
+			KeyID:    createReq.EnvelopeEncryption.KeyID,
+		}
+	}
+
+	err = h.stores.CreateKey(ctx, name, createReq.Vault, createReq.SecretStore, envelopeEncryption, allowedTenants, h.userInfo)
 	if err != nil {
 		return err
 	}
@@ -83,7 +91,16 @@ func (h *StoresHandler) CreateEthereum(ctx context.Context, name string, allowed
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.stores.CreateEthereum(ctx, name, createReq.KeyStore, allowedTenants, h.userInfo)
+	var hdWallet *entities.HDWallet
+	if createReq.HDWallet != nil {
+		hdWallet = &entities.HDWallet{
+			SecretStore:    createReq.HDWallet.SecretStore,
+			SeedID:         createReq.HDWallet.SeedID,
+			DerivationPath: createReq.HDWallet.DerivationPath,
+		}
+	}
+
+	err = h.stores.CreateEthereum(ctx, name, createReq.KeyStore, hdWallet, allowedTenants, h.userInfo)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
@@ -17,18 +18,36 @@ const (
 )
 
 type CreateEthAccountRequest struct {
-	KeyID string            `json:"keyId,omitempty" example:"my-key-account"`
-	Tags  map[string]string `json:"tags,omitempty"`
+	KeyID    string            `json:"keyId,omitempty" example:"my-key-account"`
+	Tags     map[string]string `json:"tags,omitempty"`
+	ExpireAt time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 }
 
 type ImportEthAccountRequest struct {
 	KeyID      string            `json:"keyId,omitempty" example:"my-imported-key-account"`
-	PrivateKey hexutil.Bytes     `json:"privateKey" validate:"required" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
+	PrivateKey hexutil.Bytes     `json:"privateKey,omitempty" validate:"required_without=Keystore,excluded_with=Keystore" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
+	Keystore   json.RawMessage   `json:"keystore,omitempty" validate:"required_without=PrivateKey" swaggertype:"object"`
+	Passphrase string            `json:"passphrase,omitempty" example:"my-passphrase"`
 	Tags       map[string]string `json:"tags,omitempty"`
+	ExpireAt   time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
+}
+
+type ExportEthAccountRequest struct {
+	Passphrase string `json:"passphrase" validate:"required" example:"my-passphrase"`
+}
+
+type ImportEthMnemonicRequest struct {
+	Mnemonic    string            `json:"mnemonic" validate:"required" example:"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"`
+	Passphrase  string            `json:"passphrase,omitempty" example:"my-passphrase"`
+	NumAccounts uint32            `json:"numAccounts" validate:"required,max=1000" example:"10"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	ExpireAt    time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 }
 
 type UpdateEthAccountRequest struct {
-	Tags map[string]string `json:"tags,omitempty"`
+	Tags          map[string]string `json:"tags,omitempty"`
+	ExpireAt      time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
+	ClearExpireAt bool              `json:"clearExpireAt,omitempty" validate:"excluded_with=ExpireAt" example:"false"`
 }
 
 type SignMessageRequest struct {
@@ -39,6 +58,14 @@ type SignTypedDataHashRequest struct {
 	Message hexutil.Bytes `json:"hash" validate:"required" example:"0xfeade..." swaggertype:"string"`
 }
 
+type EncryptRequest struct {
+	Data hexutil.Bytes `json:"data" validate:"required" example:"0xfeade..." swaggertype:"string"`
+}
+
+type DecryptRequest struct {
+	Data hexutil.Bytes `json:"data" validate:"required" example:"0x04a8d2..." swaggertype:"string"`
+}
+
 type SignTypedDataRequest struct {
 	DomainSeparator DomainSeparator        `json:"domainSeparator" validate:"required"`
 	Types           map[string][]Type      `json:"types" validate:"required"`
@@ -80,6 +107,7 @@ type SignQuorumPrivateTransactionRequest struct {
 	GasPrice hexutil.Big     `json:"gasPrice" validate:"required" example:"0x0" swaggertype:"string"`
 	GasLimit hexutil.Uint64  `json:"gasLimit" validate:"required" example:"0x5208" swaggertype:"string"`
 	Data     hexutil.Bytes   `json:"data,omitempty" example:"0xfeaeee..." swaggertype:"string"`
+	ChainID  *hexutil.Big    `json:"chainID,omitempty" example:"0x1 (mainnet)" swaggertype:"string"`
 }
 
 type SignEEATransactionRequest struct {
@@ -102,7 +130,9 @@ type EthAccountResponse struct {
 	UpdatedAt           time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 	DeletedAt           *time.Time        `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
 	KeyID               string            `json:"keyId" example:"my-key-id"`
+	DerivationIndex     *uint32           `json:"derivationIndex,omitempty" example:"0"`
 	Tags                map[string]string `json:"tags,omitempty"`
 	Address             common.Address    `json:"address" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6" swaggertype:"string"`
 	Disabled            bool              `json:"disabled" example:"false"`
+	ExpireAt            *time.Time        `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 }
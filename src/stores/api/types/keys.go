@@ -7,26 +7,38 @@ import (
 )
 
 type CreateKeyRequest struct {
-	Curve            string            `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1"`
-	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa"`
+	Curve            string            `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1,secp256r1,curve25519,bls12381"`
+	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa,bls"`
 	Tags             map[string]string `json:"tags,omitempty"`
+	ExpireAt         time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 }
 
 type ImportKeyRequest struct {
-	Curve            string            `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1"`
-	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa"`
+	Curve            string            `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1,secp256r1,curve25519,bls12381"`
+	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa,bls"`
 	PrivateKey       []byte            `json:"privateKey" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
 	Tags             map[string]string `json:"tags,omitempty"`
+	ExpireAt         time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 }
 
 type UpdateKeyRequest struct {
-	Tags map[string]string `json:"tags,omitempty"`
+	Tags          map[string]string `json:"tags,omitempty"`
+	ExpireAt      time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
+	ClearExpireAt bool              `json:"clearExpireAt,omitempty" validate:"excluded_with=ExpireAt" example:"false"`
 }
 
 type SignBase64PayloadRequest struct {
 	Data []byte `json:"data" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
 }
 
+type EncryptBase64PayloadRequest struct {
+	Data []byte `json:"data" validate:"required" example:"bXkgcGxhaW50ZXh0IG1lc3NhZ2U=" swaggertype:"string"`
+}
+
+type DecryptBase64PayloadRequest struct {
+	Data []byte `json:"data" validate:"required" example:"BKpL1f2ahSIYnlpZJ0k4XvpbGZ3g==" swaggertype:"string"`
+}
+
 type KeyResponse struct {
 	ID               string               `json:"id" example:"my-key"`
 	PublicKey        string               `json:"publicKey" example:"Cjix/fS3WdqKGKabagBNYwcClan5aImoFpnjSF0cqJs=" swaggertype:"string"`
@@ -34,7 +46,9 @@ type KeyResponse struct {
 	SigningAlgorithm string               `json:"signingAlgorithm" example:"ecdsa"`
 	Tags             map[string]string    `json:"tags,omitempty"`
 	Annotations      *entities.Annotation `json:"annotations,omitempty"`
+	Version          string               `json:"version,omitempty" example:"2"`
 	Disabled         bool                 `json:"disabled" example:"false"`
+	ExpireAt         *time.Time           `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 	CreatedAt        time.Time            `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 	UpdatedAt        time.Time            `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 	DeletedAt        *time.Time           `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
@@ -3,8 +3,9 @@ package types
 import "time"
 
 type SetSecretRequest struct {
-	Value string            `json:"value" validate:"required" example:"my-value"`
-	Tags  map[string]string `json:"tags,omitempty"`
+	Value    string            `json:"value" validate:"required" example:"my-value"`
+	Tags     map[string]string `json:"tags,omitempty"`
+	ExpireAt time.Time         `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 }
 
 type SecretResponse struct {
@@ -13,6 +14,7 @@ type SecretResponse struct {
 	Tags      map[string]string `json:"tags,omitempty"`
 	Version   string            `json:"version" example:"1"`
 	Disabled  bool              `json:"disabled" example:"false"`
+	ExpireAt  *time.Time        `json:"expireAt,omitempty" example:"2030-07-09T12:35:42Z"`
 	CreatedAt time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 	UpdatedAt time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 	DeletedAt *time.Time        `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
@@ -5,10 +5,23 @@ type CreateSecretStoreRequest struct {
 }
 
 type CreateKeyStoreRequest struct {
-	SecretStore string `json:"secretStore,omitempty" yaml:"secret_store,omitempty" example:"my-secret-store"`
-	Vault       string `json:"vault,omitempty" yaml:"vault,omitempty" example:"hashicorp-quorum"`
+	SecretStore        string                   `json:"secretStore,omitempty" yaml:"secret_store,omitempty" example:"my-secret-store"`
+	Vault              string                   `json:"vault,omitempty" yaml:"vault,omitempty" example:"hashicorp-quorum"`
+	EnvelopeEncryption *EnvelopeEncryptionSpecs `json:"envelopeEncryption,omitempty" yaml:"envelope_encryption,omitempty"`
+}
+
+type EnvelopeEncryptionSpecs struct {
+	KeyStore string `json:"keyStore" yaml:"key_store" validate:"required" example:"my-aws-key-store"`
+	KeyID    string `json:"keyId" yaml:"key_id" validate:"required" example:"my-key-encryption-key"`
 }
 
 type CreateEthereumStoreRequest struct {
-	KeyStore string `json:"keyStore" yaml:"key_store" validate:"required" example:"my-key-store"`
+	KeyStore string         `json:"keyStore" yaml:"key_store" validate:"required" example:"my-key-store"`
+	HDWallet *HDWalletSpecs `json:"hdWallet,omitempty" yaml:"hd_wallet,omitempty"`
+}
+
+type HDWalletSpecs struct {
+	SecretStore    string `json:"secretStore" yaml:"secret_store" validate:"required" example:"my-secret-store"`
+	SeedID         string `json:"seedId" yaml:"seed_id" validate:"required" example:"my-hd-wallet-seed"`
+	DerivationPath string `json:"derivationPath,omitempty" yaml:"derivation_path,omitempty" example:"m/44'/60'/0'/0"`
 }
@@ -46,6 +46,18 @@ func FakeSignBase64PayloadRequest() *types.SignBase64PayloadRequest {
 	}
 }
 
+func FakeEncryptBase64PayloadRequest() *types.EncryptBase64PayloadRequest {
+	return &types.EncryptBase64PayloadRequest{
+		Data: []byte("my data to encrypt"),
+	}
+}
+
+func FakeDecryptBase64PayloadRequest() *types.DecryptBase64PayloadRequest {
+	return &types.DecryptBase64PayloadRequest{
+		Data: []byte("my data to decrypt"),
+	}
+}
+
 func FakeCreateEthAccountRequest() *types.CreateEthAccountRequest {
 	randID := cmn.RandString(10)
 	return &types.CreateEthAccountRequest{
@@ -62,6 +74,20 @@ func FakeImportEthAccountRequest() *types.ImportEthAccountRequest {
 	}
 }
 
+func FakeExportEthAccountRequest() *types.ExportEthAccountRequest {
+	return &types.ExportEthAccountRequest{
+		Passphrase: "my-passphrase",
+	}
+}
+
+func FakeImportEthMnemonicRequest() *types.ImportEthMnemonicRequest {
+	return &types.ImportEthMnemonicRequest{
+		Mnemonic:    "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		NumAccounts: 5,
+		Tags:        testutils.FakeTags(),
+	}
+}
+
 func FakeUpdateEthAccountRequest() *types.UpdateEthAccountRequest {
 	return &types.UpdateEthAccountRequest{
 		Tags: testutils.FakeTags(),
@@ -74,6 +100,18 @@ func FakeSignMessageRequest() *types.SignMessageRequest {
 	}
 }
 
+func FakeEncryptRequest() *types.EncryptRequest {
+	return &types.EncryptRequest{
+		Data: []byte("any data goes here"),
+	}
+}
+
+func FakeDecryptRequest() *types.DecryptRequest {
+	return &types.DecryptRequest{
+		Data: []byte("any encrypted data goes here"),
+	}
+}
+
 func FakeSignTypedDataRequest() *types.SignTypedDataRequest {
 	return &types.SignTypedDataRequest{
 		DomainSeparator: types.DomainSeparator{
@@ -161,3 +199,40 @@ func FakeSignEEATransactionRequest() *types.SignEEATransactionRequest {
 		Data:        hexutil.MustDecode("0xfeee"),
 	}
 }
+
+func FakeSignBlockRequest() *types.SignBlockRequest {
+	return &types.SignBlockRequest{
+		Slot:        81952,
+		SigningRoot: hexutil.MustDecode("0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"),
+	}
+}
+
+func FakeSignAttestationRequest() *types.SignAttestationRequest {
+	return &types.SignAttestationRequest{
+		SourceEpoch: 2290,
+		TargetEpoch: 3007,
+		SigningRoot: hexutil.MustDecode("0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d"),
+	}
+}
+
+func FakeSlashingProtectionInterchange() *types.SlashingProtectionInterchange {
+	return &types.SlashingProtectionInterchange{
+		Metadata: &types.SlashingProtectionMetadata{
+			InterchangeFormatVersion: types.SlashingProtectionInterchangeVersion,
+			GenesisValidatorsRoot:    hexutil.MustDecode("0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"),
+		},
+		Data: []*types.ValidatorSigningHistory{
+			{
+				PublicKey: hexutil.MustDecode("0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed"),
+				SignedBlocks: []*types.SignedBlockRecord{
+					{Slot: 81952, SigningRoot: hexutil.MustDecode("0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b")},
+					{Slot: 81951},
+				},
+				SignedAttestations: []*types.SignedAttestationRecord{
+					{SourceEpoch: 2290, TargetEpoch: 3007, SigningRoot: hexutil.MustDecode("0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d")},
+					{SourceEpoch: 2290, TargetEpoch: 3008},
+				},
+			},
+		},
+	}
+}
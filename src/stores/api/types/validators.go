@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const SlashingProtectionInterchangeVersion = "5"
+
+type SignBlockRequest struct {
+	Slot        uint64        `json:"slot" example:"1024"`
+	SigningRoot hexutil.Bytes `json:"signingRoot" validate:"required,len=32" example:"0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b" swaggertype:"string"`
+}
+
+type SignAttestationRequest struct {
+	SourceEpoch uint64        `json:"sourceEpoch" example:"31"`
+	TargetEpoch uint64        `json:"targetEpoch" example:"32"`
+	SigningRoot hexutil.Bytes `json:"signingRoot" validate:"required,len=32" example:"0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d" swaggertype:"string"`
+}
+
+// SlashingProtectionInterchange follows the EIP-3076 interchange format https://eips.ethereum.org/EIPS/eip-3076
+type SlashingProtectionInterchange struct {
+	Metadata *SlashingProtectionMetadata `json:"metadata" validate:"required"`
+	Data     []*ValidatorSigningHistory  `json:"data" validate:"dive"`
+}
+
+type SlashingProtectionMetadata struct {
+	InterchangeFormatVersion string        `json:"interchange_format_version" validate:"required,eq=5" example:"5"`
+	GenesisValidatorsRoot    hexutil.Bytes `json:"genesis_validators_root" validate:"required,len=32" example:"0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673" swaggertype:"string"`
+}
+
+type ValidatorSigningHistory struct {
+	PublicKey          hexutil.Bytes              `json:"pubkey" validate:"required" example:"0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed" swaggertype:"string"`
+	SignedBlocks       []*SignedBlockRecord       `json:"signed_blocks"`
+	SignedAttestations []*SignedAttestationRecord `json:"signed_attestations"`
+}
+
+type SignedBlockRecord struct {
+	Slot        uint64        `json:"slot,string" example:"81952"`
+	SigningRoot hexutil.Bytes `json:"signing_root,omitempty" example:"0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b" swaggertype:"string"`
+}
+
+type SignedAttestationRecord struct {
+	SourceEpoch uint64        `json:"source_epoch,string" example:"2290"`
+	TargetEpoch uint64        `json:"target_epoch,string" example:"3007"`
+	SigningRoot hexutil.Bytes `json:"signing_root,omitempty" example:"0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d" swaggertype:"string"`
+}
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Web3Signer eth2 signing types, see https://consensys.github.io/web3signer/web3signer-eth2.html
+const (
+	Web3SignerAggregationSlot                   = "AGGREGATION_SLOT"
+	Web3SignerAggregateAndProof                 = "AGGREGATE_AND_PROOF"
+	Web3SignerAttestation                       = "ATTESTATION"
+	Web3SignerBlock                             = "BLOCK"
+	Web3SignerBlockV2                           = "BLOCK_V2"
+	Web3SignerDeposit                           = "DEPOSIT"
+	Web3SignerRandaoReveal                      = "RANDAO_REVEAL"
+	Web3SignerVoluntaryExit                     = "VOLUNTARY_EXIT"
+	Web3SignerSyncCommitteeMessage              = "SYNC_COMMITTEE_MESSAGE"
+	Web3SignerSyncCommitteeSelectionProof       = "SYNC_COMMITTEE_SELECTION_PROOF"
+	Web3SignerSyncCommitteeContributionAndProof = "SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF"
+	Web3SignerValidatorRegistration             = "VALIDATOR_REGISTRATION"
+)
+
+type Web3SignerEth1SignRequest struct {
+	Data hexutil.Bytes `json:"data" validate:"required" example:"0xfeee" swaggertype:"string"`
+}
+
+// Web3SignerEth2SignRequest holds the typed payload of a Web3Signer signing request.
+// The signing root is computed from the payload, if provided by the client it must match the computed one
+type Web3SignerEth2SignRequest struct {
+	Type                        string                                 `json:"type" validate:"required,oneof=AGGREGATION_SLOT AGGREGATE_AND_PROOF ATTESTATION BLOCK BLOCK_V2 DEPOSIT RANDAO_REVEAL VOLUNTARY_EXIT SYNC_COMMITTEE_MESSAGE SYNC_COMMITTEE_SELECTION_PROOF SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF VALIDATOR_REGISTRATION" example:"BLOCK_V2"`
+	SigningRoot                 hexutil.Bytes                          `json:"signingRoot,omitempty" validate:"omitempty,len=32" example:"0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b" swaggertype:"string"`
+	ForkInfo                    *Web3SignerForkInfo                    `json:"fork_info,omitempty"`
+	Block                       *Web3SignerBlockHeader                 `json:"block,omitempty"`
+	BeaconBlock                 *Web3SignerBeaconBlock                 `json:"beacon_block,omitempty"`
+	Attestation                 *Web3SignerAttestationData             `json:"attestation,omitempty"`
+	AggregationSlot             *Web3SignerAggregationSlotData         `json:"aggregation_slot,omitempty"`
+	AggregateAndProof           *Web3SignerAggregateAndProofData       `json:"aggregate_and_proof,omitempty"`
+	Deposit                     *Web3SignerDepositData                 `json:"deposit,omitempty"`
+	RandaoReveal                *Web3SignerRandaoRevealData            `json:"randao_reveal,omitempty"`
+	VoluntaryExit               *Web3SignerVoluntaryExitData           `json:"voluntary_exit,omitempty"`
+	SyncCommitteeMessage        *Web3SignerSyncCommitteeMessageData    `json:"sync_committee_message,omitempty"`
+	SyncAggregatorSelectionData *Web3SignerSyncAggregatorSelectionData `json:"sync_aggregator_selection_data,omitempty"`
+	ContributionAndProof        *Web3SignerContributionAndProofData    `json:"contribution_and_proof,omitempty"`
+	ValidatorRegistration       *Web3SignerValidatorRegistrationData   `json:"validator_registration,omitempty"`
+}
+
+type Web3SignerForkInfo struct {
+	Fork                  *Web3SignerFork `json:"fork" validate:"required"`
+	GenesisValidatorsRoot hexutil.Bytes   `json:"genesis_validators_root" validate:"required,len=32" example:"0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673" swaggertype:"string"`
+}
+
+type Web3SignerFork struct {
+	PreviousVersion hexutil.Bytes `json:"previous_version" validate:"required,len=4" example:"0x00001020" swaggertype:"string"`
+	CurrentVersion  hexutil.Bytes `json:"current_version" validate:"required,len=4" example:"0x01001020" swaggertype:"string"`
+	Epoch           uint64        `json:"epoch,string" example:"36660"`
+}
+
+type Web3SignerBeaconBlock struct {
+	Version     string                 `json:"version" example:"BELLATRIX"`
+	Block       *Web3SignerBlockHeader `json:"block,omitempty"`
+	BlockHeader *Web3SignerBlockHeader `json:"block_header,omitempty"`
+}
+
+// Web3SignerBlockHeader is the header of the block to sign, blocks are only accepted in this form as their root is computed from the body root
+type Web3SignerBlockHeader struct {
+	Slot          uint64        `json:"slot,string" example:"81952"`
+	ProposerIndex uint64        `json:"proposer_index,string" example:"4666"`
+	ParentRoot    hexutil.Bytes `json:"parent_root" validate:"required,len=32" example:"0x4f2ff0e9e4fb0fbbcb3e5bbd3e0ea8f1a6e7bd0eb8e7fa0c2a8e9b2ba7d5b1f1" swaggertype:"string"`
+	StateRoot     hexutil.Bytes `json:"state_root" validate:"required,len=32" example:"0x4f2ff0e9e4fb0fbbcb3e5bbd3e0ea8f1a6e7bd0eb8e7fa0c2a8e9b2ba7d5b1f1" swaggertype:"string"`
+	BodyRoot      hexutil.Bytes `json:"body_root" validate:"required,len=32" example:"0x4f2ff0e9e4fb0fbbcb3e5bbd3e0ea8f1a6e7bd0eb8e7fa0c2a8e9b2ba7d5b1f1" swaggertype:"string"`
+}
+
+type Web3SignerAttestationData struct {
+	Slot            uint64                `json:"slot,string" example:"73280"`
+	Index           uint64                `json:"index,string" example:"0"`
+	BeaconBlockRoot hexutil.Bytes         `json:"beacon_block_root" validate:"required,len=32" example:"0x4f2ff0e9e4fb0fbbcb3e5bbd3e0ea8f1a6e7bd0eb8e7fa0c2a8e9b2ba7d5b1f1" swaggertype:"string"`
+	Source          *Web3SignerCheckpoint `json:"source" validate:"required"`
+	Target          *Web3SignerCheckpoint `json:"target" validate:"required"`
+}
+
+type Web3SignerCheckpoint struct {
+	Epoch uint64        `json:"epoch,string" example:"2290"`
+	Root  hexutil.Bytes `json:"root" validate:"required,len=32" example:"0x4f2ff0e9e4fb0fbbcb3e5bbd3e0ea8f1a6e7bd0eb8e7fa0c2a8e9b2ba7d5b1f1" swaggertype:"string"`
+}
+
+type Web3SignerAggregationSlotData struct {
+	Slot uint64 `json:"slot,string" example:"73280"`
+}
+
+type Web3SignerAggregateAndProofData struct {
+	AggregatorIndex uint64                          `json:"aggregator_index,string" example:"1"`
+	Aggregate       *Web3SignerAggregateAttestation `json:"aggregate" validate:"required"`
+	SelectionProof  hexutil.Bytes                   `json:"selection_proof" validate:"required,len=96" swaggertype:"string"`
+}
+
+type Web3SignerAggregateAttestation struct {
+	AggregationBits hexutil.Bytes              `json:"aggregation_bits" validate:"required" example:"0x01" swaggertype:"string"`
+	Data            *Web3SignerAttestationData `json:"data" validate:"required"`
+	Signature       hexutil.Bytes              `json:"signature" validate:"required,len=96" swaggertype:"string"`
+}
+
+type Web3SignerDepositData struct {
+	PublicKey             hexutil.Bytes `json:"pubkey" validate:"required,len=48" swaggertype:"string"`
+	WithdrawalCredentials hexutil.Bytes `json:"withdrawal_credentials" validate:"required,len=32" swaggertype:"string"`
+	Amount                uint64        `json:"amount,string" example:"32000000000"`
+	GenesisForkVersion    hexutil.Bytes `json:"genesis_fork_version" validate:"required,len=4" example:"0x00000000" swaggertype:"string"`
+}
+
+type Web3SignerRandaoRevealData struct {
+	Epoch uint64 `json:"epoch,string" example:"2290"`
+}
+
+type Web3SignerVoluntaryExitData struct {
+	Epoch          uint64 `json:"epoch,string" example:"2290"`
+	ValidatorIndex uint64 `json:"validator_index,string" example:"1"`
+}
+
+type Web3SignerSyncCommitteeMessageData struct {
+	BeaconBlockRoot hexutil.Bytes `json:"beacon_block_root" validate:"required,len=32" swaggertype:"string"`
+	Slot            uint64        `json:"slot,string" example:"73280"`
+}
+
+type Web3SignerSyncAggregatorSelectionData struct {
+	Slot              uint64 `json:"slot,string" example:"73280"`
+	SubcommitteeIndex uint64 `json:"subcommittee_index,string" example:"0"`
+}
+
+type Web3SignerContributionAndProofData struct {
+	AggregatorIndex uint64                               `json:"aggregator_index,string" example:"1"`
+	SelectionProof  hexutil.Bytes                        `json:"selection_proof" validate:"required,len=96" swaggertype:"string"`
+	Contribution    *Web3SignerSyncCommitteeContribution `json:"contribution" validate:"required"`
+}
+
+type Web3SignerSyncCommitteeContribution struct {
+	Slot              uint64        `json:"slot,string" example:"73280"`
+	BeaconBlockRoot   hexutil.Bytes `json:"beacon_block_root" validate:"required,len=32" swaggertype:"string"`
+	SubcommitteeIndex uint64        `json:"subcommittee_index,string" example:"0"`
+	AggregationBits   hexutil.Bytes `json:"aggregation_bits" validate:"required,len=16" swaggertype:"string"`
+	Signature         hexutil.Bytes `json:"signature" validate:"required,len=96" swaggertype:"string"`
+}
+
+type Web3SignerValidatorRegistrationData struct {
+	FeeRecipient hexutil.Bytes `json:"fee_recipient" validate:"required,len=20" swaggertype:"string"`
+	GasLimit     uint64        `json:"gas_limit,string" example:"30000000"`
+	Timestamp    uint64        `json:"timestamp,string" example:"1663224000"`
+	PublicKey    hexutil.Bytes `json:"pubkey" validate:"required,len=48" swaggertype:"string"`
+}
+
+type Web3SignerSignResponse struct {
+	Signature string `json:"signature" example:"0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9"`
+}
@@ -0,0 +1,18 @@
+package app
+
+import "github.com/consensys/quorum-key-manager/pkg/eth2"
+
+// Web3SignerConfig holds the stores exposed through the Web3Signer compatible API
+type Web3SignerConfig struct {
+	Eth1Store          string
+	Eth2Store          string
+	GenesisForkVersion eth2.Version
+}
+
+func NewWeb3SignerConfig(eth1Store, eth2Store string, genesisForkVersion eth2.Version) *Web3SignerConfig {
+	return &Web3SignerConfig{
+		Eth1Store:          eth1Store,
+		Eth2Store:          eth2Store,
+		GenesisForkVersion: genesisForkVersion,
+	}
+}
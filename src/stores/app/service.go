@@ -4,6 +4,8 @@ import (
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/policies"
+	"github.com/consensys/quorum-key-manager/src/stores/api/clef"
 	"github.com/consensys/quorum-key-manager/src/stores/api/http"
 	"github.com/consensys/quorum-key-manager/src/stores/connectors/stores"
 	db "github.com/consensys/quorum-key-manager/src/stores/database/postgres"
@@ -11,15 +13,24 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, roles auth.Roles, vaultsService vaults.Vaults) *stores.Connector {
+func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, roles auth.Roles, vaultsService vaults.Vaults, policiesService policies.Policies, web3SignerCfg *Web3SignerConfig) *stores.Connector {
 	// Data layer
 	storesDB := db.New(logger, postgresClient)
 
 	// Business layer
-	storesService := stores.NewConnector(roles, storesDB, vaultsService, logger)
+	storesService := stores.NewConnector(roles, storesDB, vaultsService, policiesService, logger)
 
 	// Service layer
-	http.NewStoresHandler(storesService).Register(router)
+	eth2Store := ""
+	if web3SignerCfg != nil {
+		eth2Store = web3SignerCfg.Eth2Store
+	}
+	http.NewStoresHandler(storesService, eth2Store).Register(router)
+	clef.New(storesService, logger.WithComponent("clef")).Register(router)
+	if web3SignerCfg != nil {
+		http.NewWeb3SignerHandler(storesService, web3SignerCfg.Eth1Store, web3SignerCfg.Eth2Store, web3SignerCfg.GenesisForkVersion).Register(router)
+		logger.Info("Web3Signer API enabled", "eth1_store", web3SignerCfg.Eth1Store, "eth2_store", web3SignerCfg.Eth2Store)
+	}
 
 	return storesService
 }
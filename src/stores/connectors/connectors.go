@@ -0,0 +1,27 @@
+package connectors
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// CheckUsable verifies that a stored item is neither disabled nor expired, so that it can be used for cryptographic
+// operations or to retrieve its value. The name of the item is used in the returned error
+func CheckUsable(logger log.Logger, item string, metadata *entities.Metadata) error {
+	if metadata.Disabled {
+		errMessage := fmt.Sprintf("%s is disabled", item)
+		logger.Error(errMessage)
+		return errors.StatusConflictError(errMessage)
+	}
+
+	if metadata.IsExpired() {
+		errMessage := fmt.Sprintf("%s is expired", item)
+		logger.With("expire_at", metadata.ExpireAt).Error(errMessage)
+		return errors.StatusConflictError(errMessage)
+	}
+
+	return nil
+}
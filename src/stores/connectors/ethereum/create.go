@@ -20,6 +20,16 @@ func (c Connector) Create(ctx context.Context, id string, attr *entities.Attribu
 		return nil, err
 	}
 
+	if c.hdWallet != nil {
+		acc, derr := c.createDerived(ctx, id, attr)
+		if derr != nil {
+			return nil, derr
+		}
+
+		logger.With("address", acc.Address, "derivation_index", *acc.DerivationIndex).Info("ethereum account derived successfully")
+		return acc, nil
+	}
+
 	key, err := c.store.Create(ctx, id, ethAlgo, attr)
 	if err != nil && errors.IsAlreadyExistsError(err) {
 		key, err = c.store.Get(ctx, id)
@@ -5,7 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/hdwallet"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
 	"github.com/consensys/quorum-key-manager/src/stores/database/models"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 
@@ -18,6 +23,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreate(t *testing.T) {
@@ -92,3 +98,93 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 }
+
+func TestCreateHDWallet(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attributes := testutils2.FakeAttributes()
+	path, _ := hdwallet.ParseDerivationPath("")
+
+	store := mock.NewMockKeyStore(ctrl)
+	secretStore := mock.NewMockSecretStore(ctrl)
+	secretsDB := mock2.NewMockSecrets(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	hdWallet := &HDWallet{SecretStore: secretStore, SecretsDB: secretsDB, SeedID: testSeedID, Path: path}
+	connector := NewHDWalletConnector(store, hdWallet, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
+		return persist(db)
+	}).AnyTimes()
+
+	t.Run("should derive eth account at the next index successfully", func(t *testing.T) {
+		seed, _ := hdwallet.SeedFromMnemonic(testMnemonic, "")
+		secret := testutils2.FakeSecret()
+		secret.Value = hexutil.Encode(seed)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().NextDerivationIndex(gomock.Any()).Return(uint32(1), nil)
+		secretStore.EXPECT().Get(gomock.Any(), testSeedID, "").Return(secret, nil)
+		store.EXPECT().Import(gomock.Any(), "my-key", gomock.Any(), ethAlgo, attributes).DoAndReturn(fakeImportKey)
+		db.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, acc *entities2.ETHAccount) (*entities2.ETHAccount, error) {
+			return acc, nil
+		})
+
+		rAcc, err := connector.Create(ctx, "my-key", attributes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, common.HexToAddress("0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"), rAcc.Address)
+		assert.Equal(t, uint32(1), *rAcc.DerivationIndex)
+	})
+
+	t.Run("should generate the seed from a new mnemonic on first use", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+		var mnemonic, seed string
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().NextDerivationIndex(gomock.Any()).Return(uint32(0), nil)
+		secretStore.EXPECT().Get(gomock.Any(), testSeedID, "").Return(nil, errors.NotFoundError("error"))
+		secretStore.EXPECT().Set(gomock.Any(), testSeedID+"-mnemonic", gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _, value string, _ *entities2.Attributes) (*entities2.Secret, error) {
+			mnemonic = value
+			return secret, nil
+		})
+		secretStore.EXPECT().Set(gomock.Any(), testSeedID, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _, value string, _ *entities2.Attributes) (*entities2.Secret, error) {
+			seed = value
+			return secret, nil
+		})
+		secretsDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil).Times(2)
+		store.EXPECT().Import(gomock.Any(), "my-key", gomock.Any(), ethAlgo, attributes).DoAndReturn(fakeImportKey)
+		db.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, acc *entities2.ETHAccount) (*entities2.ETHAccount, error) {
+			return acc, nil
+		})
+
+		rAcc, err := connector.Create(ctx, "my-key", attributes)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), *rAcc.DerivationIndex)
+
+		expectedSeed, err := hdwallet.SeedFromMnemonic(mnemonic, "")
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Encode(expectedSeed), seed)
+	})
+
+	t.Run("should fail with same error if the key store fails to import", func(t *testing.T) {
+		seed, _ := hdwallet.SeedFromMnemonic(testMnemonic, "")
+		secret := testutils2.FakeSecret()
+		secret.Value = hexutil.Encode(seed)
+		expectedErr := fmt.Errorf("error")
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().NextDerivationIndex(gomock.Any()).Return(uint32(0), nil)
+		secretStore.EXPECT().Get(gomock.Any(), testSeedID, "").Return(secret, nil)
+		store.EXPECT().Import(gomock.Any(), "my-key", gomock.Any(), ethAlgo, attributes).Return(nil, expectedErr)
+
+		_, err := connector.Create(ctx, "my-key", attributes)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
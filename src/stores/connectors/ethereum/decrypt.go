@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -21,7 +22,12 @@ func (c Connector) Decrypt(ctx context.Context, addr common.Address, data []byte
 		return nil, err
 	}
 
-	result, err := c.store.Decrypt(ctx, acc.KeyID, data)
+	err = connectors.CheckUsable(c.logger.With("address", acc.Address.Hex()), "ethereum account", acc.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.store.Decrypt(ctx, acc.KeyID, data, ethAlgo)
 	if err != nil {
 		return nil, err
 	}
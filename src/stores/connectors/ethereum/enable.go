@@ -0,0 +1,59 @@
+package eth
+
+import (
+	"context"
+
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func (c Connector) Enable(ctx context.Context, addr ethcommon.Address) error {
+	return c.setDisabled(ctx, addr, false)
+}
+
+func (c Connector) Disable(ctx context.Context, addr ethcommon.Address) error {
+	return c.setDisabled(ctx, addr, true)
+}
+
+func (c Connector) setDisabled(ctx context.Context, addr ethcommon.Address, disabled bool) error {
+	logger := c.logger.With("address", addr.Hex(), "disabled", disabled)
+	logger.Debug("updating ethereum account status")
+
+	err := c.authorizator.CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount})
+	if err != nil {
+		return err
+	}
+
+	acc, err := c.db.Get(ctx, addr.Hex())
+	if err != nil {
+		return err
+	}
+	acc.Metadata.Disabled = disabled
+
+	err = c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
+		_, derr := dbtx.Update(ctx, acc)
+		if derr != nil {
+			return derr
+		}
+
+		if disabled {
+			derr = c.store.Disable(ctx, acc.KeyID)
+		} else {
+			derr = c.store.Enable(ctx, acc.KeyID)
+		}
+		if derr != nil && !errors.IsNotSupportedError(derr) { // If the underlying store does not support disabling, we only disable in DB
+			return derr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("ethereum account status updated successfully")
+	return nil
+}
@@ -0,0 +1,95 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableEthAccount(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("my error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should disable ethAccount successfully, ignoring not supported error", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *entities.ETHAccount) (*entities.ETHAccount, error) {
+			assert.True(t, a.Metadata.Disabled)
+			return a, nil
+		})
+		store.EXPECT().Disable(gomock.Any(), acc.KeyID).Return(errors.NotSupportedError("not supported"))
+
+		err := connector.Disable(ctx, acc.Address)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should enable ethAccount successfully", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+		acc.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *entities.ETHAccount) (*entities.ETHAccount, error) {
+			assert.False(t, a.Metadata.Disabled)
+			return a, nil
+		})
+		store.EXPECT().Enable(gomock.Any(), acc.KeyID).Return(nil)
+
+		err := connector.Enable(ctx, acc.Address)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(expectedErr)
+
+		err := connector.Disable(ctx, acc.Address)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if db fails", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := connector.Disable(ctx, acc.Address)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
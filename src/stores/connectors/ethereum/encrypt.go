@@ -3,6 +3,9 @@ package eth
 import (
 	"context"
 
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
+
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -21,7 +24,18 @@ func (c Connector) Encrypt(ctx context.Context, addr ethcommon.Address, data []b
 		return nil, err
 	}
 
-	result, err := c.store.Encrypt(ctx, acc.KeyID, data)
+	err = connectors.CheckUsable(c.logger.With("address", acc.Address.Hex()), "ethereum account", acc.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []byte
+	if encrypter, ok := c.store.(stores.PublicKeyEncrypter); ok {
+		// Encrypting from the stored public key does not require any access to the private key
+		result, err = encrypter.EncryptWithPublicKey(acc.PublicKey, data, ethAlgo)
+	} else {
+		result, err = c.store.Encrypt(ctx, acc.KeyID, data, ethAlgo)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +38,7 @@ func TestEncryptKey(t *testing.T) {
 	t.Run("should encrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(result, nil)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, ethAlgo).Return(result, nil)
 
 		rResult, err := connector.Encrypt(ctx, acc.Address, data)
 
@@ -46,6 +46,23 @@ func TestEncryptKey(t *testing.T) {
 		assert.Equal(t, rResult, result)
 	})
 
+	t.Run("should encrypt data with the stored public key successfully", func(t *testing.T) {
+		encrypter := mock.NewMockPublicKeyEncrypter(ctrl)
+		pubKeyConnector := NewConnector(&struct {
+			*mock.MockKeyStore
+			*mock.MockPublicKeyEncrypter
+		}{store, encrypter}, db, auth, logger)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		encrypter.EXPECT().EncryptWithPublicKey(acc.PublicKey, data, ethAlgo).Return(result, nil)
+
+		rResult, err := pubKeyConnector.Encrypt(ctx, acc.Address, data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rResult, result)
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(expectedErr)
 
@@ -68,7 +85,7 @@ func TestEncryptKey(t *testing.T) {
 	t.Run("should fail to encrypt data if store fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, ethAlgo).Return(nil, expectedErr)
 
 		_, err := connector.Encrypt(ctx, acc.Address, data)
 
@@ -4,6 +4,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/policies"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 )
@@ -13,6 +14,9 @@ type Connector struct {
 	logger       log.Logger
 	db           database.ETHAccounts
 	authorizator auth.Authorizator
+	hdWallet     *HDWallet
+	storeName    string
+	policies     policies.Policies
 }
 
 var _ stores.EthStore = Connector{}
@@ -30,3 +34,11 @@ func NewConnector(store stores.KeyStore, db database.ETHAccounts, authorizator a
 		authorizator: authorizator,
 	}
 }
+
+// WithPolicies returns a copy of the connector checking the transactions it signs against the signing policies applying to the store
+func (c Connector) WithPolicies(storeName string, policiesService policies.Policies) *Connector {
+	c.storeName = storeName
+	c.policies = policiesService
+
+	return &c
+}
@@ -0,0 +1,62 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+func (c Connector) Export(ctx context.Context, addr ethcommon.Address, passphrase string) ([]byte, error) {
+	logger := c.logger.With("address", addr.Hex())
+
+	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := c.db.Get(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	err = connectors.CheckUsable(c.logger.With("address", acc.Address.Hex()), "ethereum account", acc.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := c.store.Export(ctx, acc.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdsaKey, err := crypto.ToECDSA(privKey)
+	if err != nil || !bytes.Equal(crypto.FromECDSAPub(&ecdsaKey.PublicKey), acc.PublicKey) {
+		errMessage := "exported private key does not match the ethereum account"
+		logger.Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		errMessage := "failed to generate keystore id"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{Id: id, Address: acc.Address, PrivateKey: ecdsaKey}, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		errMessage := "failed to encrypt keystore"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	logger.Info("ethereum account exported successfully")
+	return keyJSON, nil
+}
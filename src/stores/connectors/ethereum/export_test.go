@@ -0,0 +1,84 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+	acc := testutils2.FakeETHAccount()
+	privKey := hexutil.MustDecode("0xdb337ca3295e4050586793f252e641f3b3a83739018fa4cce01a81ca920e7e1c")
+	passphrase := "my-passphrase"
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should export account as an encrypted keystore successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		store.EXPECT().Export(gomock.Any(), acc.KeyID).Return(privKey, nil)
+
+		keyJSON, err := connector.Export(ctx, acc.Address, passphrase)
+		require.NoError(t, err)
+
+		key, err := keystore.DecryptKey(keyJSON, passphrase)
+		require.NoError(t, err)
+		assert.Equal(t, acc.Address, key.Address)
+		assert.Equal(t, privKey, crypto.FromECDSA(key.PrivateKey))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceEthAccount}).Return(expectedErr)
+
+		_, err := connector.Export(ctx, acc.Address, passphrase)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if export fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		store.EXPECT().Export(gomock.Any(), acc.KeyID).Return(nil, expectedErr)
+
+		_, err := connector.Export(ctx, acc.Address, passphrase)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with DependencyFailureError if exported key does not match the account", func(t *testing.T) {
+		otherKey, _ := crypto.GenerateKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		store.EXPECT().Export(gomock.Any(), acc.KeyID).Return(crypto.FromECDSA(otherKey), nil)
+
+		_, err := connector.Export(ctx, acc.Address, passphrase)
+
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
+}
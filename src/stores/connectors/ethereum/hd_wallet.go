@@ -0,0 +1,159 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/hdwallet"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/database/models"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// HDWallet holds the seed location and derivation path of an HD wallet ethereum store
+type HDWallet struct {
+	SecretStore stores.SecretStore
+	SecretsDB   database.Secrets
+	SeedID      string
+	Path        accounts.DerivationPath
+}
+
+func NewHDWalletConnector(store stores.KeyStore, hdWallet *HDWallet, db database.ETHAccounts, authorizator auth.Authorizator, logger log.Logger) *Connector {
+	connector := NewConnector(store, db, authorizator, logger)
+	connector.hdWallet = hdWallet
+
+	return connector
+}
+
+func (c Connector) createDerived(ctx context.Context, id string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	var acc *entities.ETHAccount
+	err := c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
+		index, derr := dbtx.NextDerivationIndex(ctx)
+		if derr != nil {
+			return derr
+		}
+
+		seed, derr := c.getSeed(ctx)
+		if derr != nil {
+			return derr
+		}
+
+		if seed == nil {
+			seed, derr = c.generateSeed(ctx)
+			if derr != nil {
+				return derr
+			}
+		}
+
+		acc, derr = c.importDerived(ctx, dbtx, seed, id, index, attr)
+		return derr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
+
+func (c Connector) importDerived(ctx context.Context, dbtx database.ETHAccounts, seed []byte, id string, index uint32, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	logger := c.logger.With("id", id, "derivation_index", index)
+
+	privKey, err := hdwallet.DeriveSecp256k1(seed, c.hdWallet.Path, index)
+	if err != nil {
+		errMessage := "failed to derive ethereum account"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	key, err := c.store.Import(ctx, id, privKey, ethAlgo, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := models.NewETHAccountFromKey(key, attr)
+	acc.DerivationIndex = &index
+
+	return dbtx.Add(ctx, acc)
+}
+
+// getSeed returns the seed of the HD wallet, or nil if it has not been set yet
+func (c Connector) getSeed(ctx context.Context) ([]byte, error) {
+	secret, err := c.hdWallet.SecretStore.Get(ctx, c.hdWallet.SeedID, "")
+	if err != nil && errors.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := hexutil.Decode(secret.Value)
+	if err != nil {
+		errMessage := "invalid hd wallet seed"
+		c.logger.With("seed_id", c.hdWallet.SeedID).WithError(err).Error(errMessage)
+		return nil, errors.EncodingError(errMessage)
+	}
+
+	return seed, nil
+}
+
+// generateSeed creates a new BIP-39 mnemonic and sets the HD wallet seed from it. The mnemonic is kept in the secret store
+// next to the seed so that the wallet can be backed up and recovered with ImportMnemonic
+func (c Connector) generateSeed(ctx context.Context) ([]byte, error) {
+	mnemonic, err := hdwallet.NewMnemonic()
+	if err != nil {
+		errMessage := "failed to generate hd wallet mnemonic"
+		c.logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	seed, err := hdwallet.SeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		errMessage := "failed to generate hd wallet seed"
+		c.logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	err = c.setSecret(ctx, mnemonicID(c.hdWallet.SeedID), mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.setSeed(ctx, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return seed, nil
+}
+
+func (c Connector) setSeed(ctx context.Context, seed []byte) error {
+	return c.setSecret(ctx, c.hdWallet.SeedID, hexutil.Encode(seed))
+}
+
+func (c Connector) setSecret(ctx context.Context, id, value string) error {
+	secret, err := c.hdWallet.SecretStore.Set(ctx, id, value, &entities.Attributes{})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.hdWallet.SecretsDB.Add(ctx, secret)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func mnemonicID(seedID string) string {
+	return fmt.Sprintf("%s-mnemonic", seedID)
+}
+
+func derivedKeyID(seedID string, index uint32) string {
+	return fmt.Sprintf("%s-%d", seedID, index)
+}
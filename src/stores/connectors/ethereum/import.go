@@ -27,6 +27,12 @@ func (c Connector) Import(ctx context.Context, id string, privKey []byte, attr *
 		return nil, err
 	}
 
+	return c.importKey(ctx, id, privKey, attr)
+}
+
+func (c Connector) importKey(ctx context.Context, id string, privKey []byte, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	logger := c.logger.With("id", id)
+
 	key, err := c.store.Import(ctx, id, privKey, ethAlgo, attr)
 	if err != nil && errors.IsAlreadyExistsError(err) {
 		key, err = c.store.Get(ctx, id)
@@ -0,0 +1,92 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Upper bounds of the key derivation parameters accepted from an imported keystore, the parameters are chosen by the
+// caller and drive the CPU and memory cost of the decryption. They allow the standard and light geth parameters
+const (
+	maxScryptN      = keystore.StandardScryptN
+	maxScryptR      = 8
+	maxScryptCost   = maxScryptN * maxScryptR * keystore.StandardScryptP
+	maxPBKDF2Rounds = 1 << 18
+	keystoreDKLen   = 32
+)
+
+type keystoreKDF struct {
+	Crypto struct {
+		KDF       string `json:"kdf"`
+		KDFParams struct {
+			N     int `json:"n"`
+			R     int `json:"r"`
+			P     int `json:"p"`
+			C     int `json:"c"`
+			DKLen int `json:"dklen"`
+		} `json:"kdfparams"`
+	} `json:"crypto"`
+}
+
+func (c Connector) ImportKeystore(ctx context.Context, id string, keyJSON []byte, passphrase string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	logger := c.logger.With("id", id)
+	logger.Debug("importing ethereum account from keystore")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkKeystoreKDF(keyJSON)
+	if err != nil {
+		errMessage := "invalid keystore"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError("%s: %s", errMessage, err.Error())
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		errMessage := "failed to decrypt keystore"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError("%s: %s", errMessage, err.Error())
+	}
+
+	return c.importKey(ctx, id, crypto.FromECDSA(key.PrivateKey), attr)
+}
+
+// checkKeystoreKDF rejects V3 keystores whose key derivation parameters exceed the ones used by standard wallets
+func checkKeystoreKDF(keyJSON []byte) error {
+	ks := &keystoreKDF{}
+	err := json.Unmarshal(keyJSON, ks)
+	if err != nil {
+		return err
+	}
+
+	params := ks.Crypto.KDFParams
+	if params.DKLen != keystoreDKLen {
+		return fmt.Errorf("derived key length must be %d", keystoreDKLen)
+	}
+
+	switch strings.ToLower(ks.Crypto.KDF) {
+	case "scrypt":
+		if params.N <= 0 || params.N > maxScryptN || params.R <= 0 || params.R > maxScryptR || params.P <= 0 || params.P > maxScryptCost/(params.N*params.R) {
+			return fmt.Errorf("scrypt parameters must not exceed n=%d, r=%d and n*r*p=%d", maxScryptN, maxScryptR, maxScryptCost)
+		}
+	case "pbkdf2":
+		if params.C <= 0 || params.C > maxPBKDF2Rounds {
+			return fmt.Errorf("pbkdf2 rounds must not exceed %d", maxPBKDF2Rounds)
+		}
+	default:
+		return fmt.Errorf("unsupported key derivation function %q", ks.Crypto.KDF)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	"github.com/consensys/quorum-key-manager/src/stores/database/models"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportKeystore(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+	acc := testutils2.FakeETHAccount()
+	key := testutils2.FakeKey()
+	attributes := testutils2.FakeAttributes()
+	key.ID = acc.KeyID
+	acc.Tags = attributes.Tags
+	privKey := hexutil.MustDecode("0xdb337ca3295e4050586793f252e641f3b3a83739018fa4cce01a81ca920e7e1c")
+	passphrase := "my-passphrase"
+
+	ecdsaKey, err := crypto.ToECDSA(privKey)
+	require.NoError(t, err)
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(ecdsaKey.PublicKey), PrivateKey: ecdsaKey}, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should import eth account from keystore successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		store.EXPECT().Import(gomock.Any(), key.ID, privKey, ethAlgo, attributes).Return(key, nil)
+		db.EXPECT().Add(gomock.Any(), models.NewETHAccountFromKey(key, attributes)).Return(acc, nil)
+
+		rAcc, err := connector.ImportKeystore(ctx, key.ID, keyJSON, passphrase, attributes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, acc, rAcc)
+	})
+
+	t.Run("should fail with same error if authorization fails, without decrypting the keystore", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(expectedErr)
+
+		_, err := connector.ImportKeystore(ctx, key.ID, []byte("invalid keystore"), passphrase, attributes)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if the passphrase is wrong", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+
+		_, err := connector.ImportKeystore(ctx, key.ID, keyJSON, "wrong-passphrase", attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the scrypt parameters are too costly", func(t *testing.T) {
+		costlyJSON := bytes.Replace(keyJSON, []byte(`"n":4096`), []byte(`"n":1048576`), 1)
+		require.NotEqual(t, keyJSON, costlyJSON)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+
+		_, err := connector.ImportKeystore(ctx, key.ID, costlyJSON, passphrase, attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the key derivation function is unknown", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+
+		_, err := connector.ImportKeystore(ctx, key.ID, []byte(`{"crypto":{"kdf":"argon2","kdfparams":{"dklen":32}}}`), passphrase, attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
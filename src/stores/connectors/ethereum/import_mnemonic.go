@@ -0,0 +1,78 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/hdwallet"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) ImportMnemonic(ctx context.Context, mnemonic, passphrase string, numAccounts uint32, attr *entities.Attributes) ([]*entities.ETHAccount, error) {
+	logger := c.logger.With("num_accounts", numAccounts)
+	logger.Debug("importing mnemonic")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.hdWallet == nil {
+		errMessage := "ethereum store is not an HD wallet"
+		logger.Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	seed, err := hdwallet.SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		errMessage := "invalid mnemonic"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	accs := []*entities.ETHAccount{}
+	err = c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
+		next, derr := dbtx.NextDerivationIndex(ctx)
+		if derr != nil {
+			return derr
+		}
+
+		currentSeed, derr := c.getSeed(ctx)
+		if derr != nil {
+			return derr
+		}
+
+		switch {
+		case currentSeed == nil:
+			derr = c.setSeed(ctx, seed)
+			if derr != nil {
+				return derr
+			}
+		case !bytes.Equal(currentSeed, seed):
+			errMessage := "a different seed is already set for this HD wallet"
+			logger.Error(errMessage)
+			return errors.AlreadyExistsError(errMessage)
+		}
+
+		// Accounts below the next derivation index have already been derived
+		for index := next; index < numAccounts; index++ {
+			acc, derr := c.importDerived(ctx, dbtx, seed, derivedKeyID(c.hdWallet.SeedID, index), index, attr)
+			if derr != nil {
+				return derr
+			}
+
+			accs = append(accs, acc)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.With("imported", len(accs)).Info("mnemonic imported successfully")
+	return accs, nil
+}
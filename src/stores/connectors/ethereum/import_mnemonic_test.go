@@ -0,0 +1,139 @@
+package eth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/hdwallet"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testSeedID   = "my-seed"
+)
+
+func TestImportMnemonic(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	attributes := testutils2.FakeAttributes()
+	seed, _ := hdwallet.SeedFromMnemonic(testMnemonic, "")
+	path, _ := hdwallet.ParseDerivationPath("")
+
+	store := mock.NewMockKeyStore(ctrl)
+	secretStore := mock.NewMockSecretStore(ctrl)
+	secretsDB := mock2.NewMockSecrets(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	hdWallet := &HDWallet{SecretStore: secretStore, SecretsDB: secretsDB, SeedID: testSeedID, Path: path}
+	connector := NewHDWalletConnector(store, hdWallet, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
+		return persist(db)
+	}).AnyTimes()
+
+	t.Run("should set the seed and derive the accounts successfully", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+		secret.Value = hexutil.Encode(seed)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().NextDerivationIndex(gomock.Any()).Return(uint32(0), nil)
+		secretStore.EXPECT().Get(gomock.Any(), testSeedID, "").Return(nil, errors.NotFoundError("error"))
+		secretStore.EXPECT().Set(gomock.Any(), testSeedID, hexutil.Encode(seed), gomock.Any()).Return(secret, nil)
+		secretsDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+		for _, keyID := range []string{testSeedID + "-0", testSeedID + "-1"} {
+			store.EXPECT().Import(gomock.Any(), keyID, gomock.Any(), ethAlgo, attributes).DoAndReturn(fakeImportKey)
+		}
+		db.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, acc *entities2.ETHAccount) (*entities2.ETHAccount, error) {
+			return acc, nil
+		}).Times(2)
+
+		accs, err := connector.ImportMnemonic(ctx, testMnemonic, "", 2, attributes)
+
+		require.NoError(t, err)
+		require.Len(t, accs, 2)
+		assert.Equal(t, common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"), accs[0].Address)
+		assert.Equal(t, uint32(0), *accs[0].DerivationIndex)
+		assert.Equal(t, common.HexToAddress("0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"), accs[1].Address)
+		assert.Equal(t, uint32(1), *accs[1].DerivationIndex)
+	})
+
+	t.Run("should only derive the accounts that were not derived yet", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+		secret.Value = hexutil.Encode(seed)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().NextDerivationIndex(gomock.Any()).Return(uint32(1), nil)
+		secretStore.EXPECT().Get(gomock.Any(), testSeedID, "").Return(secret, nil)
+		store.EXPECT().Import(gomock.Any(), testSeedID+"-1", gomock.Any(), ethAlgo, attributes).DoAndReturn(fakeImportKey)
+		db.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, acc *entities2.ETHAccount) (*entities2.ETHAccount, error) {
+			return acc, nil
+		})
+
+		accs, err := connector.ImportMnemonic(ctx, testMnemonic, "", 2, attributes)
+
+		require.NoError(t, err)
+		require.Len(t, accs, 1)
+		assert.Equal(t, uint32(1), *accs[0].DerivationIndex)
+	})
+
+	t.Run("should fail with AlreadyExistsError if a different seed is set", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+		secret.Value = hexutil.Encode([]byte("another seed"))
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+		db.EXPECT().NextDerivationIndex(gomock.Any()).Return(uint32(0), nil)
+		secretStore.EXPECT().Get(gomock.Any(), testSeedID, "").Return(secret, nil)
+
+		_, err := connector.ImportMnemonic(ctx, testMnemonic, "", 2, attributes)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the mnemonic is invalid", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+
+		_, err := connector.ImportMnemonic(ctx, "abandon abandon abandon", "", 2, attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with NotSupportedError if the store is not an HD wallet", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceEthAccount}).Return(nil)
+
+		_, err := NewConnector(store, db, auth, logger).ImportMnemonic(ctx, testMnemonic, "", 2, attributes)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+}
+
+func fakeImportKey(_ context.Context, id string, privKey []byte, _ interface{}, attr *entities2.Attributes) (*entities2.Key, error) {
+	ecdsaKey, err := crypto.ToECDSA(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key := testutils2.FakeKey()
+	key.ID = id
+	key.PublicKey = crypto.FromECDSAPub(&ecdsaKey.PublicKey)
+	key.Tags = attr.Tags
+	return key, nil
+}
@@ -9,9 +9,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	policyentities "github.com/consensys/quorum-key-manager/src/policies/entities"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
 	quorumtypes "github.com/consensys/quorum/core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -29,6 +31,11 @@ var (
 func (c Connector) Sign(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
 	logger := c.logger.With("address", addr.Hex())
 
+	err := c.checkRawSigning(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
 	signature, err := c.sign(ctx, addr, crypto.Keccak256(data))
 	if err != nil {
 		return nil, err
@@ -52,7 +59,12 @@ func (c Connector) SignMessage(ctx context.Context, addr common.Address, data []
 
 func (c Connector) SignTypedDataHash(ctx context.Context, addr common.Address, typedDataHash []byte) ([]byte, error) {
 	logger := c.logger.With("address", addr)
-	
+
+	err := c.checkRawSigning(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
 	signature, err := c.signHomestead(ctx, addr, typedDataHash)
 	if err != nil {
 		return nil, err
@@ -84,6 +96,12 @@ func (c Connector) SignTypedData(ctx context.Context, addr common.Address, typed
 func (c Connector) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
 	logger := c.logger.With("address", addr.Hex())
 
+	policyTx := newPolicyTx(chainID, tx)
+	err := c.checkPolicies(ctx, addr, policyTx)
+	if err != nil {
+		return nil, err
+	}
+
 	signer := types.NewLondonSigner(chainID)
 	txData := signer.Hash(tx).Bytes()
 
@@ -106,6 +124,11 @@ func (c Connector) SignTransaction(ctx context.Context, addr common.Address, cha
 		return nil, errors.EncodingError(errMessage)
 	}
 
+	err = c.spendPolicies(ctx, addr, policyTx)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("transaction signed successfully")
 	return signedRaw, nil
 }
@@ -113,6 +136,12 @@ func (c Connector) SignTransaction(ctx context.Context, addr common.Address, cha
 func (c Connector) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
 	logger := c.logger.With("address", addr.Hex())
 
+	policyTx := newPolicyTx(chainID, tx)
+	err := c.checkPolicies(ctx, addr, policyTx)
+	if err != nil {
+		return nil, err
+	}
+
 	privateFromEncoded, err := base64.StdEncoding.DecodeString(*args.PrivateFrom)
 	if err != nil {
 		errMessage := "invalid 'privateFrom'"
@@ -180,13 +209,33 @@ func (c Connector) SignEEA(ctx context.Context, addr common.Address, chainID *bi
 		return nil, errors.EncodingError(errMessage)
 	}
 
+	err = c.spendPolicies(ctx, addr, policyTx)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("EEA transaction signed successfully")
 	return signedRaw, nil
 }
 
-func (c Connector) SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error) {
+func (c Connector) SignPrivate(ctx context.Context, addr common.Address, chainID *big.Int, tx *quorumtypes.Transaction) ([]byte, error) {
 	logger := c.logger.With("address", addr.Hex())
 
+	policyTx := &policyentities.Transaction{
+		ChainID:  chainID,
+		Nonce:    tx.Nonce(),
+		To:       tx.To(),
+		Value:    tx.Value(),
+		Data:     tx.Data(),
+		Gas:      tx.Gas(),
+		GasPrice: tx.GasPrice(),
+		Private:  true,
+	}
+	err := c.checkPolicies(ctx, addr, policyTx)
+	if err != nil {
+		return nil, err
+	}
+
 	signer := quorumtypes.QuorumPrivateTxSigner{}
 	txData := signer.Hash(tx).Bytes()
 	signature, err := c.sign(ctx, addr, txData)
@@ -208,6 +257,11 @@ func (c Connector) SignPrivate(ctx context.Context, addr common.Address, tx *quo
 		return nil, errors.EncodingError(errMessage)
 	}
 
+	err = c.spendPolicies(ctx, addr, policyTx)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("private transaction signed successfully")
 	return signedRaw, nil
 }
@@ -225,6 +279,11 @@ func (c Connector) sign(ctx context.Context, addr common.Address, data []byte) (
 		return nil, err
 	}
 
+	err = connectors.CheckUsable(c.logger.With("address", acc.Address.Hex()), "ethereum account", acc.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	signature, err := c.store.Sign(ctx, acc.KeyID, data, ethAlgo)
 	if err != nil {
 		return nil, err
@@ -251,6 +310,57 @@ func (c Connector) sign(ctx context.Context, addr common.Address, data []byte) (
 	return nil, errors.DependencyFailureError(errMessage)
 }
 
+// checkPolicies verifies that a transaction complies with the signing policies applying to the account before signing it
+func (c Connector) checkPolicies(ctx context.Context, addr common.Address, tx *policyentities.Transaction) error {
+	if c.policies == nil {
+		return nil
+	}
+
+	// Policies are only disclosed to users allowed to sign
+	err := c.authorizator.CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount})
+	if err != nil {
+		return err
+	}
+
+	return c.policies.CheckTransaction(ctx, c.storeName, addr, tx)
+}
+
+// checkRawSigning rejects the signature of raw data and hashes by accounts with policies, as they could be transactions
+func (c Connector) checkRawSigning(ctx context.Context, addr common.Address) error {
+	if c.policies == nil {
+		return nil
+	}
+
+	// Policies are only disclosed to users allowed to sign
+	err := c.authorizator.CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount})
+	if err != nil {
+		return err
+	}
+
+	return c.policies.CheckRawSigning(ctx, c.storeName, addr)
+}
+
+// spendPolicies records the value of a signed transaction against the daily limits of the account, the signature being discarded if a limit is exceeded
+func (c Connector) spendPolicies(ctx context.Context, addr common.Address, tx *policyentities.Transaction) error {
+	if c.policies == nil {
+		return nil
+	}
+
+	return c.policies.SpendTransaction(ctx, c.storeName, addr, tx)
+}
+
+func newPolicyTx(chainID *big.Int, tx *types.Transaction) *policyentities.Transaction {
+	return &policyentities.Transaction{
+		ChainID:  chainID,
+		Nonce:    tx.Nonce(),
+		To:       tx.To(),
+		Value:    tx.Value(),
+		Data:     tx.Data(),
+		Gas:      tx.Gas(),
+		GasPrice: tx.GasFeeCap(),
+	}
+}
+
 func eeaHash(object interface{}) (hash common.Hash, err error) {
 	hashAlgo := sha3.NewLegacyKeccak256()
 	err = rlp.Encode(hashAlgo, object)
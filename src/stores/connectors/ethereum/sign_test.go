@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	common2 "github.com/consensys/quorum-key-manager/pkg/common"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	policyentities "github.com/consensys/quorum-key-manager/src/policies/entities"
+	policiesmock "github.com/consensys/quorum-key-manager/src/policies/mock"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
@@ -73,6 +77,30 @@ func TestSignMessage(t *testing.T) {
 		assert.Equal(t, expectedSignature, hexutil.Encode(signature))
 	})
 
+	t.Run("should fail with StatusConflictError if account is disabled", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+		acc.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+
+		_, err := connector.SignMessage(ctx, acc.Address, data)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with StatusConflictError if account is expired", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+		acc.Metadata.ExpireAt = time.Now().Add(-time.Minute)
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+
+		_, err := connector.SignMessage(ctx, acc.Address, data)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
 	t.Run("should fail to sign if address is not recoverable", func(t *testing.T) {
 		R, _ := new(big.Int).SetString("63341e2c837449de3735b6f4402b154aa0a118d02e45a2b311fba39c444025dd", 16)
 		S, _ := new(big.Int).SetString("39db7699cb3d8a5caf7728a87e778c2cdccc4085cf2a346e37c1823dec5ce2ed", 16)
@@ -125,6 +153,60 @@ func TestSignMessage(t *testing.T) {
 	})
 }
 
+func TestSignRawData(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := []byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%v", 2, string(hexutil.MustDecode("0xfeaa"))))
+	violationErr := errors.PolicyViolationError("limits", "raw_signing", "error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+	policies := policiesmock.NewMockPolicies(ctrl)
+
+	connector := NewConnector(store, db, auth, testutils.NewMockLogger(ctrl)).WithPolicies("my-store", policies)
+
+	t.Run("should sign raw data of an account without policies", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+		acc.PublicKey = hexutil.MustDecode("0x04e2e7621c0c08e43905648be731a482e8eb3d3186023335812f52130e4a18dd729b22d88fbf0f22b8fa4390267ef0c54367dc638a25b38ea74290bdb9f79ff917")
+		ecdsaSignature := hexutil.MustDecode("0xe276fd7524ed7af67b7f914de5be16fad6b9038009d2d78f2315351fbd48deee57a897964e80e041c674942ef4dbd860cb79a6906fb965d5e4645f5c44f7eae4")
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil).Times(2)
+		policies.EXPECT().CheckRawSigning(ctx, "my-store", acc.Address).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		store.EXPECT().Sign(gomock.Any(), acc.KeyID, crypto.Keccak256(data), ethAlgo).Return(ecdsaSignature, nil)
+
+		signature, err := connector.Sign(ctx, acc.Address, data)
+
+		require.NoError(t, err)
+		assert.Len(t, signature, 65)
+	})
+
+	t.Run("should fail with same error without signing raw data if a policy applies to the account", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		policies.EXPECT().CheckRawSigning(ctx, "my-store", acc.Address).Return(violationErr)
+
+		_, err := connector.Sign(ctx, acc.Address, data)
+
+		assert.Equal(t, violationErr, err)
+	})
+
+	t.Run("should fail with same error without signing a typed data hash if a policy applies to the account", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		policies.EXPECT().CheckRawSigning(ctx, "my-store", acc.Address).Return(violationErr)
+
+		_, err := connector.SignTypedDataHash(ctx, acc.Address, crypto.Keccak256(data))
+
+		assert.Equal(t, violationErr, err)
+	})
+}
+
 func TestSignTransaction(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
@@ -202,6 +284,53 @@ func TestSignTransaction(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 		assert.Nil(t, signedRaw)
 	})
+
+	t.Run("should check the policies before signing and spend the value once signed", func(t *testing.T) {
+		policies := policiesmock.NewMockPolicies(ctrl)
+		policyConnector := connector.WithPolicies("my-store", policies)
+		policyTx := newPolicyTx(chainID, tx)
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil).Times(2)
+		gomock.InOrder(
+			policies.EXPECT().CheckTransaction(ctx, "my-store", acc.Address, policyTx).Return(nil),
+			store.EXPECT().Sign(ctx, acc.KeyID, types.NewEIP155Signer(chainID).Hash(tx).Bytes(), ethAlgo).Return(ecdsaSignature, nil),
+			policies.EXPECT().SpendTransaction(ctx, "my-store", acc.Address, policyTx).Return(nil),
+		)
+		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+
+		signedRaw, err := policyConnector.SignTransaction(ctx, acc.Address, chainID, tx)
+		assert.NoError(t, err)
+		assert.NotNil(t, signedRaw)
+	})
+
+	t.Run("should fail with same error without signing if a policy is violated", func(t *testing.T) {
+		policies := policiesmock.NewMockPolicies(ctrl)
+		policyConnector := connector.WithPolicies("my-store", policies)
+		violationErr := errors.PolicyViolationError("my-policy", "max_value", "error")
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		policies.EXPECT().CheckTransaction(ctx, "my-store", acc.Address, gomock.Any()).Return(violationErr)
+
+		signedRaw, err := policyConnector.SignTransaction(ctx, acc.Address, chainID, tx)
+		assert.Equal(t, violationErr, err)
+		assert.Nil(t, signedRaw)
+	})
+
+	t.Run("should fail with same error if the daily value limit is reached once signed", func(t *testing.T) {
+		policies := policiesmock.NewMockPolicies(ctrl)
+		policyConnector := connector.WithPolicies("my-store", policies)
+		violationErr := errors.PolicyViolationError("my-policy", "max_daily_value", "error")
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil).Times(2)
+		policies.EXPECT().CheckTransaction(ctx, "my-store", acc.Address, gomock.Any()).Return(nil)
+		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+		store.EXPECT().Sign(ctx, acc.KeyID, gomock.Any(), ethAlgo).Return(ecdsaSignature, nil)
+		policies.EXPECT().SpendTransaction(ctx, "my-store", acc.Address, gomock.Any()).Return(violationErr)
+
+		signedRaw, err := policyConnector.SignTransaction(ctx, acc.Address, chainID, tx)
+		assert.Equal(t, violationErr, err)
+		assert.Nil(t, signedRaw)
+	})
 }
 
 func TestSignPrivate(t *testing.T) {
@@ -227,6 +356,7 @@ func TestSignPrivate(t *testing.T) {
 		big.NewInt(0),
 		nil,
 	)
+	chainID := big.NewInt(1)
 	ecdsaSignature := hexutil.MustDecode("0x80365b013992519479ddd83584039d66851da560dbbe67f59ab9bdcd97b6250355e93d2c8050fb413956298c10eb7b8b2c8d76f4be261e458e4987cc5fed9f01")
 
 	t.Run("should sign a payload successfully with appended V value", func(t *testing.T) {
@@ -234,7 +364,7 @@ func TestSignPrivate(t *testing.T) {
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID, quorumtypes.QuorumPrivateTxSigner{}.Hash(tx).Bytes(), ethAlgo).Return(ecdsaSignature, nil)
 
-		signedRaw, err := connector.SignPrivate(ctx, acc.Address, tx)
+		signedRaw, err := connector.SignPrivate(ctx, acc.Address, chainID, tx)
 		assert.NoError(t, err)
 		assert.Equal(t, "0xf85d80808094905b88eff8bda1543d4d6f4aa05afef143d27e18808026a080365b013992519479ddd83584039d66851da560dbbe67f59ab9bdcd97b62503a055e93d2c8050fb413956298c10eb7b8b2c8d76f4be261e458e4987cc5fed9f01", hexutil.Encode(signedRaw))
 	})
@@ -242,7 +372,7 @@ func TestSignPrivate(t *testing.T) {
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(expectedErr)
 
-		signedRaw, err := connector.SignPrivate(ctx, acc.Address, tx)
+		signedRaw, err := connector.SignPrivate(ctx, acc.Address, chainID, tx)
 		assert.Equal(t, expectedErr, err)
 		assert.Nil(t, signedRaw)
 	})
@@ -251,7 +381,7 @@ func TestSignPrivate(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(nil, expectedErr)
 
-		signedRaw, err := connector.SignPrivate(ctx, acc.Address, tx)
+		signedRaw, err := connector.SignPrivate(ctx, acc.Address, chainID, tx)
 		assert.Equal(t, expectedErr, err)
 		assert.Nil(t, signedRaw)
 	})
@@ -261,10 +391,37 @@ func TestSignPrivate(t *testing.T) {
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID, gomock.Any(), ethAlgo).Return(nil, expectedErr)
 
-		signedRaw, err := connector.SignPrivate(ctx, acc.Address, tx)
+		signedRaw, err := connector.SignPrivate(ctx, acc.Address, chainID, tx)
 		assert.Equal(t, expectedErr, err)
 		assert.Nil(t, signedRaw)
 	})
+
+	t.Run("should check the policies on the chain the transaction is sent to", func(t *testing.T) {
+		policies := policiesmock.NewMockPolicies(ctrl)
+		policyConnector := connector.WithPolicies("my-store", policies)
+		policyTx := &policyentities.Transaction{
+			ChainID:  chainID,
+			Nonce:    tx.Nonce(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+			Gas:      tx.Gas(),
+			GasPrice: tx.GasPrice(),
+			Private:  true,
+		}
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil).Times(2)
+		gomock.InOrder(
+			policies.EXPECT().CheckTransaction(ctx, "my-store", acc.Address, policyTx).Return(nil),
+			store.EXPECT().Sign(ctx, acc.KeyID, quorumtypes.QuorumPrivateTxSigner{}.Hash(tx).Bytes(), ethAlgo).Return(ecdsaSignature, nil),
+			policies.EXPECT().SpendTransaction(ctx, "my-store", acc.Address, policyTx).Return(nil),
+		)
+		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+
+		signedRaw, err := policyConnector.SignPrivate(ctx, acc.Address, chainID, tx)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, signedRaw)
+	})
 }
 
 func TestSignEEA(t *testing.T) {
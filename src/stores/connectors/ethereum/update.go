@@ -2,6 +2,7 @@ package eth
 
 import (
 	"context"
+	"time"
 
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 
@@ -25,6 +26,11 @@ func (c Connector) Update(ctx context.Context, addr ethcommon.Address, attr *ent
 		return nil, err
 	}
 	acc.Tags = attr.Tags
+	if attr.ClearExpireAt {
+		acc.Metadata.ExpireAt = time.Time{}
+	} else if !attr.ExpireAt.IsZero() {
+		acc.Metadata.ExpireAt = attr.ExpireAt
+	}
 
 	err = c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
 		acc, err = dbtx.Update(ctx, acc)
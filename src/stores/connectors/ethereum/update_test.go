@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
@@ -12,6 +13,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -54,6 +56,25 @@ func TestUpdateKey(t *testing.T) {
 		assert.Equal(t, rAcc, acc)
 	})
 
+	t.Run("should clear the expiry date of an ethAccount", func(t *testing.T) {
+		expiringAcc := testutils2.FakeETHAccount()
+		expiringAcc.Metadata.ExpireAt = time.Now().Add(time.Hour)
+		clearAttributes := &entities.Attributes{Tags: attributes.Tags, ClearExpireAt: true}
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), expiringAcc.Address.Hex()).Return(expiringAcc, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *entities.ETHAccount) (*entities.ETHAccount, error) {
+			assert.True(t, a.Metadata.ExpireAt.IsZero())
+			return a, nil
+		})
+		store.EXPECT().Update(gomock.Any(), expiringAcc.KeyID, clearAttributes).Return(testutils2.FakeKey(), nil)
+
+		rAcc, err := connector.Update(ctx, expiringAcc.Address, clearAttributes)
+
+		assert.NoError(t, err)
+		assert.True(t, rAcc.Metadata.ExpireAt.IsZero())
+	})
+
 	t.Run("should update key successfully, ignoring not supported error", func(t *testing.T) {
 		rErr := errors.NotSupportedError("not supported")
 
@@ -35,6 +35,10 @@ func (c Connector) Create(ctx context.Context, id string, alg *entities2.Algorit
 		return nil, err
 	}
 
+	if !attr.ExpireAt.IsZero() {
+		key.Metadata.ExpireAt = attr.ExpireAt
+	}
+
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
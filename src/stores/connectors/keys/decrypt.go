@@ -3,18 +3,35 @@ package keys
 import (
 	"context"
 
-	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
 )
 
-func (c Connector) Decrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+func (c Connector) Decrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	logger := c.logger.With("id", id)
 
-	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey})
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionEncrypt, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := c.store.Decrypt(ctx, id, data)
+	err = connectors.CheckUsable(c.logger.With("id", key.ID), "key", key.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo == nil {
+		algo = key.Algo
+	}
+
+	result, err := c.store.Decrypt(ctx, id, data, algo)
 	if err != nil {
 		return nil, err
 	}
@@ -16,15 +16,16 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestDecryptKey(t *testing.T) {
+func TestDecrypt(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	key := testutils2.FakeKey()
-	expectedErr := fmt.Errorf("error")
+	algo := testutils2.FakeAlgorithm()
 	data := []byte("0x123")
 	result := []byte("0x456")
+	key := testutils2.FakeKey()
+	expectedErr := fmt.Errorf("error")
 
 	store := mock.NewMockKeyStore(ctrl)
 	db := mock2.NewMockKeys(ctrl)
@@ -35,9 +36,21 @@ func TestDecryptKey(t *testing.T) {
 
 	t.Run("should decrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
-		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(result, nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Decrypt(gomock.Any(), key.ID, data, algo).Return(result, nil)
+
+		rResult, err := connector.Decrypt(ctx, key.ID, data, algo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rResult, result)
+	})
+
+	t.Run("should decrypt data with key algo successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(ctx, key.ID).Return(key, nil)
+		store.EXPECT().Decrypt(ctx, key.ID, data, key.Algo).Return(result, nil)
 
-		rResult, err := connector.Decrypt(ctx, key.ID, data)
+		rResult, err := connector.Decrypt(ctx, key.ID, data, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, rResult, result)
@@ -46,7 +59,7 @@ func TestDecryptKey(t *testing.T) {
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(expectedErr)
 
-		_, err := connector.Decrypt(ctx, key.ID, data)
+		_, err := connector.Decrypt(ctx, key.ID, data, algo)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
@@ -54,9 +67,20 @@ func TestDecryptKey(t *testing.T) {
 
 	t.Run("should fail to decrypt data if decrypt fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
-		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Decrypt(gomock.Any(), key.ID, data, algo).Return(nil, expectedErr)
+
+		_, err := connector.Decrypt(ctx, key.ID, data, algo)
+
+		assert.Error(t, err)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail to decrypt data if db fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, expectedErr)
 
-		_, err := connector.Decrypt(ctx, key.ID, data)
+		_, err := connector.Decrypt(ctx, key.ID, data, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
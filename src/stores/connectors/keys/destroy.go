@@ -6,6 +6,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 )
 
@@ -23,6 +24,16 @@ func (c Connector) Destroy(ctx context.Context, id string) error {
 		return err
 	}
 
+	// Stores keeping each version as a distinct key must destroy the previous versions too, which only the DB knows about
+	var versions []string
+	versionDestroyer, destroyVersions := c.store.(stores.KeyVersionDestroyer)
+	if destroyVersions {
+		versions, err = c.db.ListVersions(ctx, id)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
 		err = dbtx.Purge(ctx, id)
 		if err != nil {
@@ -34,6 +45,13 @@ func (c Connector) Destroy(ctx context.Context, id string) error {
 			return err
 		}
 
+		for _, version := range versions {
+			err = versionDestroyer.DestroyVersion(ctx, id, version)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 
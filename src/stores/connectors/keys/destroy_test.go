@@ -62,6 +62,26 @@ func TestDestroyKey(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should destroy key and its previous versions successfully", func(t *testing.T) {
+		versionDestroyer := mock.NewMockKeyVersionDestroyer(ctrl)
+		versionsConnector := NewConnector(&struct {
+			*mock.MockKeyStore
+			*mock.MockKeyVersionDestroyer
+		}{store, versionDestroyer}, db, auth, logger)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().ListVersions(gomock.Any(), key.ID).Return([]string{"1", "2"}, nil)
+		db.EXPECT().Purge(gomock.Any(), key.ID).Return(nil)
+		store.EXPECT().Destroy(gomock.Any(), key.ID).Return(nil)
+		versionDestroyer.EXPECT().DestroyVersion(gomock.Any(), key.ID, "1").Return(nil)
+		versionDestroyer.EXPECT().DestroyVersion(gomock.Any(), key.ID, "2").Return(nil)
+
+		err := versionsConnector.Destroy(ctx, key.ID)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceKey}).Return(expectedErr)
 
@@ -0,0 +1,57 @@
+package keys
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+)
+
+func (c Connector) Enable(ctx context.Context, id string) error {
+	return c.setDisabled(ctx, id, false)
+}
+
+func (c Connector) Disable(ctx context.Context, id string) error {
+	return c.setDisabled(ctx, id, true)
+}
+
+func (c Connector) setDisabled(ctx context.Context, id string, disabled bool) error {
+	logger := c.logger.With("id", id, "disabled", disabled)
+	logger.Debug("updating key status")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceKey})
+	if err != nil {
+		return err
+	}
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	key.Metadata.Disabled = disabled
+
+	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
+		_, derr := dbtx.Update(ctx, key)
+		if derr != nil {
+			return derr
+		}
+
+		if disabled {
+			derr = c.store.Disable(ctx, id)
+		} else {
+			derr = c.store.Enable(ctx, id)
+		}
+		if derr != nil && !errors.IsNotSupportedError(derr) { // If the underlying store does not support disabling, we only disable in DB
+			return derr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("key status updated successfully")
+	return nil
+}
@@ -0,0 +1,94 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should disable key successfully, ignoring not supported error", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities2.Key) (*entities2.Key, error) {
+			assert.True(t, k.Metadata.Disabled)
+			return k, nil
+		})
+		store.EXPECT().Disable(gomock.Any(), key.ID).Return(errors.NotSupportedError("error"))
+
+		err := connector.Disable(ctx, key.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should enable key successfully", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities2.Key) (*entities2.Key, error) {
+			assert.False(t, k.Metadata.Disabled)
+			return k, nil
+		})
+		store.EXPECT().Enable(gomock.Any(), key.ID).Return(nil)
+
+		err := connector.Enable(ctx, key.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		err := connector.Disable(ctx, "my-key")
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if store fails", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).Return(key, nil)
+		store.EXPECT().Disable(gomock.Any(), key.ID).Return(expectedErr)
+
+		err := connector.Disable(ctx, key.ID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
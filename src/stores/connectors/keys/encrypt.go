@@ -3,18 +3,43 @@ package keys
 import (
 	"context"
 
-	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
 )
 
-func (c Connector) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+func (c Connector) Encrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	logger := c.logger.With("id", id)
 
-	err := c.authorizator.CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey})
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionEncrypt, Resource: authentities.ResourceKey})
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := c.store.Encrypt(ctx, id, data)
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = connectors.CheckUsable(c.logger.With("id", key.ID), "key", key.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo == nil {
+		algo = key.Algo
+	}
+
+	var result []byte
+	if encrypter, ok := c.store.(stores.PublicKeyEncrypter); ok {
+		// Encrypting from the stored public key does not require any access to the private key
+		result, err = encrypter.EncryptWithPublicKey(key.PublicKey, data, algo)
+	} else {
+		result, err = c.store.Encrypt(ctx, id, data, algo)
+	}
 	if err != nil {
 		return nil, err
 	}
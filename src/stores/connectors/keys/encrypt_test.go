@@ -21,6 +21,7 @@ func TestEncrypt(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	algo := testutils2.FakeAlgorithm()
 	data := []byte("0x123")
 	result := []byte("0x456")
 	key := testutils2.FakeKey()
@@ -35,9 +36,38 @@ func TestEncrypt(t *testing.T) {
 
 	t.Run("should encrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(result, nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, algo).Return(result, nil)
 
-		rResult, err := connector.Encrypt(ctx, key.ID, data)
+		rResult, err := connector.Encrypt(ctx, key.ID, data, algo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rResult, result)
+	})
+
+	t.Run("should encrypt data with key algo successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(ctx, key.ID).Return(key, nil)
+		store.EXPECT().Encrypt(ctx, key.ID, data, key.Algo).Return(result, nil)
+
+		rResult, err := connector.Encrypt(ctx, key.ID, data, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rResult, result)
+	})
+
+	t.Run("should encrypt data with the stored public key successfully", func(t *testing.T) {
+		encrypter := mock.NewMockPublicKeyEncrypter(ctrl)
+		pubKeyConnector := NewConnector(&struct {
+			*mock.MockKeyStore
+			*mock.MockPublicKeyEncrypter
+		}{store, encrypter}, db, auth, logger)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(ctx, key.ID).Return(key, nil)
+		encrypter.EXPECT().EncryptWithPublicKey(key.PublicKey, data, algo).Return(result, nil)
+
+		rResult, err := pubKeyConnector.Encrypt(ctx, key.ID, data, algo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, rResult, result)
@@ -46,7 +76,7 @@ func TestEncrypt(t *testing.T) {
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(expectedErr)
 
-		_, err := connector.Encrypt(ctx, key.ID, data)
+		_, err := connector.Encrypt(ctx, key.ID, data, algo)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
@@ -54,9 +84,20 @@ func TestEncrypt(t *testing.T) {
 
 	t.Run("should fail to encrypt data if encrypt fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
-		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Encrypt(gomock.Any(), key.ID, data, algo).Return(nil, expectedErr)
+
+		_, err := connector.Encrypt(ctx, key.ID, data, algo)
+
+		assert.Error(t, err)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail to encrypt data if db fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, expectedErr)
 
-		_, err := connector.Encrypt(ctx, key.ID, data)
+		_, err := connector.Encrypt(ctx, key.ID, data, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
@@ -0,0 +1,35 @@
+package keys
+
+import (
+	"context"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
+)
+
+func (c Connector) Export(ctx context.Context, id string) ([]byte, error) {
+	logger := c.logger.With("id", id)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionExport, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = connectors.CheckUsable(c.logger.With("id", key.ID), "key", key.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := c.store.Export(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("key exported successfully")
+	return privKey, nil
+}
@@ -0,0 +1,72 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	privKey := []byte("my-private-key")
+	key := testutils2.FakeKey()
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should export key successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+
+		rPrivKey, err := connector.Export(ctx, key.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, privKey, rPrivKey)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Export(ctx, key.ID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if key is not found", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, expectedErr)
+
+		_, err := connector.Export(ctx, key.ID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if export fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionExport, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Export(gomock.Any(), key.ID).Return(nil, expectedErr)
+
+		_, err := connector.Export(ctx, key.ID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
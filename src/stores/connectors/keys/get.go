@@ -41,3 +41,20 @@ func (c Connector) GetDeleted(ctx context.Context, id string) (*entities.Key, er
 	logger.Debug("deleted key retrieved successfully")
 	return key, nil
 }
+
+func (c Connector) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	logger := c.logger.With("id", id, "version", version)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.GetVersion(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("key version retrieved successfully")
+	return key, nil
+}
@@ -105,3 +105,46 @@ func TestGetDeletedKey(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 }
+
+func TestGetKeyVersion(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key := testutils2.FakeKey()
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should get key version successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetVersion(gomock.Any(), key.ID, "1").Return(key, nil)
+
+		rKey, err := connector.GetVersion(ctx, key.ID, "1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, key, rKey)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.GetVersion(ctx, key.ID, "1")
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail to get key version if db fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetVersion(gomock.Any(), key.ID, "1").Return(nil, expectedErr)
+
+		_, err := connector.GetVersion(ctx, key.ID, "1")
+
+		assert.Equal(t, err, expectedErr)
+	})
+}
@@ -41,6 +41,10 @@ func (c Connector) Import(ctx context.Context, id string, privKey []byte, alg *e
 		return nil, err
 	}
 
+	if !attr.ExpireAt.IsZero() {
+		key.Metadata.ExpireAt = attr.ExpireAt
+	}
+
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
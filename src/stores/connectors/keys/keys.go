@@ -13,6 +13,7 @@ type Connector struct {
 	db           database.Keys
 	logger       log.Logger
 	authorizator auth.Authorizator
+	ethAccounts  []database.ETHAccounts
 }
 
 var _ stores.KeyStore = Connector{}
@@ -26,11 +27,21 @@ func NewConnector(store stores.KeyStore, db database.Keys, authorizator auth.Aut
 	}
 }
 
+// WithETHAccounts sets the accounts of the ethereum stores backed by the key store, whose keys must not be rotated
+func (c *Connector) WithETHAccounts(ethAccounts []database.ETHAccounts) *Connector {
+	c.ethAccounts = ethAccounts
+	return c
+}
+
 func isSupportedAlgo(alg *entities.Algorithm) bool {
 	if alg.Type == entities.Ecdsa && alg.EllipticCurve == entities.Secp256k1 {
 		return true
 	}
 
+	if alg.Type == entities.Ecdsa && alg.EllipticCurve == entities.Secp256r1 {
+		return true
+	}
+
 	if alg.Type == entities.Eddsa && alg.EllipticCurve == entities.Babyjubjub {
 		return true
 	}
@@ -39,5 +50,9 @@ func isSupportedAlgo(alg *entities.Algorithm) bool {
 		return true
 	}
 
+	if alg.Type == entities.Bls && alg.EllipticCurve == entities.Bls12381 {
+		return true
+	}
+
 	return false
 }
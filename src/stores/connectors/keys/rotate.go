@@ -0,0 +1,139 @@
+package keys
+
+import (
+	"context"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (c Connector) Rotate(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	logger := c.logger.With("id", id)
+	logger.Debug("rotating key")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if alg == nil {
+		alg = previous.Algo
+	} else if alg.Type != previous.Algo.Type || alg.EllipticCurve != previous.Algo.EllipticCurve {
+		errMessage := "the signing algorithm and elliptic curve of a key cannot be changed by a rotation"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	if attr == nil {
+		attr = &entities.Attributes{Tags: previous.Tags}
+	}
+
+	err = c.checkNotETHAccount(ctx, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous.Metadata.Version == "" {
+		// Keys created before versioning was supported do not have their version recorded, the store knows it
+		if current.Metadata.Version == "" {
+			errMessage := "the current version of the key is unknown and could not be kept"
+			logger.Error(errMessage)
+			return nil, errors.NotSupportedError(errMessage)
+		}
+		previous.Metadata.Version = current.Metadata.Version
+	} else if current.Metadata.Version != "" && current.Metadata.Version != previous.Metadata.Version {
+		// A previous rotation succeeded in the store but could not be recorded, it is recorded before rotating again
+		logger.With("version", current.Metadata.Version).Warn("recording a previous rotation of the key")
+		previous, err = c.recordRotation(ctx, previous, current)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rotated, err := c.store.Rotate(ctx, id, alg, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.recordRotation(ctx, previous, rotated)
+	if err != nil {
+		logger.With("version", rotated.Metadata.Version).WithError(err).Error("key rotated in the store but failed to be recorded, it is recorded on the next rotation")
+		return nil, err
+	}
+
+	logger.With("version", key.Metadata.Version).Info("key rotated successfully")
+	return key, nil
+}
+
+// recordRotation keeps the previous version of a key and replaces it by the version rotated in the store
+func (c Connector) recordRotation(ctx context.Context, previous, rotated *entities.Key) (*entities.Key, error) {
+	var key *entities.Key
+	err := c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
+		derr := dbtx.AddVersion(ctx, previous)
+		if derr != nil {
+			return derr
+		}
+
+		previous.PublicKey = rotated.PublicKey
+		previous.Tags = rotated.Tags
+		previous.Metadata.Version = rotated.Metadata.Version
+		previous.Metadata.UpdatedAt = rotated.Metadata.UpdatedAt
+
+		key, derr = dbtx.Update(ctx, previous)
+		return derr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// checkNotETHAccount rejects keys backing an ethereum account, as a rotation would change the address of the account
+func (c Connector) checkNotETHAccount(ctx context.Context, key *entities.Key) error {
+	if key.Algo == nil || key.Algo.Type != entities2.Ecdsa || key.Algo.EllipticCurve != entities2.Secp256k1 {
+		return nil
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(key.PublicKey)
+	if err != nil {
+		return nil
+	}
+	addr := crypto.PubkeyToAddress(*pubKey).Hex()
+
+	for _, db := range c.ethAccounts {
+		_, err = db.Get(ctx, addr)
+		if err != nil && errors.IsNotFoundError(err) {
+			_, err = db.GetDeleted(ctx, addr)
+		}
+		if err != nil && errors.IsNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		errMessage := "key is used by an ethereum account and cannot be rotated"
+		c.logger.With("id", key.ID, "address", addr).Error(errMessage)
+		return errors.StatusConflictError(errMessage)
+	}
+
+	return nil
+}
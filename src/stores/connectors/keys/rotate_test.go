@@ -0,0 +1,236 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities3 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should rotate key successfully and keep the previous version", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		previousPubKey := key.PublicKey
+		rotatedKey := testutils2.FakeKey()
+		rotatedKey.ID = key.ID
+		rotatedKey.PublicKey = []byte("new-public-key")
+		rotatedKey.Metadata.Version = "2"
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(testutils2.FakeKey(), nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo, &entities3.Attributes{Tags: key.Tags}).Return(rotatedKey, nil)
+		db.EXPECT().AddVersion(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) error {
+			assert.Equal(t, "1", k.Metadata.Version)
+			assert.Equal(t, previousPubKey, k.PublicKey)
+			return nil
+		})
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) (*entities3.Key, error) {
+			return k, nil
+		})
+
+		rKey, err := connector.Rotate(ctx, key.ID, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2", rKey.Metadata.Version)
+		assert.Equal(t, rotatedKey.PublicKey, rKey.PublicKey)
+	})
+
+	t.Run("should fail with InvalidParameterError if the algorithm is changed", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+
+		_, err := connector.Rotate(ctx, key.ID, &entities2.Algorithm{Type: entities2.Eddsa, EllipticCurve: entities2.Babyjubjub}, nil)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Rotate(ctx, "my-key", nil, nil)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should keep the previous version resolved from the store if unknown", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.Metadata.Version = ""
+		current := testutils2.FakeKey()
+		current.Metadata.Version = "0"
+		rotatedKey := testutils2.FakeKey()
+		rotatedKey.Metadata.Version = "2"
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(current, nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo, gomock.Any()).Return(rotatedKey, nil)
+		db.EXPECT().AddVersion(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) error {
+			assert.Equal(t, "0", k.Metadata.Version)
+			return nil
+		})
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) (*entities3.Key, error) {
+			return k, nil
+		})
+
+		rKey, err := connector.Rotate(ctx, key.ID, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2", rKey.Metadata.Version)
+	})
+
+	t.Run("should fail with NotSupportedError if the previous version cannot be resolved", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.Metadata.Version = ""
+		current := testutils2.FakeKey()
+		current.Metadata.Version = ""
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(current, nil)
+
+		_, err := connector.Rotate(ctx, key.ID, nil, nil)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+
+	t.Run("should fail with StatusConflictError if the key is used by an ethereum account", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		acc := testutils2.FakeETHAccount()
+		ethDB := mock2.NewMockETHAccounts(ctrl)
+		ethConnector := NewConnector(store, db, auth, logger).WithETHAccounts([]database.ETHAccounts{ethDB})
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		ethDB.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+
+		_, err := ethConnector.Rotate(ctx, key.ID, nil, nil)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should rotate key if no ethereum account uses it", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		acc := testutils2.FakeETHAccount()
+		ethDB := mock2.NewMockETHAccounts(ctrl)
+		ethConnector := NewConnector(store, db, auth, logger).WithETHAccounts([]database.ETHAccounts{ethDB})
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		ethDB.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(nil, errors.NotFoundError("error"))
+		ethDB.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(nil, errors.NotFoundError("error"))
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(testutils2.FakeKey(), nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo, gomock.Any()).Return(testutils2.FakeKey(), nil)
+		db.EXPECT().AddVersion(gomock.Any(), key).Return(nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) (*entities3.Key, error) {
+			return k, nil
+		})
+
+		_, err := ethConnector.Rotate(ctx, key.ID, nil, nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if store fails, without recording a version", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(testutils2.FakeKey(), nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo, gomock.Any()).Return(nil, expectedErr)
+
+		_, err := connector.Rotate(ctx, key.ID, nil, nil)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with same error if the rotation fails to be recorded", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		rotatedKey := testutils2.FakeKey()
+		rotatedKey.Metadata.Version = "2"
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(testutils2.FakeKey(), nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo, gomock.Any()).Return(rotatedKey, nil)
+		db.EXPECT().AddVersion(gomock.Any(), key).Return(expectedErr)
+
+		_, err := connector.Rotate(ctx, key.ID, nil, nil)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should record a previous rotation that failed to be recorded before rotating again", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		current := testutils2.FakeKey()
+		current.PublicKey = []byte("unrecorded-public-key")
+		current.Metadata.Version = "2"
+		rotatedKey := testutils2.FakeKey()
+		rotatedKey.PublicKey = []byte("new-public-key")
+		rotatedKey.Metadata.Version = "3"
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Get(gomock.Any(), key.ID).Return(current, nil)
+		gomock.InOrder(
+			db.EXPECT().AddVersion(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) error {
+				assert.Equal(t, "1", k.Metadata.Version)
+				return nil
+			}),
+			db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) (*entities3.Key, error) {
+				assert.Equal(t, "2", k.Metadata.Version)
+				assert.Equal(t, current.PublicKey, k.PublicKey)
+				return k, nil
+			}),
+			store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo, gomock.Any()).Return(rotatedKey, nil),
+			db.EXPECT().AddVersion(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) error {
+				assert.Equal(t, "2", k.Metadata.Version)
+				assert.Equal(t, current.PublicKey, k.PublicKey)
+				return nil
+			}),
+			db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities3.Key) (*entities3.Key, error) {
+				return k, nil
+			}),
+		)
+
+		rKey, err := connector.Rotate(ctx, key.ID, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "3", rKey.Metadata.Version)
+		assert.Equal(t, rotatedKey.PublicKey, rKey.PublicKey)
+	})
+}
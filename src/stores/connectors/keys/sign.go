@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
 
 	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
 )
@@ -16,12 +17,17 @@ func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entit
 		return nil, err
 	}
 
-	if algo == nil {
-		key, derr := c.db.Get(ctx, id)
-		if derr != nil {
-			return nil, derr
-		}
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
+	err = connectors.CheckUsable(c.logger.With("id", key.ID), "key", key.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo == nil {
 		algo = key.Algo
 	}
 
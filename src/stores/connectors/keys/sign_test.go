@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
@@ -36,6 +38,7 @@ func TestSignKey(t *testing.T) {
 
 	t.Run("should sign data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(result, nil)
 
 		rResult, err := connector.Sign(ctx, key.ID, data, algo)
@@ -55,6 +58,30 @@ func TestSignKey(t *testing.T) {
 		assert.Equal(t, rResult, result)
 	})
 
+	t.Run("should fail with StatusConflictError if key is disabled", func(t *testing.T) {
+		disabledKey := testutils2.FakeKey()
+		disabledKey.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), disabledKey.ID).Return(disabledKey, nil)
+
+		_, err := connector.Sign(ctx, disabledKey.ID, data, algo)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with StatusConflictError if key is expired", func(t *testing.T) {
+		expiredKey := testutils2.FakeKey()
+		expiredKey.Metadata.ExpireAt = time.Now().Add(-time.Hour)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), expiredKey.ID).Return(expiredKey, nil)
+
+		_, err := connector.Sign(ctx, expiredKey.ID, data, algo)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(expectedErr)
 
@@ -66,6 +93,7 @@ func TestSignKey(t *testing.T) {
 
 	t.Run("should fail to sign data if sign fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(nil, expectedErr)
 
 		_, err := connector.Sign(ctx, key.ID, data, algo)
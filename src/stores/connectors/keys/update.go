@@ -2,6 +2,7 @@ package keys
 
 import (
 	"context"
+	"time"
 
 	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
 
@@ -25,6 +26,11 @@ func (c Connector) Update(ctx context.Context, id string, attr *entities.Attribu
 		return nil, err
 	}
 	key.Tags = attr.Tags
+	if attr.ClearExpireAt {
+		key.Metadata.ExpireAt = time.Time{}
+	} else if !attr.ExpireAt.IsZero() {
+		key.Metadata.ExpireAt = attr.ExpireAt
+	}
 
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
 		key, err = dbtx.Update(ctx, key)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
@@ -12,6 +13,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -54,6 +56,25 @@ func TestUpdateKey(t *testing.T) {
 		assert.Equal(t, rKey, updatedKey)
 	})
 
+	t.Run("should clear the expiry date of a key", func(t *testing.T) {
+		expiringKey := testutils2.FakeKey()
+		expiringKey.Metadata.ExpireAt = time.Now().Add(time.Hour)
+		clearAttributes := &entities2.Attributes{Tags: attributes.Tags, ClearExpireAt: true}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), expiringKey.ID).Return(expiringKey, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities2.Key) (*entities2.Key, error) {
+			assert.True(t, k.Metadata.ExpireAt.IsZero())
+			return k, nil
+		})
+		store.EXPECT().Update(gomock.Any(), expiringKey.ID, clearAttributes).Return(expiringKey, nil)
+
+		rKey, err := connector.Update(ctx, expiringKey.ID, clearAttributes)
+
+		assert.NoError(t, err)
+		assert.True(t, rKey.Metadata.ExpireAt.IsZero())
+	})
+
 	t.Run("should update key successfully, ignoring not supported error", func(t *testing.T) {
 		rErr := errors.NotSupportedError("not supported")
 
@@ -0,0 +1,72 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+)
+
+func (c Connector) Enable(ctx context.Context, id string) error {
+	return c.setDisabled(ctx, id, false)
+}
+
+func (c Connector) Disable(ctx context.Context, id string) error {
+	return c.setDisabled(ctx, id, true)
+}
+
+// setDisabled updates the status of all the versions of a secret
+func (c Connector) setDisabled(ctx context.Context, id string, disabled bool) error {
+	logger := c.logger.With("id", id, "disabled", disabled)
+	logger.Debug("updating secret status")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceSecret})
+	if err != nil {
+		return err
+	}
+
+	versions, err := c.db.ListVersions(ctx, id, false)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		errMessage := "secret not found"
+		logger.Error(errMessage)
+		return errors.NotFoundError(errMessage)
+	}
+
+	err = c.db.RunInTransaction(ctx, func(dbtx database.Secrets) error {
+		for _, version := range versions {
+			secret, derr := dbtx.Get(ctx, id, version)
+			if derr != nil {
+				return derr
+			}
+			secret.Metadata.Disabled = disabled
+
+			_, derr = dbtx.Update(ctx, secret)
+			if derr != nil {
+				return derr
+			}
+		}
+
+		var derr error
+		if disabled {
+			derr = c.store.Disable(ctx, id)
+		} else {
+			derr = c.store.Enable(ctx, id)
+		}
+		if derr != nil && !errors.IsNotSupportedError(derr) { // If the underlying store does not support disabling, we only disable in DB
+			return derr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("secret status updated successfully")
+	return nil
+}
@@ -0,0 +1,93 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableSecret(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockSecrets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Secrets) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should disable all the versions of a secret successfully", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().ListVersions(gomock.Any(), secret.ID, false).Return([]string{"1", "2"}, nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, "1").Return(testutils2.FakeSecret(), nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, "2").Return(testutils2.FakeSecret(), nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *entities2.Secret) (*entities2.Secret, error) {
+			assert.True(t, s.Metadata.Disabled)
+			return s, nil
+		}).Times(2)
+		store.EXPECT().Disable(gomock.Any(), secret.ID).Return(errors.NotSupportedError("error"))
+
+		err := connector.Disable(ctx, secret.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should enable a secret successfully", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+		secret.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().ListVersions(gomock.Any(), secret.ID, false).Return([]string{secret.Metadata.Version}, nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
+		db.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *entities2.Secret) (*entities2.Secret, error) {
+			assert.False(t, s.Metadata.Disabled)
+			return s, nil
+		})
+		store.EXPECT().Enable(gomock.Any(), secret.ID).Return(nil)
+
+		err := connector.Enable(ctx, secret.ID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with NotFoundError if the secret has no version", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().ListVersions(gomock.Any(), "my-secret", false).Return([]string{}, nil)
+
+		err := connector.Disable(ctx, "my-secret")
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(expectedErr)
+
+		err := connector.Disable(ctx, "my-secret")
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
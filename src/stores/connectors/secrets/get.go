@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
 
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
@@ -31,6 +32,11 @@ func (c Connector) Get(ctx context.Context, id, version string) (*entities.Secre
 		return nil, err
 	}
 
+	err = connectors.CheckUsable(c.logger.With("id", secret.ID, "version", secret.Metadata.Version), "secret", secret.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	secretVault, err := c.store.Get(ctx, id, version)
 	if err != nil {
 		return nil, err
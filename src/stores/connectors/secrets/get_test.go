@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
@@ -61,6 +63,30 @@ func TestGetSecret(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 
+	t.Run("should fail with StatusConflictError if secret is disabled", func(t *testing.T) {
+		disabledSecret := testutils2.FakeSecret()
+		disabledSecret.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), disabledSecret.ID, disabledSecret.Metadata.Version).Return(disabledSecret, nil)
+
+		_, err := connector.Get(ctx, disabledSecret.ID, disabledSecret.Metadata.Version)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with StatusConflictError if secret is expired", func(t *testing.T) {
+		expiredSecret := testutils2.FakeSecret()
+		expiredSecret.Metadata.ExpireAt = time.Now().Add(-time.Minute)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), expiredSecret.ID, expiredSecret.Metadata.Version).Return(expiredSecret, nil)
+
+		_, err := connector.Get(ctx, expiredSecret.ID, expiredSecret.Metadata.Version)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
 	t.Run("should fail to get secret value", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
@@ -27,6 +27,10 @@ func (c Connector) Set(ctx context.Context, id, value string, attr *entities.Att
 		return nil, err
 	}
 
+	if !attr.ExpireAt.IsZero() {
+		secret.Metadata.ExpireAt = attr.ExpireAt
+	}
+
 	_, err = c.db.Add(ctx, secret)
 	if err != nil {
 		return nil, err
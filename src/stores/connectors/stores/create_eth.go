@@ -3,12 +3,15 @@ package stores
 import (
 	"context"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/hdwallet"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	eth "github.com/consensys/quorum-key-manager/src/stores/connectors/ethereum"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
 
-func (c *Connector) CreateEthereum(ctx context.Context, name, keyStore string, allowedTenants []string, userInfo *auth.UserInfo) error {
+func (c *Connector) CreateEthereum(ctx context.Context, name, keyStore string, hdWallet *entities.HDWallet, allowedTenants []string, userInfo *auth.UserInfo) error {
 	logger := c.logger.With("name", name, "key_store", keyStore)
 	logger.Debug("creating ethereum store")
 
@@ -21,7 +24,29 @@ func (c *Connector) CreateEthereum(ctx context.Context, name, keyStore string, a
 		return err
 	}
 
-	c.createStore(name, entities.EthereumStoreType, store, allowedTenants)
+	var wallet *eth.HDWallet
+	if hdWallet != nil {
+		secretStore, err := c.getSecretStore(ctx, hdWallet.SecretStore, resolver)
+		if err != nil {
+			return err
+		}
+
+		path, err := hdwallet.ParseDerivationPath(hdWallet.DerivationPath)
+		if err != nil {
+			errMessage := "invalid derivation path"
+			logger.With("derivation_path", hdWallet.DerivationPath).WithError(err).Error(errMessage)
+			return errors.InvalidParameterError(errMessage)
+		}
+
+		wallet = &eth.HDWallet{
+			SecretStore: secretStore,
+			SecretsDB:   c.db.Secrets(hdWallet.SecretStore),
+			SeedID:      hdWallet.SeedID,
+			Path:        path,
+		}
+	}
+
+	c.createStore(name, entities.EthereumStoreType, &ethStore{keyStore: store, hdWallet: wallet}, allowedTenants)
 
 	logger.Info("ethereum store created successfully")
 	return nil
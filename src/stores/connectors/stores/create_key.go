@@ -8,12 +8,17 @@ import (
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 	akvinfra "github.com/consensys/quorum-key-manager/src/infra/akv"
 	awsinfra "github.com/consensys/quorum-key-manager/src/infra/aws"
+	gcpinfra "github.com/consensys/quorum-key-manager/src/infra/gcp"
 	hashicorpinfra "github.com/consensys/quorum-key-manager/src/infra/hashicorp"
+	pkcs11infra "github.com/consensys/quorum-key-manager/src/infra/pkcs11"
 	"github.com/consensys/quorum-key-manager/src/stores"
 
 	"github.com/consensys/quorum-key-manager/src/stores/store/keys/akv"
 	"github.com/consensys/quorum-key-manager/src/stores/store/keys/aws"
+	"github.com/consensys/quorum-key-manager/src/stores/store/keys/gcp"
 	"github.com/consensys/quorum-key-manager/src/stores/store/keys/hashicorp"
+	"github.com/consensys/quorum-key-manager/src/stores/store/keys/pkcs11"
+	"github.com/consensys/quorum-key-manager/src/stores/store/keys/transit"
 
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	localkeys "github.com/consensys/quorum-key-manager/src/stores/store/keys/local"
@@ -21,7 +26,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 )
 
-func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore string, allowedTenants []string, userInfo *authtypes.UserInfo) error {
+func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore string, envelopeEncryption *entities.EnvelopeEncryption, allowedTenants []string, userInfo *authtypes.UserInfo) error {
 	logger := c.logger.With("name", name, "vault", vaultName, "secret_store", secretStore)
 	logger.Debug("creating key store")
 
@@ -31,6 +36,12 @@ func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore
 		return errors.InvalidParameterError(errMessage)
 	}
 
+	if vaultName != "" && envelopeEncryption != nil {
+		errMessage := "envelope encryption is only supported by key stores backed by a secret store"
+		logger.Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
 	// TODO: Uncomment when authManager no longer a runnable
 	// permissions := c.authManager.UserPermissions(userInfo)
 	resolver := authorizator.New(userInfo.Permissions, userInfo.Tenant, c.logger)
@@ -46,11 +57,19 @@ func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore
 
 		switch vault.VaultType {
 		case entities2.HashicorpVaultType:
-			store, err = hashicorp.New(vault.Client.(hashicorpinfra.PluginClient), logger), nil
+			if vault.Client.(hashicorpinfra.Client).Engine() == entities2.HashicorpTransitEngine {
+				store, err = transit.New(vault.Client.(hashicorpinfra.TransitClient), logger), nil
+			} else {
+				store, err = hashicorp.New(vault.Client.(hashicorpinfra.PluginClient), logger), nil
+			}
 		case entities2.AzureVaultType:
 			store, err = akv.New(vault.Client.(akvinfra.KeysClient), logger), nil
 		case entities2.AWSVaultType:
 			store, err = aws.New(vault.Client.(awsinfra.KmsClient), logger), nil
+		case entities2.GCPVaultType:
+			store, err = gcp.New(vault.Client.(gcpinfra.KmsClient), logger), nil
+		case entities2.PKCS11VaultType:
+			store, err = pkcs11.New(vault.Client.(pkcs11infra.KeysClient), logger), nil
 		default:
 			errMessage := "invalid vault for key store"
 			logger.Error(errMessage)
@@ -65,7 +84,31 @@ func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore
 			return err
 		}
 
-		store = localkeys.New(secretstore, c.db.Secrets(secretStore), c.logger)
+		if envelopeEncryption == nil {
+			store = localkeys.New(secretstore, c.db.Secrets(secretStore), c.logger)
+			break
+		}
+
+		kekStore, err := c.getKeyStore(ctx, envelopeEncryption.KeyStore, resolver)
+		if err != nil {
+			return err
+		}
+
+		// Wrapping data keys with a key kept in the same secret engine would not protect against its compromise
+		if _, ok := kekStore.(*localkeys.Store); ok {
+			errMessage := "key encryption key store must be backed by a vault"
+			logger.Error(errMessage, "key_store", envelopeEncryption.KeyStore)
+			return errors.InvalidParameterError(errMessage)
+		}
+
+		kekAlgo, err := localkeys.CheckKeyEncryptionKey(ctx, kekStore, envelopeEncryption.KeyID)
+		if err != nil {
+			errMessage := "invalid key encryption key"
+			logger.With("key_store", envelopeEncryption.KeyStore, "key_id", envelopeEncryption.KeyID).WithError(err).Error(errMessage)
+			return errors.InvalidParameterError("%s: %s", errMessage, err.Error())
+		}
+
+		store = localkeys.NewWithEnvelopeEncryption(secretstore, c.db.Secrets(secretStore), kekStore, envelopeEncryption.KeyID, kekAlgo, c.logger)
 	default:
 		errMessage := "either vault or secret store must be specified. Please choose one option"
 		logger.Error(errMessage)
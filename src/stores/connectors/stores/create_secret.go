@@ -7,11 +7,16 @@ import (
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 	akvinfra "github.com/consensys/quorum-key-manager/src/infra/akv"
 	awsinfra "github.com/consensys/quorum-key-manager/src/infra/aws"
+	gcpinfra "github.com/consensys/quorum-key-manager/src/infra/gcp"
 	hashicorpinfra "github.com/consensys/quorum-key-manager/src/infra/hashicorp"
+	localinfra "github.com/consensys/quorum-key-manager/src/infra/local"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/akv"
 	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/aws"
+	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/gcp"
 	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/hashicorp"
+	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/kvv1"
+	localsecrets "github.com/consensys/quorum-key-manager/src/stores/store/secrets/local"
 
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 
@@ -30,11 +35,32 @@ func (c *Connector) CreateSecret(ctx context.Context, name, vaultName string, al
 	var store stores.SecretStore
 	switch vault.VaultType {
 	case entities2.HashicorpVaultType:
-		store, err = hashicorp.New(vault.Client.(hashicorpinfra.Kvv2Client), c.db.Secrets(name), logger), nil
+		cli := vault.Client.(hashicorpinfra.Client)
+		if cli.Engine() == entities2.HashicorpTransitEngine {
+			errMessage := "Hashicorp vault using the transit engine cannot be used for secret stores"
+			logger.Error(errMessage)
+			return errors.InvalidParameterError(errMessage)
+		}
+
+		kvVersion, derr := cli.KVVersion()
+		if derr != nil {
+			logger.WithError(derr).Warn("failed to detect KV engine version, assuming version 2")
+			kvVersion = 2
+		}
+
+		if kvVersion == 1 {
+			store, err = kvv1.New(cli, logger), nil
+		} else {
+			store, err = hashicorp.New(cli, c.db.Secrets(name), logger), nil
+		}
 	case entities2.AzureVaultType:
 		store, err = akv.New(vault.Client.(akvinfra.SecretClient), logger), nil
 	case entities2.AWSVaultType:
 		store, err = aws.New(vault.Client.(awsinfra.SecretsManagerClient), logger), nil
+	case entities2.GCPVaultType:
+		store, err = gcp.New(vault.Client.(gcpinfra.SecretManagerClient), logger), nil
+	case entities2.LocalVaultType:
+		store, err = localsecrets.New(vault.Client.(localinfra.SecretsClient), logger), nil
 	default:
 		errMessage := "invalid vault for secret store"
 		logger.Error(errMessage)
@@ -26,7 +26,11 @@ func (c *Connector) Ethereum(ctx context.Context, storeName string, userInfo *au
 	}
 
 	c.logger.Debug("ethereum store found successfully", "store_name", storeName)
-	return eth.NewConnector(store, c.db.ETHAccounts(storeName), resolver, c.logger), nil
+	if store.hdWallet != nil {
+		return eth.NewHDWalletConnector(store.keyStore, store.hdWallet, c.db.ETHAccounts(storeName), resolver, c.logger).WithPolicies(storeName, c.policies), nil
+	}
+
+	return eth.NewConnector(store.keyStore, c.db.ETHAccounts(storeName), resolver, c.logger).WithPolicies(storeName, c.policies), nil
 }
 
 func (c *Connector) EthereumByAddr(ctx context.Context, addr common.Address, userInfo *authtypes.UserInfo) (stores.EthStore, error) {
@@ -60,7 +64,13 @@ func (c *Connector) EthereumByAddr(ctx context.Context, addr common.Address, use
 	return nil, errors.NotFoundError(errMessage)
 }
 
-func (c *Connector) getEthStore(ctx context.Context, storeName string, resolver auth.Authorizator) (stores.KeyStore, error) {
+// ethStore is the registry entry of an ethereum store, with the HD wallet set if accounts are derived from a seed
+type ethStore struct {
+	keyStore stores.KeyStore
+	hdWallet *eth.HDWallet
+}
+
+func (c *Connector) getEthStore(ctx context.Context, storeName string, resolver auth.Authorizator) (*ethStore, error) {
 	storeInfo, err := c.getStore(ctx, storeName, resolver)
 	if err != nil {
 		return nil, err
@@ -72,5 +82,5 @@ func (c *Connector) getEthStore(ctx context.Context, storeName string, resolver
 		return nil, errors.NotFoundError(errMessage)
 	}
 
-	return storeInfo.Store.(stores.KeyStore), nil
+	return storeInfo.Store.(*ethStore), nil
 }
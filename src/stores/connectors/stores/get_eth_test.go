@@ -24,7 +24,7 @@ func TestGetEthereum(t *testing.T) {
 	auth := mock3.NewMockRoles(ctrl)
 	vaults := mock4.NewMockVaults(ctrl)
 
-	connector := NewConnector(auth, db, vaults, logger)
+	connector := NewConnector(auth, db, vaults, nil, logger)
 
 	t.Run("should fail with not found ethereum store successfully", func(t *testing.T) {
 		storeName := "not-found-store"
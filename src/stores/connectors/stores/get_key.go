@@ -12,6 +12,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
 )
 
 func (c *Connector) Key(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.KeyStore, error) {
@@ -24,7 +25,22 @@ func (c *Connector) Key(ctx context.Context, storeName string, userInfo *authtyp
 	}
 
 	c.logger.Debug("key store found successfully", "store_name", storeName)
-	return keys.NewConnector(store, c.db.Keys(storeName), resolver, c.logger), nil
+	return keys.NewConnector(store, c.db.Keys(storeName), resolver, c.logger).WithETHAccounts(c.ethAccountsOf(store)), nil
+}
+
+// ethAccountsOf returns the accounts of the ethereum stores backed by the given key store
+func (c *Connector) ethAccountsOf(keyStore stores.KeyStore) []database.ETHAccounts {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	var ethAccounts []database.ETHAccounts
+	for name, store := range c.stores {
+		if eth, ok := store.Store.(*ethStore); ok && eth.keyStore == keyStore {
+			ethAccounts = append(ethAccounts, c.db.ETHAccounts(name))
+		}
+	}
+
+	return ethAccounts
 }
 
 func (c *Connector) getKeyStore(ctx context.Context, storeName string, resolver auth.Authorizator) (stores.KeyStore, error) {
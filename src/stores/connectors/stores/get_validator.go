@@ -0,0 +1,24 @@
+package stores
+
+import (
+	"context"
+
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/validators"
+)
+
+// Validator returns a validator store signing with the BLS keys of a key store
+func (c *Connector) Validator(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.ValidatorStore, error) {
+	permissions := c.roles.UserPermissions(ctx, userInfo)
+	resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
+
+	store, err := c.getKeyStore(ctx, storeName, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("validator store found successfully", "store_name", storeName)
+	return validators.NewConnector(store, c.db.Keys(storeName), c.db.SlashingProtection(storeName), resolver, c.logger), nil
+}
@@ -19,10 +19,11 @@ func (c *Connector) ImportEthereum(ctx context.Context, storeName string, userIn
 	// permissions := c.authManager.UserPermissions(userInfo)
 	resolver := authorizator.New(userInfo.Permissions, userInfo.Tenant, c.logger)
 
-	store, err := c.getEthStore(ctx, storeName, resolver)
+	ethStore, err := c.getEthStore(ctx, storeName, resolver)
 	if err != nil {
 		return err
 	}
+	store := ethStore.keyStore
 
 	storeIDs, err := store.List(ctx, 0, 0)
 	if err != nil {
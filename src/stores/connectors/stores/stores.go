@@ -11,29 +11,32 @@ import (
 
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/policies"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 )
 
 type Connector struct {
-	logger log.Logger
-	mux    sync.RWMutex
-	roles  auth.Roles
-	stores map[string]*entities.Store
-	vaults vaults.Vaults
-	db     database.Database
+	logger   log.Logger
+	mux      sync.RWMutex
+	roles    auth.Roles
+	stores   map[string]*entities.Store
+	vaults   vaults.Vaults
+	policies policies.Policies
+	db       database.Database
 }
 
 var _ stores.Stores = &Connector{}
 
-func NewConnector(roles auth.Roles, db database.Database, vaultsService vaults.Vaults, logger log.Logger) *Connector {
+func NewConnector(roles auth.Roles, db database.Database, vaultsService vaults.Vaults, policiesService policies.Policies, logger log.Logger) *Connector {
 	return &Connector{
-		logger: logger,
-		mux:    sync.RWMutex{},
-		roles:  roles,
-		stores: make(map[string]*entities.Store),
-		vaults: vaultsService,
-		db:     db,
+		logger:   logger,
+		mux:      sync.RWMutex{},
+		roles:    roles,
+		stores:   make(map[string]*entities.Store),
+		vaults:   vaultsService,
+		policies: policiesService,
+		db:       db,
 	}
 }
 
@@ -0,0 +1,30 @@
+package validators
+
+import (
+	"context"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) List(ctx context.Context) ([]*entities.Key, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := c.keys.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorKeys := []*entities.Key{}
+	for _, key := range keys {
+		if key.Algo != nil && *key.Algo == *blsAlgo {
+			validatorKeys = append(validatorKeys, key)
+		}
+	}
+
+	c.logger.Debug("validator keys listed successfully")
+	return validatorKeys, nil
+}
@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities3 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListValidators(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorKey := testutils2.FakeKey()
+	validatorKey.Algo = blsAlgo
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	keys := mock2.NewMockKeys(ctrl)
+	db := mock2.NewMockSlashingProtection(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, keys, db, auth, logger)
+
+	t.Run("should list validator keys successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().GetAll(gomock.Any()).Return([]*entities3.Key{testutils2.FakeKey(), validatorKey}, nil)
+
+		rKeys, err := connector.List(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities3.Key{validatorKey}, rKeys)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.List(ctx)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if get all keys fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().GetAll(gomock.Any()).Return(nil, expectedErr)
+
+		_, err := connector.List(ctx)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -0,0 +1,107 @@
+package validators
+
+import (
+	"context"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) SignBlock(ctx context.Context, id string, block *entities.SignedBlock) ([]byte, error) {
+	logger := c.logger.With("id", id, "slot", block.Slot)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionSign, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.getValidatorKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature []byte
+	err = c.db.RunInTransaction(ctx, func(dbtx database.SlashingProtection) error {
+		derr := dbtx.LockValidator(ctx, key.PublicKey)
+		if derr != nil {
+			return derr
+		}
+
+		history, derr := dbtx.ListSignedBlocks(ctx, key.PublicKey)
+		if derr != nil {
+			return derr
+		}
+
+		alreadySigned, derr := checkBlock(history, block)
+		if derr != nil {
+			logger.WithError(derr).Error("refused to sign slashable block")
+			return derr
+		}
+
+		if !alreadySigned {
+			derr = dbtx.AddSignedBlock(ctx, key.PublicKey, block)
+			if derr != nil {
+				return derr
+			}
+		}
+
+		signature, derr = c.store.Sign(ctx, id, block.SigningRoot, key.Algo)
+		return derr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("block signed successfully")
+	return signature, nil
+}
+
+func (c Connector) SignAttestation(ctx context.Context, id string, attestation *entities.SignedAttestation) ([]byte, error) {
+	logger := c.logger.With("id", id, "source_epoch", attestation.SourceEpoch, "target_epoch", attestation.TargetEpoch)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionSign, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.getValidatorKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature []byte
+	err = c.db.RunInTransaction(ctx, func(dbtx database.SlashingProtection) error {
+		derr := dbtx.LockValidator(ctx, key.PublicKey)
+		if derr != nil {
+			return derr
+		}
+
+		history, derr := dbtx.ListSignedAttestations(ctx, key.PublicKey)
+		if derr != nil {
+			return derr
+		}
+
+		alreadySigned, derr := checkAttestation(history, attestation)
+		if derr != nil {
+			logger.WithError(derr).Error("refused to sign slashable attestation")
+			return derr
+		}
+
+		if !alreadySigned {
+			derr = dbtx.AddSignedAttestation(ctx, key.PublicKey, attestation)
+			if derr != nil {
+				return derr
+			}
+		}
+
+		signature, derr = c.store.Sign(ctx, id, attestation.SigningRoot, key.Algo)
+		return derr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("attestation signed successfully")
+	return signature, nil
+}
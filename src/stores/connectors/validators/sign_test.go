@@ -0,0 +1,182 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities3 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignBlock(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key := testutils2.FakeKey()
+	key.Algo = blsAlgo
+	block := &entities3.SignedBlock{Slot: 10, SigningRoot: []byte("signing root")}
+	signature := []byte("signature")
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	keys := mock2.NewMockKeys(ctrl)
+	db := mock2.NewMockSlashingProtection(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, keys, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.SlashingProtection) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should sign block successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().LockValidator(gomock.Any(), key.PublicKey).Return(nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), key.PublicKey).Return([]*entities3.SignedBlock{{Slot: 9}}, nil)
+		db.EXPECT().AddSignedBlock(gomock.Any(), key.PublicKey, block).Return(nil)
+		store.EXPECT().Sign(gomock.Any(), key.ID, block.SigningRoot, key.Algo).Return(signature, nil)
+
+		rSignature, err := connector.SignBlock(ctx, key.ID, block)
+
+		assert.NoError(t, err)
+		assert.Equal(t, signature, rSignature)
+	})
+
+	t.Run("should sign again an already signed block successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().LockValidator(gomock.Any(), key.PublicKey).Return(nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), key.PublicKey).Return([]*entities3.SignedBlock{block}, nil)
+		store.EXPECT().Sign(gomock.Any(), key.ID, block.SigningRoot, key.Algo).Return(signature, nil)
+
+		rSignature, err := connector.SignBlock(ctx, key.ID, block)
+
+		assert.NoError(t, err)
+		assert.Equal(t, signature, rSignature)
+	})
+
+	t.Run("should fail with StatusConflictError if block is slashable", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().LockValidator(gomock.Any(), key.PublicKey).Return(nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), key.PublicKey).Return([]*entities3.SignedBlock{{Slot: block.Slot, SigningRoot: []byte("other root")}}, nil)
+
+		_, err := connector.SignBlock(ctx, key.ID, block)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if key is not a BLS key", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(testutils2.FakeKey(), nil)
+
+		_, err := connector.SignBlock(ctx, key.ID, block)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.SignBlock(ctx, key.ID, block)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if sign fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().LockValidator(gomock.Any(), key.PublicKey).Return(nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), key.PublicKey).Return(nil, nil)
+		db.EXPECT().AddSignedBlock(gomock.Any(), key.PublicKey, block).Return(nil)
+		store.EXPECT().Sign(gomock.Any(), key.ID, block.SigningRoot, key.Algo).Return(nil, expectedErr)
+
+		_, err := connector.SignBlock(ctx, key.ID, block)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestSignAttestation(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key := testutils2.FakeKey()
+	key.Algo = &entities2.Algorithm{Type: entities2.Bls, EllipticCurve: entities2.Bls12381}
+	attestation := &entities3.SignedAttestation{SourceEpoch: 10, TargetEpoch: 11, SigningRoot: []byte("signing root")}
+	signature := []byte("signature")
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	keys := mock2.NewMockKeys(ctrl)
+	db := mock2.NewMockSlashingProtection(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, keys, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.SlashingProtection) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should sign attestation successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().LockValidator(gomock.Any(), key.PublicKey).Return(nil)
+		db.EXPECT().ListSignedAttestations(gomock.Any(), key.PublicKey).Return([]*entities3.SignedAttestation{{SourceEpoch: 9, TargetEpoch: 10}}, nil)
+		db.EXPECT().AddSignedAttestation(gomock.Any(), key.PublicKey, attestation).Return(nil)
+		store.EXPECT().Sign(gomock.Any(), key.ID, attestation.SigningRoot, key.Algo).Return(signature, nil)
+
+		rSignature, err := connector.SignAttestation(ctx, key.ID, attestation)
+
+		assert.NoError(t, err)
+		assert.Equal(t, signature, rSignature)
+	})
+
+	t.Run("should fail with StatusConflictError if attestation is slashable", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().LockValidator(gomock.Any(), key.PublicKey).Return(nil)
+		db.EXPECT().ListSignedAttestations(gomock.Any(), key.PublicKey).Return([]*entities3.SignedAttestation{{SourceEpoch: 9, TargetEpoch: 12}}, nil)
+
+		_, err := connector.SignAttestation(ctx, key.ID, attestation)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with same error if lock fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().LockValidator(gomock.Any(), key.PublicKey).Return(expectedErr)
+
+		_, err := connector.SignAttestation(ctx, key.ID, attestation)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if get key fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		keys.EXPECT().Get(gomock.Any(), key.ID).Return(nil, expectedErr)
+
+		_, err := connector.SignAttestation(ctx, key.ID, attestation)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
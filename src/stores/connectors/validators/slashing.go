@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"bytes"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// checkBlock applies the EIP-3076 slashing protection rules to a block proposal.
+// It returns true if the block has already been signed with the same signing root
+func checkBlock(history []*entities.SignedBlock, block *entities.SignedBlock) (bool, error) {
+	if len(history) == 0 {
+		return false, nil
+	}
+
+	minSlot := history[0].Slot
+	for _, signed := range history {
+		if signed.Slot == block.Slot {
+			if isSameSigningRoot(signed.SigningRoot, block.SigningRoot) {
+				return true, nil
+			}
+
+			return false, errors.StatusConflictError("double block proposal for slot %d", block.Slot)
+		}
+
+		if signed.Slot < minSlot {
+			minSlot = signed.Slot
+		}
+	}
+
+	if block.Slot <= minSlot {
+		return false, errors.StatusConflictError("block slot %d is lower than the minimum signed slot %d", block.Slot, minSlot)
+	}
+
+	return false, nil
+}
+
+// checkAttestation applies the EIP-3076 slashing protection rules to an attestation.
+// It returns true if the attestation has already been signed with the same signing root
+func checkAttestation(history []*entities.SignedAttestation, attestation *entities.SignedAttestation) (bool, error) {
+	if attestation.SourceEpoch > attestation.TargetEpoch {
+		return false, errors.InvalidParameterError("source epoch %d is greater than target epoch %d", attestation.SourceEpoch, attestation.TargetEpoch)
+	}
+
+	if len(history) == 0 {
+		return false, nil
+	}
+
+	minSourceEpoch, minTargetEpoch := history[0].SourceEpoch, history[0].TargetEpoch
+	for _, signed := range history {
+		switch {
+		case signed.TargetEpoch == attestation.TargetEpoch:
+			if isSameSigningRoot(signed.SigningRoot, attestation.SigningRoot) {
+				return true, nil
+			}
+
+			return false, errors.StatusConflictError("double vote for target epoch %d", attestation.TargetEpoch)
+		case signed.SourceEpoch < attestation.SourceEpoch && attestation.TargetEpoch < signed.TargetEpoch:
+			return false, errors.StatusConflictError("attestation is surrounded by a previous attestation (source %d, target %d)", signed.SourceEpoch, signed.TargetEpoch)
+		case attestation.SourceEpoch < signed.SourceEpoch && signed.TargetEpoch < attestation.TargetEpoch:
+			return false, errors.StatusConflictError("attestation surrounds a previous attestation (source %d, target %d)", signed.SourceEpoch, signed.TargetEpoch)
+		}
+
+		if signed.SourceEpoch < minSourceEpoch {
+			minSourceEpoch = signed.SourceEpoch
+		}
+		if signed.TargetEpoch < minTargetEpoch {
+			minTargetEpoch = signed.TargetEpoch
+		}
+	}
+
+	if attestation.SourceEpoch < minSourceEpoch {
+		return false, errors.StatusConflictError("source epoch %d is lower than the minimum signed source epoch %d", attestation.SourceEpoch, minSourceEpoch)
+	}
+	if attestation.TargetEpoch <= minTargetEpoch {
+		return false, errors.StatusConflictError("target epoch %d is lower than the minimum signed target epoch %d", attestation.TargetEpoch, minTargetEpoch)
+	}
+
+	return false, nil
+}
+
+func isSameSigningRoot(signed, root []byte) bool {
+	return len(signed) > 0 && bytes.Equal(signed, root)
+}
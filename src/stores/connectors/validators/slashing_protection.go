@@ -0,0 +1,151 @@
+package validators
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func (c Connector) ImportSlashingProtection(ctx context.Context, interchange *entities.SlashingProtectionInterchange) error {
+	logger := c.logger.With("genesis_validators_root", hexutil.Encode(interchange.GenesisValidatorsRoot))
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceKey})
+	if err != nil {
+		return err
+	}
+
+	err = c.db.RunInTransaction(ctx, func(dbtx database.SlashingProtection) error {
+		root, derr := dbtx.GetGenesisValidatorsRoot(ctx)
+		if derr != nil {
+			return derr
+		}
+
+		switch {
+		case root == nil:
+			derr = dbtx.SetGenesisValidatorsRoot(ctx, interchange.GenesisValidatorsRoot)
+			if derr != nil {
+				return derr
+			}
+		case !bytes.Equal(root, interchange.GenesisValidatorsRoot):
+			errMessage := "genesis validators root does not match the one of the store"
+			logger.Error(errMessage)
+			return errors.InvalidParameterError(errMessage)
+		}
+
+		for _, validator := range interchange.Data {
+			derr = importValidator(ctx, dbtx, validator)
+			if derr != nil {
+				return derr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("slashing protection data imported successfully", "validators", len(interchange.Data))
+	return nil
+}
+
+// importValidator merges the imported signing history of a validator with the existing one, skipping the records already present
+func importValidator(ctx context.Context, dbtx database.SlashingProtection, validator *entities.ValidatorSigningHistory) error {
+	err := dbtx.LockValidator(ctx, validator.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	blocks, err := dbtx.ListSignedBlocks(ctx, validator.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	slots := make(map[uint64]bool)
+	for _, block := range blocks {
+		slots[block.Slot] = true
+	}
+
+	for _, block := range validator.SignedBlocks {
+		if slots[block.Slot] {
+			continue
+		}
+
+		err = dbtx.AddSignedBlock(ctx, validator.PublicKey, block)
+		if err != nil {
+			return err
+		}
+		slots[block.Slot] = true
+	}
+
+	attestations, err := dbtx.ListSignedAttestations(ctx, validator.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	targetEpochs := make(map[uint64]bool)
+	for _, attestation := range attestations {
+		targetEpochs[attestation.TargetEpoch] = true
+	}
+
+	for _, attestation := range validator.SignedAttestations {
+		if targetEpochs[attestation.TargetEpoch] {
+			continue
+		}
+
+		err = dbtx.AddSignedAttestation(ctx, validator.PublicKey, attestation)
+		if err != nil {
+			return err
+		}
+		targetEpochs[attestation.TargetEpoch] = true
+	}
+
+	return nil
+}
+
+func (c Connector) ExportSlashingProtection(ctx context.Context) (*entities.SlashingProtectionInterchange, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := c.db.GetGenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys, err := c.db.ListValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interchange := &entities.SlashingProtectionInterchange{
+		GenesisValidatorsRoot: root,
+		Data:                  []*entities.ValidatorSigningHistory{},
+	}
+	for _, pubKey := range pubKeys {
+		blocks, err := c.db.ListSignedBlocks(ctx, pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		attestations, err := c.db.ListSignedAttestations(ctx, pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		interchange.Data = append(interchange.Data, &entities.ValidatorSigningHistory{
+			PublicKey:          pubKey,
+			SignedBlocks:       blocks,
+			SignedAttestations: attestations,
+		})
+	}
+
+	c.logger.Debug("slashing protection data exported successfully", "validators", len(interchange.Data))
+	return interchange, nil
+}
@@ -0,0 +1,169 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities3 "github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeInterchange() *entities3.SlashingProtectionInterchange {
+	return &entities3.SlashingProtectionInterchange{
+		GenesisValidatorsRoot: []byte("genesis validators root"),
+		Data: []*entities3.ValidatorSigningHistory{
+			{
+				PublicKey: []byte("public key"),
+				SignedBlocks: []*entities3.SignedBlock{
+					{Slot: 10, SigningRoot: []byte("block 10")},
+					{Slot: 11, SigningRoot: []byte("block 11")},
+				},
+				SignedAttestations: []*entities3.SignedAttestation{
+					{SourceEpoch: 1, TargetEpoch: 2, SigningRoot: []byte("attestation 2")},
+					{SourceEpoch: 2, TargetEpoch: 3},
+				},
+			},
+		},
+	}
+}
+
+func TestImportSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interchange := fakeInterchange()
+	validator := interchange.Data[0]
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	keys := mock2.NewMockKeys(ctrl)
+	db := mock2.NewMockSlashingProtection(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, keys, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.SlashingProtection) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should import slashing protection data successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetGenesisValidatorsRoot(gomock.Any()).Return(nil, nil)
+		db.EXPECT().SetGenesisValidatorsRoot(gomock.Any(), interchange.GenesisValidatorsRoot).Return(nil)
+		db.EXPECT().LockValidator(gomock.Any(), validator.PublicKey).Return(nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), validator.PublicKey).Return(nil, nil)
+		db.EXPECT().AddSignedBlock(gomock.Any(), validator.PublicKey, validator.SignedBlocks[0]).Return(nil)
+		db.EXPECT().AddSignedBlock(gomock.Any(), validator.PublicKey, validator.SignedBlocks[1]).Return(nil)
+		db.EXPECT().ListSignedAttestations(gomock.Any(), validator.PublicKey).Return(nil, nil)
+		db.EXPECT().AddSignedAttestation(gomock.Any(), validator.PublicKey, validator.SignedAttestations[0]).Return(nil)
+		db.EXPECT().AddSignedAttestation(gomock.Any(), validator.PublicKey, validator.SignedAttestations[1]).Return(nil)
+
+		err := connector.ImportSlashingProtection(ctx, interchange)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip records already present successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetGenesisValidatorsRoot(gomock.Any()).Return(interchange.GenesisValidatorsRoot, nil)
+		db.EXPECT().LockValidator(gomock.Any(), validator.PublicKey).Return(nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), validator.PublicKey).Return([]*entities3.SignedBlock{{Slot: 10}}, nil)
+		db.EXPECT().AddSignedBlock(gomock.Any(), validator.PublicKey, validator.SignedBlocks[1]).Return(nil)
+		db.EXPECT().ListSignedAttestations(gomock.Any(), validator.PublicKey).Return([]*entities3.SignedAttestation{{SourceEpoch: 1, TargetEpoch: 2}, {SourceEpoch: 2, TargetEpoch: 3}}, nil)
+
+		err := connector.ImportSlashingProtection(ctx, interchange)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if genesis validators root does not match", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetGenesisValidatorsRoot(gomock.Any()).Return([]byte("other root"), nil)
+
+		err := connector.ImportSlashingProtection(ctx, interchange)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		err := connector.ImportSlashingProtection(ctx, interchange)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if add signed block fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetGenesisValidatorsRoot(gomock.Any()).Return(interchange.GenesisValidatorsRoot, nil)
+		db.EXPECT().LockValidator(gomock.Any(), validator.PublicKey).Return(nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), validator.PublicKey).Return(nil, nil)
+		db.EXPECT().AddSignedBlock(gomock.Any(), validator.PublicKey, validator.SignedBlocks[0]).Return(expectedErr)
+
+		err := connector.ImportSlashingProtection(ctx, interchange)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestExportSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interchange := fakeInterchange()
+	validator := interchange.Data[0]
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	keys := mock2.NewMockKeys(ctrl)
+	db := mock2.NewMockSlashingProtection(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, keys, db, auth, logger)
+
+	t.Run("should export slashing protection data successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetGenesisValidatorsRoot(gomock.Any()).Return(interchange.GenesisValidatorsRoot, nil)
+		db.EXPECT().ListValidators(gomock.Any()).Return([][]byte{validator.PublicKey}, nil)
+		db.EXPECT().ListSignedBlocks(gomock.Any(), validator.PublicKey).Return(validator.SignedBlocks, nil)
+		db.EXPECT().ListSignedAttestations(gomock.Any(), validator.PublicKey).Return(validator.SignedAttestations, nil)
+
+		rInterchange, err := connector.ExportSlashingProtection(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, interchange, rInterchange)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.ExportSlashingProtection(ctx)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if list validators fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetGenesisValidatorsRoot(gomock.Any()).Return(interchange.GenesisValidatorsRoot, nil)
+		db.EXPECT().ListValidators(gomock.Any()).Return(nil, expectedErr)
+
+		_, err := connector.ExportSlashingProtection(ctx)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
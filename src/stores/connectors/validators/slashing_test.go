@@ -0,0 +1,124 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rootA = []byte{0xa}
+	rootB = []byte{0xb}
+)
+
+func TestCheckBlock(t *testing.T) {
+	history := []*entities.SignedBlock{
+		{Slot: 10, SigningRoot: rootA},
+		{Slot: 20, SigningRoot: rootA},
+	}
+
+	t.Run("should accept a block when there is no history", func(t *testing.T) {
+		alreadySigned, err := checkBlock(nil, &entities.SignedBlock{Slot: 1, SigningRoot: rootA})
+
+		assert.NoError(t, err)
+		assert.False(t, alreadySigned)
+	})
+
+	t.Run("should accept a block with a new slot", func(t *testing.T) {
+		alreadySigned, err := checkBlock(history, &entities.SignedBlock{Slot: 15, SigningRoot: rootB})
+
+		assert.NoError(t, err)
+		assert.False(t, alreadySigned)
+	})
+
+	t.Run("should accept to sign again the same block", func(t *testing.T) {
+		alreadySigned, err := checkBlock(history, &entities.SignedBlock{Slot: 20, SigningRoot: rootA})
+
+		assert.NoError(t, err)
+		assert.True(t, alreadySigned)
+	})
+
+	t.Run("should refuse a double proposal", func(t *testing.T) {
+		_, err := checkBlock(history, &entities.SignedBlock{Slot: 20, SigningRoot: rootB})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should refuse a block for a slot already signed without signing root", func(t *testing.T) {
+		_, err := checkBlock([]*entities.SignedBlock{{Slot: 20}}, &entities.SignedBlock{Slot: 20, SigningRoot: rootA})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should refuse a block lower than the minimum signed slot", func(t *testing.T) {
+		_, err := checkBlock(history, &entities.SignedBlock{Slot: 5, SigningRoot: rootA})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+}
+
+func TestCheckAttestation(t *testing.T) {
+	history := []*entities.SignedAttestation{
+		{SourceEpoch: 10, TargetEpoch: 11, SigningRoot: rootA},
+		{SourceEpoch: 11, TargetEpoch: 15, SigningRoot: rootA},
+	}
+
+	t.Run("should accept an attestation when there is no history", func(t *testing.T) {
+		alreadySigned, err := checkAttestation(nil, &entities.SignedAttestation{SourceEpoch: 0, TargetEpoch: 0, SigningRoot: rootA})
+
+		assert.NoError(t, err)
+		assert.False(t, alreadySigned)
+	})
+
+	t.Run("should accept a new attestation", func(t *testing.T) {
+		alreadySigned, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 15, TargetEpoch: 16, SigningRoot: rootB})
+
+		assert.NoError(t, err)
+		assert.False(t, alreadySigned)
+	})
+
+	t.Run("should accept to sign again the same attestation", func(t *testing.T) {
+		alreadySigned, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 11, TargetEpoch: 15, SigningRoot: rootA})
+
+		assert.NoError(t, err)
+		assert.True(t, alreadySigned)
+	})
+
+	t.Run("should refuse a double vote", func(t *testing.T) {
+		_, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 11, TargetEpoch: 15, SigningRoot: rootB})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should refuse a surrounded vote", func(t *testing.T) {
+		_, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 12, TargetEpoch: 14, SigningRoot: rootB})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should refuse a surrounding vote", func(t *testing.T) {
+		_, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 10, TargetEpoch: 16, SigningRoot: rootB})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should refuse an attestation lower than the minimum signed source epoch", func(t *testing.T) {
+		_, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 9, TargetEpoch: 9, SigningRoot: rootB})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should refuse an attestation lower than the minimum signed target epoch", func(t *testing.T) {
+		_, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 10, TargetEpoch: 10, SigningRoot: rootB})
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if source epoch is greater than target epoch", func(t *testing.T) {
+		_, err := checkAttestation(history, &entities.SignedAttestation{SourceEpoch: 20, TargetEpoch: 19, SigningRoot: rootB})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
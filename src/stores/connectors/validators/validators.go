@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type Connector struct {
+	store        stores.KeyStore
+	keys         database.Keys
+	db           database.SlashingProtection
+	logger       log.Logger
+	authorizator auth.Authorizator
+}
+
+var _ stores.ValidatorStore = Connector{}
+
+var blsAlgo = &entities2.Algorithm{
+	Type:          entities2.Bls,
+	EllipticCurve: entities2.Bls12381,
+}
+
+func NewConnector(store stores.KeyStore, keys database.Keys, db database.SlashingProtection, authorizator auth.Authorizator, logger log.Logger) *Connector {
+	return &Connector{
+		store:        store,
+		keys:         keys,
+		db:           db,
+		logger:       logger,
+		authorizator: authorizator,
+	}
+}
+
+// getValidatorKey gets a BLS12-381 key that can be used for signing
+func (c Connector) getValidatorKey(ctx context.Context, id string) (*entities.Key, error) {
+	key, err := c.keys.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Algo == nil || *key.Algo != *blsAlgo {
+		errMessage := "key is not a BLS12-381 validator key"
+		c.logger.With("id", id).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	err = connectors.CheckUsable(c.logger.With("id", id), "validator key", key.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
@@ -13,6 +13,7 @@ type Database interface {
 	Ping(ctx context.Context) error
 	Keys(storeID string) Keys
 	Secrets(storeID string) Secrets
+	SlashingProtection(storeID string) SlashingProtection
 }
 
 type ETHAccounts interface {
@@ -27,17 +28,21 @@ type ETHAccounts interface {
 	Delete(ctx context.Context, addr string) error
 	Restore(ctx context.Context, addr string) error
 	Purge(ctx context.Context, addr string) error
+	NextDerivationIndex(ctx context.Context) (uint32, error)
 }
 
 type Keys interface {
 	RunInTransaction(ctx context.Context, persistFunc func(dbtx Keys) error) error
 	Get(ctx context.Context, id string) (*entities.Key, error)
+	GetVersion(ctx context.Context, id, version string) (*entities.Key, error)
 	GetDeleted(ctx context.Context, id string) (*entities.Key, error)
 	GetAll(ctx context.Context) ([]*entities.Key, error)
 	GetAllDeleted(ctx context.Context) ([]*entities.Key, error)
 	SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
 	Add(ctx context.Context, key *entities.Key) (*entities.Key, error)
 	Update(ctx context.Context, key *entities.Key) (*entities.Key, error)
+	AddVersion(ctx context.Context, key *entities.Key) error
+	ListVersions(ctx context.Context, id string) ([]string, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
 	Purge(ctx context.Context, id string) error
@@ -58,3 +63,15 @@ type Secrets interface {
 	Restore(ctx context.Context, id string) error
 	Purge(ctx context.Context, id string) error
 }
+
+type SlashingProtection interface {
+	RunInTransaction(ctx context.Context, persistFunc func(dbtx SlashingProtection) error) error
+	LockValidator(ctx context.Context, pubKey []byte) error
+	GetGenesisValidatorsRoot(ctx context.Context) ([]byte, error)
+	SetGenesisValidatorsRoot(ctx context.Context, root []byte) error
+	ListValidators(ctx context.Context) ([][]byte, error)
+	ListSignedBlocks(ctx context.Context, pubKey []byte) ([]*entities.SignedBlock, error)
+	AddSignedBlock(ctx context.Context, pubKey []byte, block *entities.SignedBlock) error
+	ListSignedAttestations(ctx context.Context, pubKey []byte) ([]*entities.SignedAttestation, error)
+	AddSignedAttestation(ctx context.Context, pubKey []byte, attestation *entities.SignedAttestation) error
+}
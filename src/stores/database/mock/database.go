@@ -91,6 +91,20 @@ func (mr *MockDatabaseMockRecorder) Secrets(storeID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockDatabase)(nil).Secrets), storeID)
 }
 
+// SlashingProtection mocks base method
+func (m *MockDatabase) SlashingProtection(storeID string) database.SlashingProtection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashingProtection", storeID)
+	ret0, _ := ret[0].(database.SlashingProtection)
+	return ret0
+}
+
+// SlashingProtection indicates an expected call of SlashingProtection
+func (mr *MockDatabaseMockRecorder) SlashingProtection(storeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashingProtection", reflect.TypeOf((*MockDatabase)(nil).SlashingProtection), storeID)
+}
+
 // MockETHAccounts is a mock of ETHAccounts interface
 type MockETHAccounts struct {
 	ctrl     *gomock.Controller
@@ -275,6 +289,21 @@ func (mr *MockETHAccountsMockRecorder) Purge(ctx, addr interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockETHAccounts)(nil).Purge), ctx, addr)
 }
 
+// NextDerivationIndex mocks base method
+func (m *MockETHAccounts) NextDerivationIndex(ctx context.Context) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextDerivationIndex", ctx)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextDerivationIndex indicates an expected call of NextDerivationIndex
+func (mr *MockETHAccountsMockRecorder) NextDerivationIndex(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextDerivationIndex", reflect.TypeOf((*MockETHAccounts)(nil).NextDerivationIndex), ctx)
+}
+
 // MockKeys is a mock of Keys interface
 type MockKeys struct {
 	ctrl     *gomock.Controller
@@ -327,6 +356,21 @@ func (mr *MockKeysMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeys)(nil).Get), ctx, id)
 }
 
+// GetVersion mocks base method
+func (m *MockKeys) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, id, version)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockKeysMockRecorder) GetVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockKeys)(nil).GetVersion), ctx, id, version)
+}
+
 // GetDeleted mocks base method
 func (m *MockKeys) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -417,6 +461,35 @@ func (mr *MockKeysMockRecorder) Update(ctx, key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeys)(nil).Update), ctx, key)
 }
 
+// AddVersion mocks base method
+func (m *MockKeys) AddVersion(ctx context.Context, key *entities.Key) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVersion", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddVersion indicates an expected call of AddVersion
+func (mr *MockKeysMockRecorder) AddVersion(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVersion", reflect.TypeOf((*MockKeys)(nil).AddVersion), ctx, key)
+}
+
+// ListVersions mocks base method
+func (m *MockKeys) ListVersions(ctx context.Context, id string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockKeysMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockKeys)(nil).ListVersions), ctx, id)
+}
+
 // Delete mocks base method
 func (m *MockKeys) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -672,3 +745,156 @@ func (mr *MockSecretsMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockSecrets)(nil).Purge), ctx, id)
 }
+
+// MockSlashingProtection is a mock of SlashingProtection interface
+type MockSlashingProtection struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingProtectionMockRecorder
+}
+
+// MockSlashingProtectionMockRecorder is the mock recorder for MockSlashingProtection
+type MockSlashingProtectionMockRecorder struct {
+	mock *MockSlashingProtection
+}
+
+// NewMockSlashingProtection creates a new mock instance
+func NewMockSlashingProtection(ctrl *gomock.Controller) *MockSlashingProtection {
+	mock := &MockSlashingProtection{ctrl: ctrl}
+	mock.recorder = &MockSlashingProtectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSlashingProtection) EXPECT() *MockSlashingProtectionMockRecorder {
+	return m.recorder
+}
+
+// RunInTransaction mocks base method
+func (m *MockSlashingProtection) RunInTransaction(ctx context.Context, persistFunc func(database.SlashingProtection) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTransaction indicates an expected call of RunInTransaction
+func (mr *MockSlashingProtectionMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockSlashingProtection)(nil).RunInTransaction), ctx, persistFunc)
+}
+
+// LockValidator mocks base method
+func (m *MockSlashingProtection) LockValidator(ctx context.Context, pubKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockValidator", ctx, pubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockValidator indicates an expected call of LockValidator
+func (mr *MockSlashingProtectionMockRecorder) LockValidator(ctx, pubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockValidator", reflect.TypeOf((*MockSlashingProtection)(nil).LockValidator), ctx, pubKey)
+}
+
+// GetGenesisValidatorsRoot mocks base method
+func (m *MockSlashingProtection) GetGenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGenesisValidatorsRoot", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGenesisValidatorsRoot indicates an expected call of GetGenesisValidatorsRoot
+func (mr *MockSlashingProtectionMockRecorder) GetGenesisValidatorsRoot(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenesisValidatorsRoot", reflect.TypeOf((*MockSlashingProtection)(nil).GetGenesisValidatorsRoot), ctx)
+}
+
+// SetGenesisValidatorsRoot mocks base method
+func (m *MockSlashingProtection) SetGenesisValidatorsRoot(ctx context.Context, root []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGenesisValidatorsRoot", ctx, root)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGenesisValidatorsRoot indicates an expected call of SetGenesisValidatorsRoot
+func (mr *MockSlashingProtectionMockRecorder) SetGenesisValidatorsRoot(ctx, root interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGenesisValidatorsRoot", reflect.TypeOf((*MockSlashingProtection)(nil).SetGenesisValidatorsRoot), ctx, root)
+}
+
+// ListValidators mocks base method
+func (m *MockSlashingProtection) ListValidators(ctx context.Context) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListValidators", ctx)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListValidators indicates an expected call of ListValidators
+func (mr *MockSlashingProtectionMockRecorder) ListValidators(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValidators", reflect.TypeOf((*MockSlashingProtection)(nil).ListValidators), ctx)
+}
+
+// ListSignedBlocks mocks base method
+func (m *MockSlashingProtection) ListSignedBlocks(ctx context.Context, pubKey []byte) ([]*entities.SignedBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSignedBlocks", ctx, pubKey)
+	ret0, _ := ret[0].([]*entities.SignedBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSignedBlocks indicates an expected call of ListSignedBlocks
+func (mr *MockSlashingProtectionMockRecorder) ListSignedBlocks(ctx, pubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSignedBlocks", reflect.TypeOf((*MockSlashingProtection)(nil).ListSignedBlocks), ctx, pubKey)
+}
+
+// AddSignedBlock mocks base method
+func (m *MockSlashingProtection) AddSignedBlock(ctx context.Context, pubKey []byte, block *entities.SignedBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSignedBlock", ctx, pubKey, block)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSignedBlock indicates an expected call of AddSignedBlock
+func (mr *MockSlashingProtectionMockRecorder) AddSignedBlock(ctx, pubKey, block interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignedBlock", reflect.TypeOf((*MockSlashingProtection)(nil).AddSignedBlock), ctx, pubKey, block)
+}
+
+// ListSignedAttestations mocks base method
+func (m *MockSlashingProtection) ListSignedAttestations(ctx context.Context, pubKey []byte) ([]*entities.SignedAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSignedAttestations", ctx, pubKey)
+	ret0, _ := ret[0].([]*entities.SignedAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSignedAttestations indicates an expected call of ListSignedAttestations
+func (mr *MockSlashingProtectionMockRecorder) ListSignedAttestations(ctx, pubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSignedAttestations", reflect.TypeOf((*MockSlashingProtection)(nil).ListSignedAttestations), ctx, pubKey)
+}
+
+// AddSignedAttestation mocks base method
+func (m *MockSlashingProtection) AddSignedAttestation(ctx context.Context, pubKey []byte, attestation *entities.SignedAttestation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSignedAttestation", ctx, pubKey, attestation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSignedAttestation indicates an expected call of AddSignedAttestation
+func (mr *MockSlashingProtectionMockRecorder) AddSignedAttestation(ctx, pubKey, attestation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSignedAttestation", reflect.TypeOf((*MockSlashingProtection)(nil).AddSignedAttestation), ctx, pubKey, attestation)
+}
@@ -17,8 +17,10 @@ type ETHAccount struct {
 	KeyID               string
 	PublicKey           []byte
 	CompressedPublicKey []byte
+	DerivationIndex     *uint32
 	Tags                map[string]string
-	Disabled            bool
+	Disabled            bool      `pg:",use_zero"`
+	ExpireAt            time.Time `pg:",use_zero"`
 	CreatedAt           time.Time `pg:"default:now()"`
 	UpdatedAt           time.Time `pg:"default:now()"`
 	DeletedAt           time.Time `pg:",soft_delete"`
@@ -30,8 +32,10 @@ func NewETHAccount(account *entities.ETHAccount) *ETHAccount {
 		KeyID:               account.KeyID,
 		PublicKey:           account.PublicKey,
 		CompressedPublicKey: account.CompressedPublicKey,
+		DerivationIndex:     account.DerivationIndex,
 		Tags:                account.Tags,
 		Disabled:            account.Metadata.Disabled,
+		ExpireAt:            account.Metadata.ExpireAt,
 		CreatedAt:           account.Metadata.CreatedAt,
 		UpdatedAt:           account.Metadata.UpdatedAt,
 		DeletedAt:           account.Metadata.DeletedAt,
@@ -48,6 +52,7 @@ func NewETHAccountFromKey(key *entities.Key, attr *entities.Attributes) *entitie
 		CompressedPublicKey: crypto.CompressPubkey(pubKey),
 		Metadata: &entities.Metadata{
 			Disabled:  key.Metadata.Disabled,
+			ExpireAt:  attr.ExpireAt,
 			CreatedAt: key.Metadata.CreatedAt,
 			UpdatedAt: key.Metadata.UpdatedAt,
 		},
@@ -60,8 +65,10 @@ func (eth *ETHAccount) ToEntity() *entities.ETHAccount {
 		KeyID:               eth.KeyID,
 		PublicKey:           eth.PublicKey,
 		CompressedPublicKey: eth.CompressedPublicKey,
+		DerivationIndex:     eth.DerivationIndex,
 		Metadata: &entities.Metadata{
 			Disabled:  eth.Disabled,
+			ExpireAt:  eth.ExpireAt,
 			CreatedAt: eth.CreatedAt,
 			UpdatedAt: eth.UpdatedAt,
 			DeletedAt: eth.DeletedAt,
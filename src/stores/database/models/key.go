@@ -13,12 +13,14 @@ type Key struct {
 
 	ID               string `pg:",pk"`
 	StoreID          string `pg:",pk"`
+	Version          string
 	PublicKey        []byte
 	SigningAlgorithm string
 	EllipticCurve    string
 	Tags             map[string]string
 	Annotations      *entities.Annotation
-	Disabled         bool
+	Disabled         bool      `pg:",use_zero"`
+	ExpireAt         time.Time `pg:",use_zero"`
 	CreatedAt        time.Time `pg:"default:now()"`
 	UpdatedAt        time.Time `pg:"default:now()"`
 	DeletedAt        time.Time `pg:",soft_delete"`
@@ -27,12 +29,14 @@ type Key struct {
 func NewKey(key *entities.Key) *Key {
 	return &Key{
 		ID:               key.ID,
+		Version:          key.Metadata.Version,
 		PublicKey:        key.PublicKey,
 		SigningAlgorithm: string(key.Algo.Type),
 		EllipticCurve:    string(key.Algo.EllipticCurve),
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
 		Disabled:         key.Metadata.Disabled,
+		ExpireAt:         key.Metadata.ExpireAt,
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
 		DeletedAt:        key.Metadata.DeletedAt,
@@ -50,7 +54,9 @@ func (k *Key) ToEntity() *entities.Key {
 		Tags:        k.Tags,
 		Annotations: k.Annotations,
 		Metadata: &entities.Metadata{
+			Version:   k.Version,
 			Disabled:  k.Disabled,
+			ExpireAt:  k.ExpireAt,
 			CreatedAt: k.CreatedAt,
 			UpdatedAt: k.UpdatedAt,
 			DeletedAt: k.DeletedAt,
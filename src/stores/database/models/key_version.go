@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// KeyVersion is a previous version of a key, kept after a rotation so that its public key remains available
+type KeyVersion struct {
+	tableName struct{} `pg:"key_versions"` // nolint:unused,structcheck // reason
+
+	ID        string `pg:",pk"`
+	Version   string `pg:",pk"`
+	StoreID   string `pg:",pk"`
+	PublicKey []byte
+	CreatedAt time.Time `pg:"default:now()"`
+}
+
+func NewKeyVersion(key *entities.Key) *KeyVersion {
+	return &KeyVersion{
+		ID:        key.ID,
+		Version:   key.Metadata.Version,
+		PublicKey: key.PublicKey,
+		CreatedAt: key.Metadata.CreatedAt,
+	}
+}
+
+// ToEntity returns the key entity of this version, completed by the current key it belongs to
+func (k *KeyVersion) ToEntity(current *entities.Key) *entities.Key {
+	metadata := *current.Metadata
+	key := *current
+	key.Metadata = &metadata
+	key.PublicKey = k.PublicKey
+	key.Metadata.Version = k.Version
+	key.Metadata.CreatedAt = k.CreatedAt
+
+	return &key
+}
@@ -13,7 +13,8 @@ type Secret struct {
 	Version   string `pg:",pk"`
 	StoreID   string `pg:",pk"`
 	Tags      map[string]string
-	Disabled  bool
+	Disabled  bool      `pg:",use_zero"`
+	ExpireAt  time.Time `pg:",use_zero"`
 	CreatedAt time.Time `pg:"default:now()"`
 	UpdatedAt time.Time `pg:"default:now()"`
 	DeletedAt time.Time `pg:",soft_delete"`
@@ -25,6 +26,7 @@ func NewSecret(secret *entities.Secret) *Secret {
 		Version:   secret.Metadata.Version,
 		Tags:      secret.Tags,
 		Disabled:  secret.Metadata.Disabled,
+		ExpireAt:  secret.Metadata.ExpireAt,
 		CreatedAt: secret.Metadata.CreatedAt,
 		UpdatedAt: secret.Metadata.UpdatedAt,
 		DeletedAt: secret.Metadata.DeletedAt,
@@ -38,6 +40,7 @@ func (s *Secret) ToEntity() *entities.Secret {
 		Metadata: &entities.Metadata{
 			Version:   s.Version,
 			Disabled:  s.Disabled,
+			ExpireAt:  s.ExpireAt,
 			CreatedAt: s.CreatedAt,
 			UpdatedAt: s.UpdatedAt,
 			DeletedAt: s.DeletedAt,
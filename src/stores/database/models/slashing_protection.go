@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type SignedBlock struct {
+	tableName struct{} `pg:"signed_blocks"` // nolint:unused,structcheck // reason
+
+	PK          int `pg:",pk"`
+	StoreID     string
+	PublicKey   string
+	Slot        uint64 `pg:",use_zero"`
+	SigningRoot []byte
+	CreatedAt   time.Time `pg:"default:now()"`
+}
+
+func NewSignedBlock(block *entities.SignedBlock) *SignedBlock {
+	return &SignedBlock{
+		Slot:        block.Slot,
+		SigningRoot: block.SigningRoot,
+	}
+}
+
+func (b *SignedBlock) ToEntity() *entities.SignedBlock {
+	return &entities.SignedBlock{
+		Slot:        b.Slot,
+		SigningRoot: b.SigningRoot,
+	}
+}
+
+type SignedAttestation struct {
+	tableName struct{} `pg:"signed_attestations"` // nolint:unused,structcheck // reason
+
+	PK          int `pg:",pk"`
+	StoreID     string
+	PublicKey   string
+	SourceEpoch uint64 `pg:",use_zero"`
+	TargetEpoch uint64 `pg:",use_zero"`
+	SigningRoot []byte
+	CreatedAt   time.Time `pg:"default:now()"`
+}
+
+func NewSignedAttestation(attestation *entities.SignedAttestation) *SignedAttestation {
+	return &SignedAttestation{
+		SourceEpoch: attestation.SourceEpoch,
+		TargetEpoch: attestation.TargetEpoch,
+		SigningRoot: attestation.SigningRoot,
+	}
+}
+
+func (a *SignedAttestation) ToEntity() *entities.SignedAttestation {
+	return &entities.SignedAttestation{
+		SourceEpoch: a.SourceEpoch,
+		TargetEpoch: a.TargetEpoch,
+		SigningRoot: a.SigningRoot,
+	}
+}
+
+type SlashingProtectionMetadata struct {
+	tableName struct{} `pg:"slashing_protection_metadata"` // nolint:unused,structcheck // reason
+
+	StoreID               string `pg:",pk"`
+	GenesisValidatorsRoot []byte
+	CreatedAt             time.Time `pg:"default:now()"`
+}
@@ -46,3 +46,7 @@ func (db *Database) Keys(storeID string) database.Keys {
 func (db *Database) Secrets(storeID string) database.Secrets {
 	return NewSecrets(storeID, db.client, db.logger.With("store_id", storeID))
 }
+
+func (db *Database) SlashingProtection(storeID string) database.SlashingProtection {
+	return NewSlashingProtection(storeID, db.client, db.logger.With("store_id", storeID))
+}
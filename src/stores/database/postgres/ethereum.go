@@ -178,3 +178,25 @@ func (ea *ETHAccounts) Purge(ctx context.Context, addr string) error {
 
 	return nil
 }
+
+// NextDerivationIndex locks the HD wallet of the store for the duration of the current transaction
+// and returns the index following the highest derivation index ever used, deleted accounts included
+func (ea *ETHAccounts) NextDerivationIndex(ctx context.Context) (uint32, error) {
+	var count int
+	err := ea.client.QueryOne(ctx, &count, "SELECT count(*) FROM (SELECT pg_advisory_xact_lock(hashtext(? || ?))) AS hd_wallet_lock", "hd_wallet/", ea.storeID)
+	if err != nil {
+		errMessage := "failed to lock hd wallet"
+		ea.logger.WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var index uint32
+	err = ea.client.QueryOne(ctx, &index, "SELECT coalesce(max(derivation_index) + 1, 0) FROM eth_accounts WHERE store_id = ?", ea.storeID)
+	if err != nil {
+		errMessage := "failed to get next derivation index"
+		ea.logger.WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return index, nil
+}
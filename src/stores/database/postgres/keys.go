@@ -51,6 +51,27 @@ func (k *Keys) Get(ctx context.Context, id string) (*entities.Key, error) {
 	return key.ToEntity(), nil
 }
 
+func (k *Keys) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	key, err := k.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == "" || key.Metadata.Version == version {
+		return key, nil
+	}
+
+	keyVersion := &models.KeyVersion{ID: id, Version: version, StoreID: k.storeID}
+	err = k.client.SelectPK(ctx, keyVersion)
+	if err != nil {
+		errMessage := "failed to get key version"
+		k.logger.With("id", id).With("version", version).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return keyVersion.ToEntity(key), nil
+}
+
 func (k *Keys) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
 	key := &models.Key{ID: id, StoreID: k.storeID}
 
@@ -139,6 +160,34 @@ func (k *Keys) Update(ctx context.Context, key *entities.Key) (*entities.Key, er
 	return keyModel.ToEntity(), nil
 }
 
+func (k *Keys) AddVersion(ctx context.Context, key *entities.Key) error {
+	keyVersionModel := models.NewKeyVersion(key)
+	keyVersionModel.StoreID = k.storeID
+
+	err := k.client.Insert(ctx, keyVersionModel)
+	if err != nil {
+		errMessage := "failed to add key version"
+		k.logger.With("id", key.ID).With("version", key.Metadata.Version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+// ListVersions lists the previous versions of a key, the current version is not included
+func (k *Keys) ListVersions(ctx context.Context, id string) ([]string, error) {
+	var versions []string
+	err := k.client.Query(ctx, &versions,
+		"SELECT array_agg(version ORDER BY created_at ASC) FROM key_versions WHERE id = ? AND store_id = ?", id, k.storeID)
+	if err != nil {
+		errMessage := "failed to list key versions"
+		k.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return versions, nil
+}
+
 func (k *Keys) Delete(ctx context.Context, id string) error {
 	err := k.client.DeletePK(ctx, &models.Key{ID: id, StoreID: k.storeID})
 	if err != nil {
@@ -169,5 +218,12 @@ func (k *Keys) Purge(ctx context.Context, id string) error {
 		return errors.FromError(err).SetMessage(errMessage)
 	}
 
+	err = k.client.ForceDeleteWhere(ctx, &models.KeyVersion{}, "id = ? AND store_id = ?", id, k.storeID)
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to permanently delete key versions"
+		k.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
 	return nil
 }
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/stores/database/models"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+)
+
+type SlashingProtection struct {
+	storeID string
+	logger  log.Logger
+	client  postgres.Client
+}
+
+var _ database.SlashingProtection = &SlashingProtection{}
+
+func NewSlashingProtection(storeID string, db postgres.Client, logger log.Logger) *SlashingProtection {
+	return &SlashingProtection{
+		storeID: storeID,
+		logger:  logger,
+		client:  db,
+	}
+}
+
+func (sp SlashingProtection) RunInTransaction(ctx context.Context, persist func(dbtx database.SlashingProtection) error) error {
+	return sp.client.RunInTransaction(ctx, func(dbTx postgres.Client) error {
+		sp.client = dbTx
+		return persist(&sp)
+	})
+}
+
+// LockValidator acquires a lock on the validator for the duration of the current transaction,
+// so that concurrent signing requests are checked against each other
+func (sp *SlashingProtection) LockValidator(ctx context.Context, pubKey []byte) error {
+	var count int
+	err := sp.client.QueryOne(ctx, &count, "SELECT count(*) FROM (SELECT pg_advisory_xact_lock(hashtext(? || ?))) AS validator_lock", sp.storeID, hexutil.Encode(pubKey))
+	if err != nil {
+		errMessage := "failed to lock validator"
+		sp.logger.With("public_key", hexutil.Encode(pubKey)).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (sp *SlashingProtection) GetGenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	metadata := &models.SlashingProtectionMetadata{StoreID: sp.storeID}
+
+	err := sp.client.SelectPK(ctx, metadata)
+	if err != nil && errors.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		errMessage := "failed to get genesis validators root"
+		sp.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return metadata.GenesisValidatorsRoot, nil
+}
+
+func (sp *SlashingProtection) SetGenesisValidatorsRoot(ctx context.Context, root []byte) error {
+	err := sp.client.Insert(ctx, &models.SlashingProtectionMetadata{
+		StoreID:               sp.storeID,
+		GenesisValidatorsRoot: root,
+		CreatedAt:             time.Now(),
+	})
+	if err != nil {
+		errMessage := "failed to set genesis validators root"
+		sp.logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (sp *SlashingProtection) ListValidators(ctx context.Context) ([][]byte, error) {
+	var pubKeys []string
+
+	query := "SELECT array_agg(DISTINCT public_key) FROM (SELECT public_key FROM signed_blocks WHERE store_id = ? UNION SELECT public_key FROM signed_attestations WHERE store_id = ?) AS validators"
+	err := sp.client.Query(ctx, &pubKeys, query, sp.storeID, sp.storeID)
+	if err != nil {
+		errMessage := "failed to list validators"
+		sp.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var validators [][]byte
+	for _, pubKey := range pubKeys {
+		validators = append(validators, hexutil.MustDecode(pubKey))
+	}
+
+	return validators, nil
+}
+
+func (sp *SlashingProtection) ListSignedBlocks(ctx context.Context, pubKey []byte) ([]*entities.SignedBlock, error) {
+	var blockModels []*models.SignedBlock
+
+	err := sp.client.SelectWhere(ctx, &blockModels, "store_id = ? AND public_key = ?", []string{}, sp.storeID, hexutil.Encode(pubKey))
+	if err != nil {
+		errMessage := "failed to list signed blocks"
+		sp.logger.With("public_key", hexutil.Encode(pubKey)).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var blocks []*entities.SignedBlock
+	for _, block := range blockModels {
+		blocks = append(blocks, block.ToEntity())
+	}
+
+	return blocks, nil
+}
+
+func (sp *SlashingProtection) AddSignedBlock(ctx context.Context, pubKey []byte, block *entities.SignedBlock) error {
+	blockModel := models.NewSignedBlock(block)
+	blockModel.StoreID = sp.storeID
+	blockModel.PublicKey = hexutil.Encode(pubKey)
+	blockModel.CreatedAt = time.Now()
+
+	err := sp.client.Insert(ctx, blockModel)
+	if err != nil {
+		errMessage := "failed to add signed block"
+		sp.logger.With("public_key", blockModel.PublicKey, "slot", block.Slot).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (sp *SlashingProtection) ListSignedAttestations(ctx context.Context, pubKey []byte) ([]*entities.SignedAttestation, error) {
+	var attestationModels []*models.SignedAttestation
+
+	err := sp.client.SelectWhere(ctx, &attestationModels, "store_id = ? AND public_key = ?", []string{}, sp.storeID, hexutil.Encode(pubKey))
+	if err != nil {
+		errMessage := "failed to list signed attestations"
+		sp.logger.With("public_key", hexutil.Encode(pubKey)).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var attestations []*entities.SignedAttestation
+	for _, attestation := range attestationModels {
+		attestations = append(attestations, attestation.ToEntity())
+	}
+
+	return attestations, nil
+}
+
+func (sp *SlashingProtection) AddSignedAttestation(ctx context.Context, pubKey []byte, attestation *entities.SignedAttestation) error {
+	attestationModel := models.NewSignedAttestation(attestation)
+	attestationModel.StoreID = sp.storeID
+	attestationModel.PublicKey = hexutil.Encode(pubKey)
+	attestationModel.CreatedAt = time.Now()
+
+	err := sp.client.Insert(ctx, attestationModel)
+	if err != nil {
+		errMessage := "failed to add signed attestation"
+		sp.logger.With("public_key", attestationModel.PublicKey, "target_epoch", attestation.TargetEpoch).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
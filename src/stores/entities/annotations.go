@@ -6,4 +6,5 @@ type Annotation struct {
 	AWSCloudHsmClusterID string `json:"AWSCloudHsmClusterID,omitempty"`
 	AWSAccountID         string `json:"AWSAccountID,omitempty"`
 	AWSArn               string `json:"AWSArn,omitempty"`
+	GCPKeyVersionName    string `json:"GCPKeyVersionName,omitempty"`
 }
@@ -24,6 +24,12 @@ type Attributes struct {
 	// TTL
 	TTL time.Duration
 
+	// ExpireAt date after which the item can no longer be used
+	ExpireAt time.Time
+
+	// ClearExpireAt removes the expiry date of an item on update
+	ClearExpireAt bool
+
 	// Recovery policy about a key after being deleted before being destroyed
 	Recovery *Recovery
 
@@ -0,0 +1,8 @@
+package entities
+
+// EnvelopeEncryption configures a local key store to encrypt its private keys with data keys wrapped by a key
+// encryption key held in a vault backed key store
+type EnvelopeEncryption struct {
+	KeyStore string
+	KeyID    string
+}
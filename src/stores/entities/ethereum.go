@@ -7,6 +7,7 @@ type ETHAccount struct {
 	KeyID               string
 	PublicKey           []byte
 	CompressedPublicKey []byte
+	DerivationIndex     *uint32
 	Metadata            *Metadata
 	Tags                map[string]string
 }
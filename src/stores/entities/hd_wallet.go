@@ -0,0 +1,8 @@
+package entities
+
+// HDWallet configures an ethereum store to derive its accounts from a BIP-39 seed kept in a secret store
+type HDWallet struct {
+	SecretStore    string
+	SeedID         string
+	DerivationPath string
+}
@@ -10,3 +10,8 @@ type Metadata struct {
 	UpdatedAt time.Time
 	DeletedAt time.Time
 }
+
+// IsExpired indicates whether the expiry date of the item, if any, is reached
+func (m *Metadata) IsExpired() bool {
+	return !m.ExpireAt.IsZero() && !time.Now().Before(m.ExpireAt)
+}
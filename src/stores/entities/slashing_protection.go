@@ -0,0 +1,27 @@
+package entities
+
+// SignedBlock is a block proposal signed by a validator
+type SignedBlock struct {
+	Slot        uint64
+	SigningRoot []byte
+}
+
+// SignedAttestation is an attestation signed by a validator
+type SignedAttestation struct {
+	SourceEpoch uint64
+	TargetEpoch uint64
+	SigningRoot []byte
+}
+
+// ValidatorSigningHistory is the slashing protection data of a single validator
+type ValidatorSigningHistory struct {
+	PublicKey          []byte
+	SignedBlocks       []*SignedBlock
+	SignedAttestations []*SignedAttestation
+}
+
+// SlashingProtectionInterchange is the slashing protection data of a set of validators, as defined in EIP-3076
+type SlashingProtectionInterchange struct {
+	GenesisValidatorsRoot []byte
+	Data                  []*ValidatorSigningHistory
+}
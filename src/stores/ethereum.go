@@ -23,6 +23,12 @@ type EthStore interface {
 	// Import imports an externally created Ethereum account
 	Import(ctx context.Context, id string, privKey []byte, attr *entities.Attributes) (*entities.ETHAccount, error)
 
+	// ImportKeystore imports an Ethereum account from a keystore V3 JSON encrypted with the passphrase
+	ImportKeystore(ctx context.Context, id string, keyJSON []byte, passphrase string, attr *entities.Attributes) (*entities.ETHAccount, error)
+
+	// ImportMnemonic sets the seed of an HD wallet store from a BIP-39 mnemonic and derives its first accounts
+	ImportMnemonic(ctx context.Context, mnemonic, passphrase string, numAccounts uint32, attr *entities.Attributes) ([]*entities.ETHAccount, error)
+
 	// Get gets an Ethereum account
 	Get(ctx context.Context, addr common.Address) (*entities.ETHAccount, error)
 
@@ -32,6 +38,12 @@ type EthStore interface {
 	// Update updates Ethereum account attributes
 	Update(ctx context.Context, addr common.Address, attr *entities.Attributes) (*entities.ETHAccount, error)
 
+	// Enable enables a previously disabled Ethereum account
+	Enable(ctx context.Context, addr common.Address) error
+
+	// Disable disables an Ethereum account, a disabled account cannot sign nor encrypt until enabled again
+	Disable(ctx context.Context, addr common.Address) error
+
 	// Delete deletes an account temporarily, by using Restore the account can be restored
 	Delete(ctx context.Context, addr common.Address) error
 
@@ -65,12 +77,15 @@ type EthStore interface {
 	// SignEEA signs an EEA transaction
 	SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error)
 
-	// SignPrivate signs a Quorum private transaction
-	SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error)
+	// SignPrivate signs a Quorum private transaction sent to a chain, nil if unknown, the chain ID not being part of the signature
+	SignPrivate(ctx context.Context, addr common.Address, chainID *big.Int, tx *quorumtypes.Transaction) ([]byte, error)
 
 	// Encrypt encrypts any arbitrary data using a specified account
 	Encrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error)
 
 	// Decrypt decrypts a single block of encrypted data.
 	Decrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error)
+
+	// Export exports an Ethereum account as a keystore V3 JSON encrypted with the passphrase
+	Export(ctx context.Context, addr common.Address, passphrase string) ([]byte, error)
 }
@@ -20,12 +20,24 @@ type KeyStore interface {
 	// Get gets the public part of a stored key.
 	Get(ctx context.Context, id string) (*entities.Key, error)
 
+	// GetVersion gets the public part of a specific version of a stored key
+	GetVersion(ctx context.Context, id, version string) (*entities.Key, error)
+
+	// Rotate creates a new version of a key under the same ID and makes it the active version
+	Rotate(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error)
+
 	// List lists keys
 	List(ctx context.Context, limit, offset uint64) ([]string, error)
 
 	// Update updates key tags
 	Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error)
 
+	// Enable enables a previously disabled key
+	Enable(ctx context.Context, id string) error
+
+	// Disable disables a key, a disabled key cannot be used for cryptographic operations until enabled again
+	Disable(ctx context.Context, id string) error
+
 	// Delete soft-deletes a key
 	Delete(ctx context.Context, id string) error
 
@@ -44,9 +56,24 @@ type KeyStore interface {
 	// Sign from any arbitrary data using the specified key
 	Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error)
 
-	// Encrypt encrypts any arbitrary data using a specified key
-	Encrypt(ctx context.Context, id string, data []byte) ([]byte, error)
+	// Encrypt encrypts any arbitrary data using the specified key
+	Encrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error)
+
+	// Decrypt decrypts data previously encrypted using the specified key
+	Decrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error)
+
+	// Export returns the private key of the specified key, only supported when the key material is held by the key manager
+	Export(ctx context.Context, id string) ([]byte, error)
+}
+
+// PublicKeyEncrypter is implemented by key stores able to encrypt data from the public key of a key only, without accessing its private key
+type PublicKeyEncrypter interface {
+	// EncryptWithPublicKey encrypts any arbitrary data for the given public key
+	EncryptWithPublicKey(pubKey, data []byte, algo *entities2.Algorithm) ([]byte, error)
+}
 
-	// Decrypt decrypts a single block of encrypted data.
-	Decrypt(ctx context.Context, id string, data []byte) ([]byte, error)
+// KeyVersionDestroyer is implemented by key stores holding each version of a key as a distinct key, which Destroy does not remove
+type KeyVersionDestroyer interface {
+	// DestroyVersion destroys a previous version of a key permanently
+	DestroyVersion(ctx context.Context, id, version string) error
 }
@@ -70,6 +70,36 @@ func (mr *MockEthStoreMockRecorder) Import(ctx, id, privKey, attr interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockEthStore)(nil).Import), ctx, id, privKey, attr)
 }
 
+// ImportKeystore mocks base method
+func (m *MockEthStore) ImportKeystore(ctx context.Context, id string, keyJSON []byte, passphrase string, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKeystore", ctx, id, keyJSON, passphrase, attr)
+	ret0, _ := ret[0].(*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKeystore indicates an expected call of ImportKeystore
+func (mr *MockEthStoreMockRecorder) ImportKeystore(ctx, id, keyJSON, passphrase, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKeystore", reflect.TypeOf((*MockEthStore)(nil).ImportKeystore), ctx, id, keyJSON, passphrase, attr)
+}
+
+// ImportMnemonic mocks base method
+func (m *MockEthStore) ImportMnemonic(ctx context.Context, mnemonic, passphrase string, numAccounts uint32, attr *entities.Attributes) ([]*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMnemonic", ctx, mnemonic, passphrase, numAccounts, attr)
+	ret0, _ := ret[0].([]*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportMnemonic indicates an expected call of ImportMnemonic
+func (mr *MockEthStoreMockRecorder) ImportMnemonic(ctx, mnemonic, passphrase, numAccounts, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMnemonic", reflect.TypeOf((*MockEthStore)(nil).ImportMnemonic), ctx, mnemonic, passphrase, numAccounts, attr)
+}
+
 // Get mocks base method
 func (m *MockEthStore) Get(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +145,34 @@ func (mr *MockEthStoreMockRecorder) Update(ctx, addr, attr interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEthStore)(nil).Update), ctx, addr, attr)
 }
 
+// Enable mocks base method
+func (m *MockEthStore) Enable(ctx context.Context, addr common.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enable indicates an expected call of Enable
+func (mr *MockEthStoreMockRecorder) Enable(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockEthStore)(nil).Enable), ctx, addr)
+}
+
+// Disable mocks base method
+func (m *MockEthStore) Disable(ctx context.Context, addr common.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable
+func (mr *MockEthStoreMockRecorder) Disable(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockEthStore)(nil).Disable), ctx, addr)
+}
+
 // Delete mocks base method
 func (m *MockEthStore) Delete(ctx context.Context, addr common.Address) error {
 	m.ctrl.T.Helper()
@@ -217,6 +275,21 @@ func (mr *MockEthStoreMockRecorder) SignMessage(ctx, addr, data interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessage", reflect.TypeOf((*MockEthStore)(nil).SignMessage), ctx, addr, data)
 }
 
+// SignTypedDataHash mocks base method
+func (m *MockEthStore) SignTypedDataHash(ctx context.Context, addr common.Address, typedDataHash []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTypedDataHash", ctx, addr, typedDataHash)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTypedDataHash indicates an expected call of SignTypedDataHash
+func (mr *MockEthStoreMockRecorder) SignTypedDataHash(ctx, addr, typedDataHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTypedDataHash", reflect.TypeOf((*MockEthStore)(nil).SignTypedDataHash), ctx, addr, typedDataHash)
+}
+
 // SignTypedData mocks base method
 func (m *MockEthStore) SignTypedData(ctx context.Context, addr common.Address, typedData *core.TypedData) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -263,18 +336,18 @@ func (mr *MockEthStoreMockRecorder) SignEEA(ctx, addr, chainID, tx, args interfa
 }
 
 // SignPrivate mocks base method
-func (m *MockEthStore) SignPrivate(ctx context.Context, addr common.Address, tx *types.Transaction) ([]byte, error) {
+func (m *MockEthStore) SignPrivate(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignPrivate", ctx, addr, tx)
+	ret := m.ctrl.Call(m, "SignPrivate", ctx, addr, chainID, tx)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SignPrivate indicates an expected call of SignPrivate
-func (mr *MockEthStoreMockRecorder) SignPrivate(ctx, addr, tx interface{}) *gomock.Call {
+func (mr *MockEthStoreMockRecorder) SignPrivate(ctx, addr, chainID, tx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignPrivate", reflect.TypeOf((*MockEthStore)(nil).SignPrivate), ctx, addr, tx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignPrivate", reflect.TypeOf((*MockEthStore)(nil).SignPrivate), ctx, addr, chainID, tx)
 }
 
 // Encrypt mocks base method
@@ -306,3 +379,18 @@ func (mr *MockEthStoreMockRecorder) Decrypt(ctx, addr, data interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockEthStore)(nil).Decrypt), ctx, addr, data)
 }
+
+// Export mocks base method
+func (m *MockEthStore) Export(ctx context.Context, addr common.Address, passphrase string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, addr, passphrase)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export
+func (mr *MockEthStoreMockRecorder) Export(ctx, addr, passphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockEthStore)(nil).Export), ctx, addr, passphrase)
+}
@@ -6,8 +6,8 @@ package mock
 
 import (
 	context "context"
-	entities2 "github.com/consensys/quorum-key-manager/src/entities"
-	entities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	entities "github.com/consensys/quorum-key-manager/src/entities"
+	entities0 "github.com/consensys/quorum-key-manager/src/stores/entities"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -36,10 +36,10 @@ func (m *MockKeyStore) EXPECT() *MockKeyStoreMockRecorder {
 }
 
 // Create mocks base method
-func (m *MockKeyStore) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+func (m *MockKeyStore) Create(ctx context.Context, id string, alg *entities.Algorithm, attr *entities0.Attributes) (*entities0.Key, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, id, alg, attr)
-	ret0, _ := ret[0].(*entities.Key)
+	ret0, _ := ret[0].(*entities0.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -51,10 +51,10 @@ func (mr *MockKeyStoreMockRecorder) Create(ctx, id, alg, attr interface{}) *gomo
 }
 
 // Import mocks base method
-func (m *MockKeyStore) Import(ctx context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+func (m *MockKeyStore) Import(ctx context.Context, id string, privKey []byte, alg *entities.Algorithm, attr *entities0.Attributes) (*entities0.Key, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Import", ctx, id, privKey, alg, attr)
-	ret0, _ := ret[0].(*entities.Key)
+	ret0, _ := ret[0].(*entities0.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -66,10 +66,10 @@ func (mr *MockKeyStoreMockRecorder) Import(ctx, id, privKey, alg, attr interface
 }
 
 // Get mocks base method
-func (m *MockKeyStore) Get(ctx context.Context, id string) (*entities.Key, error) {
+func (m *MockKeyStore) Get(ctx context.Context, id string) (*entities0.Key, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, id)
-	ret0, _ := ret[0].(*entities.Key)
+	ret0, _ := ret[0].(*entities0.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -80,6 +80,36 @@ func (mr *MockKeyStoreMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeyStore)(nil).Get), ctx, id)
 }
 
+// GetVersion mocks base method
+func (m *MockKeyStore) GetVersion(ctx context.Context, id, version string) (*entities0.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, id, version)
+	ret0, _ := ret[0].(*entities0.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockKeyStoreMockRecorder) GetVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockKeyStore)(nil).GetVersion), ctx, id, version)
+}
+
+// Rotate mocks base method
+func (m *MockKeyStore) Rotate(ctx context.Context, id string, alg *entities.Algorithm, attr *entities0.Attributes) (*entities0.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, id, alg, attr)
+	ret0, _ := ret[0].(*entities0.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate
+func (mr *MockKeyStoreMockRecorder) Rotate(ctx, id, alg, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockKeyStore)(nil).Rotate), ctx, id, alg, attr)
+}
+
 // List mocks base method
 func (m *MockKeyStore) List(ctx context.Context, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -96,10 +126,10 @@ func (mr *MockKeyStoreMockRecorder) List(ctx, limit, offset interface{}) *gomock
 }
 
 // Update mocks base method
-func (m *MockKeyStore) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
+func (m *MockKeyStore) Update(ctx context.Context, id string, attr *entities0.Attributes) (*entities0.Key, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Update", ctx, id, attr)
-	ret0, _ := ret[0].(*entities.Key)
+	ret0, _ := ret[0].(*entities0.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -110,6 +140,34 @@ func (mr *MockKeyStoreMockRecorder) Update(ctx, id, attr interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeyStore)(nil).Update), ctx, id, attr)
 }
 
+// Enable mocks base method
+func (m *MockKeyStore) Enable(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enable indicates an expected call of Enable
+func (mr *MockKeyStoreMockRecorder) Enable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockKeyStore)(nil).Enable), ctx, id)
+}
+
+// Disable mocks base method
+func (m *MockKeyStore) Disable(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable
+func (mr *MockKeyStoreMockRecorder) Disable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockKeyStore)(nil).Disable), ctx, id)
+}
+
 // Delete mocks base method
 func (m *MockKeyStore) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -125,10 +183,10 @@ func (mr *MockKeyStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 }
 
 // GetDeleted mocks base method
-func (m *MockKeyStore) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
+func (m *MockKeyStore) GetDeleted(ctx context.Context, id string) (*entities0.Key, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
-	ret0, _ := ret[0].(*entities.Key)
+	ret0, _ := ret[0].(*entities0.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -183,7 +241,7 @@ func (mr *MockKeyStoreMockRecorder) Destroy(ctx, id interface{}) *gomock.Call {
 }
 
 // Sign mocks base method
-func (m *MockKeyStore) Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+func (m *MockKeyStore) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Sign", ctx, id, data, algo)
 	ret0, _ := ret[0].([]byte)
@@ -198,31 +256,121 @@ func (mr *MockKeyStoreMockRecorder) Sign(ctx, id, data, algo interface{}) *gomoc
 }
 
 // Encrypt mocks base method
-func (m *MockKeyStore) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+func (m *MockKeyStore) Encrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Encrypt", ctx, id, data)
+	ret := m.ctrl.Call(m, "Encrypt", ctx, id, data, algo)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Encrypt indicates an expected call of Encrypt
-func (mr *MockKeyStoreMockRecorder) Encrypt(ctx, id, data interface{}) *gomock.Call {
+func (mr *MockKeyStoreMockRecorder) Encrypt(ctx, id, data, algo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockKeyStore)(nil).Encrypt), ctx, id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockKeyStore)(nil).Encrypt), ctx, id, data, algo)
 }
 
 // Decrypt mocks base method
-func (m *MockKeyStore) Decrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+func (m *MockKeyStore) Decrypt(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Decrypt", ctx, id, data)
+	ret := m.ctrl.Call(m, "Decrypt", ctx, id, data, algo)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Decrypt indicates an expected call of Decrypt
-func (mr *MockKeyStoreMockRecorder) Decrypt(ctx, id, data interface{}) *gomock.Call {
+func (mr *MockKeyStoreMockRecorder) Decrypt(ctx, id, data, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeyStore)(nil).Decrypt), ctx, id, data, algo)
+}
+
+// Export mocks base method
+func (m *MockKeyStore) Export(ctx context.Context, id string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export
+func (mr *MockKeyStoreMockRecorder) Export(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockKeyStore)(nil).Export), ctx, id)
+}
+
+// MockPublicKeyEncrypter is a mock of PublicKeyEncrypter interface
+type MockPublicKeyEncrypter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicKeyEncrypterMockRecorder
+}
+
+// MockPublicKeyEncrypterMockRecorder is the mock recorder for MockPublicKeyEncrypter
+type MockPublicKeyEncrypterMockRecorder struct {
+	mock *MockPublicKeyEncrypter
+}
+
+// NewMockPublicKeyEncrypter creates a new mock instance
+func NewMockPublicKeyEncrypter(ctrl *gomock.Controller) *MockPublicKeyEncrypter {
+	mock := &MockPublicKeyEncrypter{ctrl: ctrl}
+	mock.recorder = &MockPublicKeyEncrypterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPublicKeyEncrypter) EXPECT() *MockPublicKeyEncrypterMockRecorder {
+	return m.recorder
+}
+
+// EncryptWithPublicKey mocks base method
+func (m *MockPublicKeyEncrypter) EncryptWithPublicKey(pubKey, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptWithPublicKey", pubKey, data, algo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptWithPublicKey indicates an expected call of EncryptWithPublicKey
+func (mr *MockPublicKeyEncrypterMockRecorder) EncryptWithPublicKey(pubKey, data, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptWithPublicKey", reflect.TypeOf((*MockPublicKeyEncrypter)(nil).EncryptWithPublicKey), pubKey, data, algo)
+}
+
+// MockKeyVersionDestroyer is a mock of KeyVersionDestroyer interface
+type MockKeyVersionDestroyer struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyVersionDestroyerMockRecorder
+}
+
+// MockKeyVersionDestroyerMockRecorder is the mock recorder for MockKeyVersionDestroyer
+type MockKeyVersionDestroyerMockRecorder struct {
+	mock *MockKeyVersionDestroyer
+}
+
+// NewMockKeyVersionDestroyer creates a new mock instance
+func NewMockKeyVersionDestroyer(ctrl *gomock.Controller) *MockKeyVersionDestroyer {
+	mock := &MockKeyVersionDestroyer{ctrl: ctrl}
+	mock.recorder = &MockKeyVersionDestroyerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockKeyVersionDestroyer) EXPECT() *MockKeyVersionDestroyerMockRecorder {
+	return m.recorder
+}
+
+// DestroyVersion mocks base method
+func (m *MockKeyVersionDestroyer) DestroyVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyVersion indicates an expected call of DestroyVersion
+func (mr *MockKeyVersionDestroyerMockRecorder) DestroyVersion(ctx, id, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeyStore)(nil).Decrypt), ctx, id, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVersion", reflect.TypeOf((*MockKeyVersionDestroyer)(nil).DestroyVersion), ctx, id, version)
 }
@@ -79,6 +79,34 @@ func (mr *MockSecretStoreMockRecorder) List(ctx, limit, offset interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSecretStore)(nil).List), ctx, limit, offset)
 }
 
+// Enable mocks base method
+func (m *MockSecretStore) Enable(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enable indicates an expected call of Enable
+func (mr *MockSecretStoreMockRecorder) Enable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockSecretStore)(nil).Enable), ctx, id)
+}
+
+// Disable mocks base method
+func (m *MockSecretStore) Disable(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable
+func (mr *MockSecretStoreMockRecorder) Disable(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockSecretStore)(nil).Disable), ctx, id)
+}
+
 // Delete mocks base method
 func (m *MockSecretStore) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 	context "context"
 	entities "github.com/consensys/quorum-key-manager/src/auth/entities"
 	stores "github.com/consensys/quorum-key-manager/src/stores"
+	entities0 "github.com/consensys/quorum-key-manager/src/stores/entities"
 	common "github.com/ethereum/go-ethereum/common"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -37,31 +38,31 @@ func (m *MockStores) EXPECT() *MockStoresMockRecorder {
 }
 
 // CreateEthereum mocks base method
-func (m *MockStores) CreateEthereum(arg0 context.Context, name, keyStore string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateEthereum(arg0 context.Context, name, keyStore string, hdWallet *entities0.HDWallet, allowedTenants []string, userInfo *entities.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEthereum", arg0, name, keyStore, allowedTenants, userInfo)
+	ret := m.ctrl.Call(m, "CreateEthereum", arg0, name, keyStore, hdWallet, allowedTenants, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateEthereum indicates an expected call of CreateEthereum
-func (mr *MockStoresMockRecorder) CreateEthereum(arg0, name, keyStore, allowedTenants, userInfo interface{}) *gomock.Call {
+func (mr *MockStoresMockRecorder) CreateEthereum(arg0, name, keyStore, hdWallet, allowedTenants, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthereum", reflect.TypeOf((*MockStores)(nil).CreateEthereum), arg0, name, keyStore, allowedTenants, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthereum", reflect.TypeOf((*MockStores)(nil).CreateEthereum), arg0, name, keyStore, hdWallet, allowedTenants, userInfo)
 }
 
 // CreateKey mocks base method
-func (m *MockStores) CreateKey(arg0 context.Context, name, vault, secretStore string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateKey(arg0 context.Context, name, vault, secretStore string, envelopeEncryption *entities0.EnvelopeEncryption, allowedTenants []string, userInfo *entities.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKey", arg0, name, vault, secretStore, allowedTenants, userInfo)
+	ret := m.ctrl.Call(m, "CreateKey", arg0, name, vault, secretStore, envelopeEncryption, allowedTenants, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateKey indicates an expected call of CreateKey
-func (mr *MockStoresMockRecorder) CreateKey(arg0, name, vault, secretStore, allowedTenants, userInfo interface{}) *gomock.Call {
+func (mr *MockStoresMockRecorder) CreateKey(arg0, name, vault, secretStore, envelopeEncryption, allowedTenants, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockStores)(nil).CreateKey), arg0, name, vault, secretStore, allowedTenants, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockStores)(nil).CreateKey), arg0, name, vault, secretStore, envelopeEncryption, allowedTenants, userInfo)
 }
 
 // CreateSecret mocks base method
@@ -165,6 +166,21 @@ func (mr *MockStoresMockRecorder) Ethereum(ctx, storeName, userInfo interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ethereum", reflect.TypeOf((*MockStores)(nil).Ethereum), ctx, storeName, userInfo)
 }
 
+// Validator mocks base method
+func (m *MockStores) Validator(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.ValidatorStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator", ctx, storeName, userInfo)
+	ret0, _ := ret[0].(stores.ValidatorStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validator indicates an expected call of Validator
+func (mr *MockStoresMockRecorder) Validator(ctx, storeName, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStores)(nil).Validator), ctx, storeName, userInfo)
+}
+
 // EthereumByAddr mocks base method
 func (m *MockStores) EthereumByAddr(ctx context.Context, addr common.Address, userInfo *entities.UserInfo) (stores.EthStore, error) {
 	m.ctrl.T.Helper()
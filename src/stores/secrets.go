@@ -18,6 +18,12 @@ type SecretStore interface {
 	// List secrets
 	List(ctx context.Context, limit, offset uint64) ([]string, error)
 
+	// Enable a previously disabled secret
+	Enable(ctx context.Context, id string) error
+
+	// Disable a secret, the value of a disabled secret cannot be retrieved until enabled again
+	Disable(ctx context.Context, id string) error
+
 	// Delete secret not permanently, it can be restored
 	Delete(ctx context.Context, id string) error
 
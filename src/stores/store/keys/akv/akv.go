@@ -12,6 +12,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/infra/akv"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
@@ -45,6 +46,9 @@ func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm,
 	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
 		kty = keyvault.EC
 		crv = keyvault.P256K
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		kty = keyvault.EC
+		crv = keyvault.P256
 	default:
 		errMessage := "not supported elliptic curve and signing algorithm in AKV for creation"
 		logger.Error(errMessage)
@@ -80,6 +84,20 @@ func (s *Store) Import(ctx context.Context, id string, privKey []byte, alg *enti
 		pKeyY = base64.RawURLEncoding.EncodeToString(pKey.Y.Bytes())
 		kty = keyvault.EC
 		crv = keyvault.P256K
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		pKey, pubKey, err := ecdsa.CreateSecp256r1(privKey)
+		if err != nil {
+			errMessage := "invalid private key"
+			s.logger.WithError(err).Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+
+		// Uncompressed public key format: 0x04 || X || Y
+		pKeyD = base64.RawURLEncoding.EncodeToString(pKey)
+		pKeyX = base64.RawURLEncoding.EncodeToString(pubKey[1:33])
+		pKeyY = base64.RawURLEncoding.EncodeToString(pubKey[33:])
+		kty = keyvault.EC
+		crv = keyvault.P256
 	default:
 		errMessage := "not supported signing algorithm and curve combination for import"
 		s.logger.With("signing_algorithm", alg.Type, "elliptic_curve", alg.EllipticCurve).Error(errMessage)
@@ -114,6 +132,22 @@ func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
 	return parseKeyBundleRes(&res), nil
 }
 
+func (s *Store) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	res, err := s.client.GetKey(ctx, id, version)
+	if err != nil {
+		errMessage := "failed to get AKV key version"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return parseKeyBundleRes(&res), nil
+}
+
+// Rotate creates a new AKV key version, creating a key with an existing name generates a new version in AKV
+func (s *Store) Rotate(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	return s.Create(ctx, id, alg, attr)
+}
+
 func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	res, err := s.client.GetKeys(ctx, 0)
 	if err != nil {
@@ -213,6 +247,8 @@ func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities
 	switch {
 	case algo.EllipticCurve == entities2.Secp256k1 && algo.Type == entities2.Ecdsa:
 		akvAlgo = keyvault.ES256K
+	case algo.EllipticCurve == entities2.Secp256r1 && algo.Type == entities2.Ecdsa:
+		akvAlgo = keyvault.ES256
 	default:
 		errMessage := "invalid elliptic curve and signing algorithm combination for signing"
 		logger.With("signing_algorithm", algo.Type, "elliptic_curve", algo.EllipticCurve).Error(errMessage)
@@ -242,10 +278,92 @@ func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entiti
 	return err
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Encrypt encrypts data natively in AKV
+// AKV only supports encryption with RSA keys
+func (s *Store) Encrypt(ctx context.Context, id string, data []byte, _ *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	akvAlgo, err := s.getEncryptionAlgo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	b64Ciphertext, err := s.client.Encrypt(ctx, id, "", akvAlgo, base64.RawURLEncoding.EncodeToString(data))
+	if err != nil {
+		errMessage := "failed to encrypt using AKV key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	ciphertext, err := base64.RawURLEncoding.DecodeString(b64Ciphertext)
+	if err != nil {
+		errMessage := "failed to decode ciphertext from AKV vault"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.AKVError(errMessage)
+	}
+
+	return ciphertext, nil
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Decrypt decrypts data natively in AKV
+// AKV only supports decryption with RSA keys
+func (s *Store) Decrypt(ctx context.Context, id string, data []byte, _ *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	akvAlgo, err := s.getEncryptionAlgo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	b64Plaintext, err := s.client.Decrypt(ctx, id, "", akvAlgo, base64.RawURLEncoding.EncodeToString(data))
+	if err != nil {
+		errMessage := "failed to decrypt using AKV key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	plaintext, err := base64.RawURLEncoding.DecodeString(b64Plaintext)
+	if err != nil {
+		errMessage := "failed to decode plaintext from AKV vault"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.AKVError(errMessage)
+	}
+
+	return plaintext, nil
+}
+
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("key export is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) getEncryptionAlgo(ctx context.Context, id string) (keyvault.JSONWebKeyEncryptionAlgorithm, error) {
+	res, err := s.client.GetKey(ctx, id, "")
+	if err != nil {
+		errMessage := "failed to get AKV key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return "", errors.FromError(err).SetMessage(errMessage)
+	}
+
+	switch res.Key.Kty {
+	case keyvault.RSA, keyvault.RSAHSM:
+		return keyvault.RSAOAEP256, nil
+	default:
+		errMessage := "AKV key type does not support encryption"
+		s.logger.With("id", id, "key_type", res.Key.Kty).Error(errMessage)
+		return "", errors.NotSupportedError(errMessage)
+	}
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
 }
@@ -29,12 +29,17 @@ const (
 	id        = "my-key"
 	publicKey = "0x04555214986a521f43409c1c6b236db1674332faaaf11fc42a7047ab07781ebe6f0974f2265a8a7d82208f88c21a2c55663b33e5af92d919252511638e82dff8b2"
 	privKey   = "db337ca3295e4050586793f252e641f3b3a83739018fa4cce01a81ca920e7e1c"
+
+	publicKeySecp256r1 = "0x04e035cce1b135aeec665cdc37a8343b81b4e2350a73f053c0e03d1088df7f58f9f2c184736d0dc08d2792dca4f4a62204821f71e1c59951fe8c95f495eec54fc2"
 )
 
 var (
 	base64PrivKey = "2zN8oyleQFBYZ5PyUuZB87OoNzkBj6TM4BqBypIOfhw"
 	base64PubKeyX = "VVIUmGpSH0NAnBxrI22xZ0My-qrxH8QqcEerB3gevm8"
 	base64PubKeyY = "CXTyJlqKfYIgj4jCGixVZjsz5a-S2RklJRFjjoLf-LI"
+
+	base64PubKeySecp256r1X = "4DXM4bE1ruxmXNw3qDQ7gbTiNQpz8FPA4D0QiN9_WPk"
+	base64PubKeySecp256r1Y = "8sGEc20NwI0nktyk9KYiBIIfceHFmVH-jJX0le7FT8I"
 )
 
 type akvKeyStoreTestSuite struct {
@@ -92,6 +97,29 @@ func (s *akvKeyStoreTestSuite) TestCreate() {
 		assert.False(s.T(), key.Metadata.Disabled)
 		assert.Equal(s.T(), version, key.Metadata.Version)
 	})
+
+	s.Run("should create a new secp256r1 key successfully", func() {
+		p256Key := akvKey
+		p256Key.Key = &akv.JSONWebKey{
+			Kid: &akvKeyID,
+			Crv: akv.P256,
+			Kty: akv.EC,
+			X:   &base64PubKeySecp256r1X,
+			Y:   &base64PubKeySecp256r1Y,
+		}
+		s.mockVault.EXPECT().CreateKey(gomock.Any(), id, akv.EC, akv.P256, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(p256Key, nil)
+
+		key, err := s.keyStore.Create(ctx, id, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256r1,
+		}, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), publicKeySecp256r1, hexutil.Encode(key.PublicKey))
+		assert.Equal(s.T(), entities.Ecdsa, key.Algo.Type)
+		assert.Equal(s.T(), entities.Secp256r1, key.Algo.EllipticCurve)
+	})
 }
 
 func (s *akvKeyStoreTestSuite) TestImport() {
@@ -138,6 +166,36 @@ func (s *akvKeyStoreTestSuite) TestImport() {
 		assert.False(s.T(), key.Metadata.Disabled)
 		assert.Equal(s.T(), version, key.Metadata.Version)
 	})
+
+	s.Run("should import a secp256r1 key successfully", func() {
+		p256Key := akvKey
+		p256Key.Key = &akv.JSONWebKey{
+			Kid: &akvKeyID,
+			Crv: akv.P256,
+			Kty: akv.EC,
+			X:   &base64PubKeySecp256r1X,
+			Y:   &base64PubKeySecp256r1Y,
+		}
+		s.mockVault.EXPECT().ImportKey(gomock.Any(), id, gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, keyName string, k *akv.JSONWebKey, attr *akv.KeyAttributes, tags map[string]string) (akv.KeyBundle, error) {
+				require.Equal(s.T(), k.Crv, akv.P256)
+				require.Equal(s.T(), k.Kty, akv.EC)
+				require.Equal(s.T(), *k.D, base64PrivKey)
+				require.Equal(s.T(), *k.X, base64PubKeySecp256r1X)
+				require.Equal(s.T(), *k.Y, base64PubKeySecp256r1Y)
+				return p256Key, nil
+			})
+
+		privKeyB, _ := hex.DecodeString(privKey)
+		key, err := s.keyStore.Import(ctx, id, privKeyB, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256r1,
+		}, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), publicKeySecp256r1, hexutil.Encode(key.PublicKey))
+		assert.Equal(s.T(), entities.Secp256r1, key.Algo.EllipticCurve)
+	})
 }
 
 func (s *akvKeyStoreTestSuite) TestGet() {
@@ -180,6 +238,37 @@ func (s *akvKeyStoreTestSuite) TestGet() {
 	})
 }
 
+func (s *akvKeyStoreTestSuite) TestGetVersion() {
+	ctx := context.Background()
+	version := "1234"
+
+	akvKeyID := fmt.Sprintf("keyvault.com/keys/%s/%s", id, version)
+	akvKey := akv.KeyBundle{
+		Attributes: &akv.KeyAttributes{
+			Enabled: common.ToPtr(true).(*bool),
+			Created: common.ToPtr(date.NewUnixTimeFromNanoseconds(time.Now().UnixNano())).(*date.UnixTime),
+			Updated: common.ToPtr(date.NewUnixTimeFromNanoseconds(time.Now().UnixNano())).(*date.UnixTime),
+		},
+		Key: &akv.JSONWebKey{
+			Kid: &akvKeyID,
+			Crv: akv.P256K,
+			Kty: akv.EC,
+			X:   &base64PubKeyX,
+			Y:   &base64PubKeyY,
+		},
+	}
+
+	s.Run("should get a key version successfully", func() {
+		s.mockVault.EXPECT().GetKey(gomock.Any(), id, version).Return(akvKey, nil)
+
+		key, err := s.keyStore.GetVersion(ctx, id, version)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), publicKey, hexutil.Encode(key.PublicKey))
+		assert.Equal(s.T(), version, key.Metadata.Version)
+	})
+}
+
 func (s *akvKeyStoreTestSuite) TestList() {
 	ctx := context.Background()
 	expectedIds := []interface{}{"my-key1", "my-key2"}
@@ -237,4 +326,16 @@ func (s *akvKeyStoreTestSuite) TestSign() {
 		assert.NoError(s.T(), err)
 		assert.Equal(s.T(), hexutil.Encode(signature), expectedSignature)
 	})
+
+	s.Run("should sign payload with a secp256r1 key successfully", func() {
+		s.mockVault.EXPECT().Sign(gomock.Any(), id, "", akv.ES256, b64Payload).Return(b64Sig, nil)
+
+		signature, err := s.keyStore.Sign(ctx, id, payload, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256r1,
+		})
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), hexutil.Encode(signature), expectedSignature)
+	})
 }
@@ -2,6 +2,7 @@ package akv
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"encoding/base64"
 	"math/big"
 	"strings"
@@ -46,8 +47,11 @@ func algoFromAKVKeyTypeCrv(kty keyvault.JSONWebKeyType, crv keyvault.JSONWebKeyC
 		algo.Type = entities2.Ecdsa
 	}
 
-	if crv == keyvault.P256K {
+	switch crv {
+	case keyvault.P256K:
 		algo.EllipticCurve = entities2.Secp256k1
+	case keyvault.P256:
+		algo.EllipticCurve = entities2.Secp256r1
 	}
 
 	return algo
@@ -60,6 +64,10 @@ func pubKeyBytes(key *keyvault.JSONWebKey) []byte {
 		yBytes, _ := decodePubKeyBase64(*key.Y)
 		pKey := ecdsa.PublicKey{X: new(big.Int).SetBytes(xBytes), Y: new(big.Int).SetBytes(yBytes)}
 		return crypto.FromECDSAPub(&pKey)
+	case key.Kty == keyvault.EC && key.Crv == keyvault.P256:
+		xBytes, _ := decodePubKeyBase64(*key.X)
+		yBytes, _ := decodePubKeyBase64(*key.Y)
+		return elliptic.Marshal(elliptic.P256(), new(big.Int).SetBytes(xBytes), new(big.Int).SetBytes(yBytes))
 	default:
 		return nil
 	}
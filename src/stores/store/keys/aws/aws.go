@@ -24,6 +24,7 @@ type Store struct {
 }
 
 var _ stores.KeyStore = &Store{}
+var _ stores.KeyVersionDestroyer = &Store{}
 
 func New(client aws.KmsClient, logger log.Logger) *Store {
 	return &Store{
@@ -37,18 +38,12 @@ func (s *Store) Info(context.Context) (*entities.Store, error) {
 }
 
 func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
-	var keyType string
-
-	switch {
-	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
-		keyType = kms.CustomerMasterKeySpecEccSecgP256k1
-	default:
-		errMessage := "invalid or not supported elliptic curve and signing algorithm for AWS key creation"
-		s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
-		return nil, errors.NotSupportedError(errMessage)
+	keyType, err := s.keySpec(alg)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err := s.client.CreateKey(ctx, alias(id), keyType, toTags(attr.Tags))
+	_, err = s.client.CreateKey(ctx, alias(id), keyType, toTags(attr.Tags))
 	if err != nil {
 		errMessage := "failed to create AWS key"
 		s.logger.With("id", id).WithError(err).Error(errMessage)
@@ -63,19 +58,62 @@ func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm,
 	return key, nil
 }
 
-// Import an externally created key and stores it
-// this feature is not supported by AWS kms
-// always returns errors.ErrNotSupported
-func (s *Store) Import(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
-	err := errors.NotSupportedError("import secret is not supported")
-	s.logger.Warn(err.Error())
-	return nil, err
+// Import creates an AWS key with external origin and imports the private key as its key material
+func (s *Store) Import(ctx context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	keyType, err := s.keySpec(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	keyMaterial, err := toPKCS8(privKey, alg)
+	if err != nil {
+		errMessage := "invalid private key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	_, err = s.client.ImportKey(ctx, alias(id), keyType, keyMaterial, toTags(attr.Tags))
+	if err != nil {
+		errMessage := "failed to import AWS key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return s.Get(ctx, id)
 }
 
 func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
+	return s.getKey(ctx, id, alias(id))
+}
+
+// GetVersion gets a version of a key, the version being the identifier of the AWS key the alias pointed to
+func (s *Store) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	return s.getKey(ctx, id, version)
+}
+
+// Rotate creates a new AWS key and points the alias of the key to it, the previous AWS key remains available by its identifier
+func (s *Store) Rotate(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	keyType, err := s.keySpec(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.RotateKey(ctx, alias(id), keyType, toTags(attr.Tags))
+	if err != nil {
+		errMessage := "failed to rotate AWS key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return s.Get(ctx, id)
+}
+
+func (s *Store) getKey(ctx context.Context, id, keyRef string) (*entities.Key, error) {
 	logger := s.logger.With("id", id)
 
-	outDescribe, err := s.client.DescribeKey(ctx, alias(id))
+	outDescribe, err := s.client.DescribeKey(ctx, keyRef)
 	if err != nil {
 		errMessage := "failed to get AWS key"
 		logger.WithError(err).Error(errMessage)
@@ -195,16 +233,98 @@ func (s *Store) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Key, error) {
-	err := errors.NotSupportedError("get deleted key is not supported")
-	s.logger.Warn(err.Error())
-	return nil, err
+// GetDeleted gets a key scheduled for deletion, without its public key as AWS KMS does not return it in this state
+func (s *Store) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	outDescribe, err := s.client.DescribeKey(ctx, alias(id))
+	if err != nil {
+		errMessage := "failed to get AWS key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if *outDescribe.KeyMetadata.KeyState != kms.KeyStatePendingDeletion {
+		errMessage := "AWS key is not scheduled for deletion"
+		logger.Debug(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+	keyID := *outDescribe.KeyMetadata.KeyId
+
+	tags, err := s.listTags(ctx, keyID)
+	if err != nil {
+		errMessage := "failed to list AWS key tags"
+		logger.With("key_id", keyID).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	key, err := parseDeletedKey(id, outDescribe, tags)
+	if err != nil {
+		errMessage := "failed to parse key retrieved from AWS KMS"
+		logger.With("key_id", keyID).WithError(err).Error(errMessage)
+		return nil, errors.AWSError(errMessage)
+	}
+
+	return key, nil
 }
 
-func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
-	err := errors.NotSupportedError("list deleted keys is not supported")
-	s.logger.Warn(err.Error())
-	return nil, err
+// ListDeleted lists the keys scheduled for deletion
+// ListDeleted lists the keys pending deletion, skipping the first offset ones and returning at most limit of them if set
+func (s *Store) ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error) {
+	var ids []string
+	var skipped uint64
+	nextMarker := ""
+
+	for {
+		ret, err := s.client.ListKeys(ctx, 0, nextMarker)
+		if err != nil {
+			errMessage := "failed to list AWS keys"
+			s.logger.WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		}
+
+		for _, key := range ret.Keys {
+			outDescribe, err := s.client.DescribeKey(ctx, *key.KeyId)
+			if err != nil {
+				errMessage := "failed to get AWS key"
+				s.logger.With("keyID", *key.KeyId).WithError(err).Error(errMessage)
+				return nil, errors.FromError(err).SetMessage(errMessage)
+			}
+
+			if *outDescribe.KeyMetadata.KeyState != kms.KeyStatePendingDeletion {
+				continue
+			}
+
+			keyAlias, err := s.client.GetAlias(ctx, *key.KeyId)
+			if err != nil {
+				errMessage := "failed to get AWS key alias"
+				s.logger.With("keyID", *key.KeyId).WithError(err).Error(errMessage)
+				return nil, errors.FromError(err).SetMessage(errMessage)
+			}
+
+			// Previous versions of rotated keys have no alias
+			if keyAlias == "" {
+				continue
+			}
+
+			if skipped < offset {
+				skipped++
+				continue
+			}
+
+			ids = append(ids, keyAlias)
+			if limit != 0 && uint64(len(ids)) == limit {
+				return ids, nil
+			}
+		}
+
+		if ret.NextMarker == nil {
+			break
+		}
+		nextMarker = *ret.NextMarker
+	}
+
+	return ids, nil
 }
 
 func (s *Store) Restore(ctx context.Context, id string) error {
@@ -224,13 +344,38 @@ func (s *Store) Restore(ctx context.Context, id string) error {
 	return nil
 }
 
-// Destroy destroys an externally created key and stores it
-// this feature is not supported by AWS kms
-// always returns errors.ErrNotSupported
-func (s *Store) Destroy(_ context.Context, _ string) error {
-	err := errors.NotSupportedError("destroy key is not supported")
-	s.logger.Warn(err.Error())
-	return err
+// Destroy schedules the deletion of the AWS key if it is not already pending deletion.
+// AWS KMS deletes the key at the end of the waiting period, it can no longer be restored after that
+func (s *Store) Destroy(ctx context.Context, id string) error {
+	return s.scheduleDeletion(ctx, alias(id), s.logger.With("id", id))
+}
+
+// DestroyVersion schedules the deletion of a previous version of a key, each version being a distinct AWS key
+func (s *Store) DestroyVersion(ctx context.Context, id, version string) error {
+	return s.scheduleDeletion(ctx, version, s.logger.With("id", id, "version", version))
+}
+
+func (s *Store) scheduleDeletion(ctx context.Context, keyRef string, logger log.Logger) error {
+	outDescribe, err := s.client.DescribeKey(ctx, keyRef)
+	if err != nil {
+		errMessage := "failed to get AWS key"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if *outDescribe.KeyMetadata.KeyState == kms.KeyStatePendingDeletion {
+		logger.Debug("AWS key already scheduled for deletion")
+		return nil
+	}
+
+	_, err = s.client.DeleteKey(ctx, *outDescribe.KeyMetadata.KeyId)
+	if err != nil {
+		errMessage := "failed to schedule AWS key deletion"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
 }
 
 func (s *Store) Sign(ctx context.Context, id string, data []byte, _ *entities2.Algorithm) ([]byte, error) {
@@ -240,7 +385,7 @@ func (s *Store) Sign(ctx context.Context, id string, data []byte, _ *entities2.A
 		return nil, err
 	}
 
-	// TODO: Only sign with ECDSA, extract the algorithm from the key when more keys are available
+	// Both secp256k1 and secp256r1 keys sign with ECDSA_SHA_256
 	outSignature, err := s.client.Sign(ctx, keyID, data, kms.SigningAlgorithmSpecEcdsaSha256)
 	if err != nil {
 		errMessage := "failed to sign using AWS key"
@@ -264,12 +409,81 @@ func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entiti
 	return err
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Encrypt encrypts data natively in AWS KMS
+// only keys created with the ENCRYPT_DECRYPT key usage support encryption
+func (s *Store) Encrypt(ctx context.Context, id string, data []byte, _ *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	keyID, encryptionAlgo, err := s.getEncryptionKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.client.Encrypt(ctx, keyID, data, encryptionAlgo)
+	if err != nil {
+		errMessage := "failed to encrypt using AWS key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return out.CiphertextBlob, nil
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+// Decrypt decrypts data natively in AWS KMS
+// only keys created with the ENCRYPT_DECRYPT key usage support decryption
+func (s *Store) Decrypt(ctx context.Context, id string, data []byte, _ *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	keyID, encryptionAlgo, err := s.getEncryptionKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := s.client.Decrypt(ctx, keyID, data, encryptionAlgo)
+	if err != nil {
+		errMessage := "failed to decrypt using AWS key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return out.Plaintext, nil
+}
+
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("key export is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) getEncryptionKey(ctx context.Context, id string) (keyID, encryptionAlgo string, err error) {
+	outDescribe, err := s.client.DescribeKey(ctx, alias(id))
+	if err != nil {
+		errMessage := "failed to get AWS key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return "", "", errors.FromError(err).SetMessage(errMessage)
+	}
+
+	encryptionAlgo = encryptionAlgorithm(outDescribe.KeyMetadata)
+	if encryptionAlgo == "" {
+		errMessage := "AWS key does not support encryption"
+		s.logger.With("id", id).Error(errMessage)
+		return "", "", errors.NotSupportedError(errMessage)
+	}
+
+	return *outDescribe.KeyMetadata.KeyId, encryptionAlgo, nil
+}
+
+func (s *Store) keySpec(alg *entities2.Algorithm) (string, error) {
+	switch {
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
+		return kms.CustomerMasterKeySpecEccSecgP256k1, nil
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		return kms.CustomerMasterKeySpecEccNistP256, nil
+	default:
+		errMessage := "invalid or not supported elliptic curve and signing algorithm for AWS key creation"
+		s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return "", errors.NotSupportedError(errMessage)
+	}
 }
 
 func (s *Store) getAWSKeyID(ctx context.Context, id string) (string, error) {
@@ -308,3 +522,15 @@ func (s *Store) listTags(ctx context.Context, keyID string) (map[string]string,
 func alias(id string) string {
 	return fmt.Sprintf("%s/%s", aliasPrefix, id)
 }
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
@@ -2,6 +2,8 @@ package aws
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
 	"encoding/base64"
 	"fmt"
 	"testing"
@@ -10,7 +12,6 @@ import (
 	"github.com/consensys/quorum-key-manager/src/entities"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
-	"github.com/consensys/quorum-key-manager/src/stores"
 
 	"github.com/consensys/quorum-key-manager/src/infra/aws/mocks"
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
@@ -19,8 +20,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -34,7 +37,7 @@ var expectedErr = errors.AWSError("error")
 type awsKeyStoreTestSuite struct {
 	suite.Suite
 	mockKmsClient *mocks.MockKmsClient
-	keyStore      stores.KeyStore
+	keyStore      *Store
 }
 
 func TestAWSKeyStore(t *testing.T) {
@@ -81,6 +84,33 @@ func (s *awsKeyStoreTestSuite) TestCreate() {
 		assert.ObjectsAreEqualValues(testutils2.FakeTags(), key.Tags)
 	})
 
+	s.Run("should create a new secp256r1 key successfully", func() {
+		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), alias(id), kms.CustomerMasterKeySpecEccNistP256, gomock.Any()).Return(&retCreateKey, nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(fakeGetSecp256r1PubKey(keyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(retListTags, nil)
+
+		key, err := s.keyStore.Create(ctx, id, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256r1,
+		}, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), entities.Ecdsa, key.Algo.Type)
+		assert.Equal(s.T(), entities.Secp256r1, key.Algo.EllipticCurve)
+		assert.Equal(s.T(), "0x04e035cce1b135aeec665cdc37a8343b81b4e2350a73f053c0e03d1088df7f58f9f2c184736d0dc08d2792dca4f4a62204821f71e1c59951fe8c95f495eec54fc2", hexutil.Encode(key.PublicKey))
+	})
+
+	s.Run("should fail with NotSupportedError if algorithm is not supported", func() {
+		key, err := s.keyStore.Create(ctx, id, &entities.Algorithm{
+			Type:          entities.Eddsa,
+			EllipticCurve: entities.Babyjubjub,
+		}, attributes)
+		assert.Nil(s.T(), key)
+
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
 	s.Run("should fail with same error if CreateKey fails", func() {
 		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
 
@@ -101,13 +131,106 @@ func (s *awsKeyStoreTestSuite) TestCreate() {
 	})
 }
 
+func (s *awsKeyStoreTestSuite) TestRotate() {
+	ctx := context.Background()
+	attributes := testutils2.FakeAttributes()
+	algorithm := testutils2.FakeAlgorithm()
+	newKeyID := "new-key-ID"
+
+	s.Run("should rotate a key by creating a new AWS key behind the alias", func() {
+		s.mockKmsClient.EXPECT().RotateKey(gomock.Any(), alias(id), kms.CustomerMasterKeySpecEccSecgP256k1, gomock.Any()).Return(&kms.CreateKeyOutput{
+			KeyMetadata: &kms.KeyMetadata{KeyId: aws.String(newKeyID)},
+		}, nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakeDescribeKey(newKeyID), nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, newKeyID).Return(fakeGetPubKey(newKeyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, newKeyID, "").Return(fakeListTags(), nil)
+
+		key, err := s.keyStore.Rotate(ctx, id, algorithm, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), id, key.ID)
+		assert.Equal(s.T(), newKeyID, key.Metadata.Version)
+	})
+
+	s.Run("should fail with same error if RotateKey fails", func() {
+		s.mockKmsClient.EXPECT().RotateKey(gomock.Any(), alias(id), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		_, err := s.keyStore.Rotate(ctx, id, algorithm, attributes)
+
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
+func (s *awsKeyStoreTestSuite) TestGetVersion() {
+	ctx := context.Background()
+
+	s.Run("should get a previous version of a key by its AWS key ID", func() {
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, keyID).Return(fakeDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(fakeGetPubKey(keyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(fakeListTags(), nil)
+
+		key, err := s.keyStore.GetVersion(ctx, id, keyID)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), id, key.ID)
+		assert.Equal(s.T(), keyID, key.Metadata.Version)
+	})
+}
+
 func (s *awsKeyStoreTestSuite) TestImport() {
 	ctx := context.Background()
+	attributes := testutils2.FakeAttributes()
+	privKey := hexutil.MustDecode("0xdb337ca3295e4050586793f252e641f3b3a83739018fa4cce01a81ca920e7e1c")
+
+	s.Run("should import a secp256k1 key successfully", func() {
+		s.mockKmsClient.EXPECT().ImportKey(ctx, alias(id), kms.CustomerMasterKeySpecEccSecgP256k1, gomock.Any(), gomock.Any()).Return(&kms.CreateKeyOutput{}, nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakeDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(fakeGetPubKey(keyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(fakeListTags(), nil)
+
+		key, err := s.keyStore.Import(ctx, id, privKey, testutils2.FakeAlgorithm(), attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), id, key.ID)
+		assert.Equal(s.T(), entities.Secp256k1, key.Algo.EllipticCurve)
+	})
+
+	s.Run("should import a secp256r1 key as PKCS#8 key material successfully", func() {
+		algorithm := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256r1}
+
+		s.mockKmsClient.EXPECT().ImportKey(ctx, alias(id), kms.CustomerMasterKeySpecEccNistP256, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, keyMaterial []byte, _ []*kms.Tag) (*kms.CreateKeyOutput, error) {
+				parsedKey, err := x509.ParsePKCS8PrivateKey(keyMaterial)
+				require.NoError(s.T(), err)
+				assert.Equal(s.T(), privKey, parsedKey.(*ecdsa.PrivateKey).D.Bytes())
+				return &kms.CreateKeyOutput{}, nil
+			})
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakeDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(fakeGetSecp256r1PubKey(keyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(fakeListTags(), nil)
+
+		key, err := s.keyStore.Import(ctx, id, privKey, algorithm, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), entities.Secp256r1, key.Algo.EllipticCurve)
+	})
 
-	s.Run("should return NotSupportedError", func() {
-		_, err := s.keyStore.Import(ctx, "my-id", []byte(""), testutils2.FakeAlgorithm(), testutils2.FakeAttributes())
+	s.Run("should fail with NotSupportedError if algorithm is not supported", func() {
+		_, err := s.keyStore.Import(ctx, id, privKey, &entities.Algorithm{Type: entities.Eddsa, EllipticCurve: entities.Curve25519}, attributes)
 		assert.True(s.T(), errors.IsNotSupportedError(err))
 	})
+
+	s.Run("should fail with InvalidParameterError if private key is invalid", func() {
+		_, err := s.keyStore.Import(ctx, id, []byte("invalid"), testutils2.FakeAlgorithm(), attributes)
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
+	})
+
+	s.Run("should fail with same error if ImportKey fails", func() {
+		s.mockKmsClient.EXPECT().ImportKey(ctx, alias(id), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		_, err := s.keyStore.Import(ctx, id, privKey, testutils2.FakeAlgorithm(), attributes)
+		assert.Equal(s.T(), expectedErr, err)
+	})
 }
 
 func (s *awsKeyStoreTestSuite) TestSign_One() {
@@ -283,45 +406,185 @@ func (s *awsKeyStoreTestSuite) TestRestore() {
 func (s *awsKeyStoreTestSuite) TestGetDeleted() {
 	ctx := context.Background()
 
-	s.Run("should return NotSupportedError", func() {
-		_, err := s.keyStore.GetDeleted(ctx, "my-id")
-		assert.True(s.T(), errors.IsNotSupportedError(err))
+	s.Run("should get a key pending deletion successfully", func() {
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakePendingDeletionDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(fakeListTags(), nil)
+
+		key, err := s.keyStore.GetDeleted(ctx, id)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), id, key.ID)
+		assert.Equal(s.T(), entities.Secp256k1, key.Algo.EllipticCurve)
+		assert.True(s.T(), key.Metadata.Disabled)
+		assert.NotEmpty(s.T(), key.Metadata.DeletedAt)
+		assert.Equal(s.T(), testutils2.FakeTags(), key.Tags)
+	})
+
+	s.Run("should fail with NotFoundError if key is not pending deletion", func() {
+		retDescribeKey := fakeDescribeKey(keyID)
+		retDescribeKey.KeyMetadata.KeyState = aws.String(kms.KeyStateEnabled)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+
+		_, err := s.keyStore.GetDeleted(ctx, id)
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+
+	s.Run("should fail with same error if DescribeKey fails", func() {
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(nil, expectedErr)
+
+		_, err := s.keyStore.GetDeleted(ctx, id)
+		assert.Equal(s.T(), expectedErr, err)
 	})
 }
 
 func (s *awsKeyStoreTestSuite) TestListDeleted() {
 	ctx := context.Background()
+	enabledKeyID := "enabled-key-ID"
+	retDescribeKey := fakeDescribeKey(enabledKeyID)
+	retDescribeKey.KeyMetadata.KeyState = aws.String(kms.KeyStateEnabled)
+
+	s.Run("should list keys pending deletion successfully", func() {
+		s.mockKmsClient.EXPECT().ListKeys(ctx, int64(0), "").Return(&kms.ListKeysOutput{
+			Keys: []*kms.KeyListEntry{{KeyId: aws.String(keyID)}, {KeyId: aws.String(enabledKeyID)}},
+		}, nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, keyID).Return(fakePendingDeletionDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, enabledKeyID).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().GetAlias(ctx, keyID).Return(id, nil)
+
+		ids, err := s.keyStore.ListDeleted(ctx, 0, 0)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{id}, ids)
+	})
+
+	s.Run("should apply limit and offset to the keys pending deletion", func() {
+		s.mockKmsClient.EXPECT().ListKeys(ctx, int64(0), "").Return(&kms.ListKeysOutput{
+			Keys:       []*kms.KeyListEntry{{KeyId: aws.String("key-1")}, {KeyId: aws.String(enabledKeyID)}},
+			NextMarker: aws.String("marker"),
+		}, nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, "key-1").Return(fakePendingDeletionDescribeKey("key-1"), nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, enabledKeyID).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().GetAlias(ctx, "key-1").Return("my-key-1", nil)
+		s.mockKmsClient.EXPECT().ListKeys(ctx, int64(0), "marker").Return(&kms.ListKeysOutput{
+			Keys: []*kms.KeyListEntry{{KeyId: aws.String("key-2")}, {KeyId: aws.String("key-3")}},
+		}, nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, "key-2").Return(fakePendingDeletionDescribeKey("key-2"), nil)
+		s.mockKmsClient.EXPECT().GetAlias(ctx, "key-2").Return("my-key-2", nil)
+
+		ids, err := s.keyStore.ListDeleted(ctx, 1, 1)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"my-key-2"}, ids)
+	})
+
+	s.Run("should fail with same error if ListKeys fails", func() {
+		s.mockKmsClient.EXPECT().ListKeys(ctx, int64(0), "").Return(nil, expectedErr)
 
-	s.Run("should return NotSupportedError", func() {
 		_, err := s.keyStore.ListDeleted(ctx, 0, 0)
-		assert.True(s.T(), errors.IsNotSupportedError(err))
+		assert.Equal(s.T(), expectedErr, err)
 	})
 }
 
 func (s *awsKeyStoreTestSuite) TestDestroy() {
 	ctx := context.Background()
 
-	s.Run("should return NotSupportedError", func() {
-		err := s.keyStore.Destroy(ctx, "my-id")
-		assert.True(s.T(), errors.IsNotSupportedError(err))
+	s.Run("should schedule the deletion of the key successfully", func() {
+		retDescribeKey := fakeDescribeKey(keyID)
+		retDescribeKey.KeyMetadata.KeyState = aws.String(kms.KeyStateEnabled)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().DeleteKey(ctx, keyID).Return(&kms.ScheduleKeyDeletionOutput{}, nil)
+
+		err := s.keyStore.Destroy(ctx, id)
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should do nothing if the key is already pending deletion", func() {
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakePendingDeletionDescribeKey(keyID), nil)
+
+		err := s.keyStore.Destroy(ctx, id)
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if DeleteKey fails", func() {
+		retDescribeKey := fakeDescribeKey(keyID)
+		retDescribeKey.KeyMetadata.KeyState = aws.String(kms.KeyStateDisabled)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().DeleteKey(ctx, keyID).Return(nil, expectedErr)
+
+		err := s.keyStore.Destroy(ctx, id)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+
+	s.Run("should schedule the deletion of a previous version successfully", func() {
+		versionKeyID := "previous-key-id"
+		retDescribeKey := fakeDescribeKey(versionKeyID)
+		retDescribeKey.KeyMetadata.KeyState = aws.String(kms.KeyStateEnabled)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, versionKeyID).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().DeleteKey(ctx, versionKeyID).Return(&kms.ScheduleKeyDeletionOutput{}, nil)
+
+		err := s.keyStore.DestroyVersion(ctx, id, versionKeyID)
+		assert.NoError(s.T(), err)
 	})
 }
 
 func (s *awsKeyStoreTestSuite) TestEncrypt() {
 	ctx := context.Background()
+	data := []byte("my data")
+	ciphertext := []byte("my ciphertext")
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Encrypt(ctx, "my-id", []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+	s.Run("should encrypt successfully with a symmetric key", func() {
+		retDescribeKey := fakeEncryptionDescribeKey(keyID)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().Encrypt(ctx, keyID, data, kms.EncryptionAlgorithmSpecSymmetricDefault).Return(&kms.EncryptOutput{
+			CiphertextBlob: ciphertext,
+		}, nil)
+
+		result, err := s.keyStore.Encrypt(ctx, id, data, nil)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), ciphertext, result)
+	})
+
+	s.Run("should fail with NotSupportedError if key is a signing key", func() {
+		retDescribeKey := fakeDescribeKey(keyID)
+		retDescribeKey.KeyMetadata.KeyUsage = aws.String(kms.KeyUsageTypeSignVerify)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+
+		_, err := s.keyStore.Encrypt(ctx, id, data, nil)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if Encrypt fails", func() {
+		retDescribeKey := fakeEncryptionDescribeKey(keyID)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().Encrypt(ctx, keyID, data, kms.EncryptionAlgorithmSpecSymmetricDefault).Return(nil, expectedErr)
+
+		_, err := s.keyStore.Encrypt(ctx, id, data, nil)
+		assert.True(s.T(), errors.IsAWSError(err))
 	})
 }
 
 func (s *awsKeyStoreTestSuite) TestDecrypt() {
 	ctx := context.Background()
+	data := []byte("my data")
+	ciphertext := []byte("my ciphertext")
+
+	s.Run("should decrypt successfully with a symmetric key", func() {
+		retDescribeKey := fakeEncryptionDescribeKey(keyID)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
+		s.mockKmsClient.EXPECT().Decrypt(ctx, keyID, ciphertext, kms.EncryptionAlgorithmSpecSymmetricDefault).Return(&kms.DecryptOutput{
+			Plaintext: data,
+		}, nil)
+
+		result, err := s.keyStore.Decrypt(ctx, id, ciphertext, nil)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), data, result)
+	})
+
+	s.Run("should fail with same error if DescribeKey fails", func() {
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(nil, expectedErr)
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Decrypt(ctx, "my-id", []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+		_, err := s.keyStore.Decrypt(ctx, id, ciphertext, nil)
+		assert.True(s.T(), errors.IsAWSError(err))
 	})
 }
 
@@ -360,6 +623,24 @@ func fakeDescribeKey(keyID string) *kms.DescribeKeyOutput {
 	}
 }
 
+func fakePendingDeletionDescribeKey(keyID string) *kms.DescribeKeyOutput {
+	out := fakeDescribeKey(keyID)
+	out.KeyMetadata.Enabled = aws.Bool(false)
+	out.KeyMetadata.KeyState = aws.String(kms.KeyStatePendingDeletion)
+	out.KeyMetadata.KeyUsage = aws.String(kms.KeyUsageTypeSignVerify)
+	out.KeyMetadata.KeySpec = aws.String(kms.CustomerMasterKeySpecEccSecgP256k1)
+
+	return out
+}
+
+func fakeEncryptionDescribeKey(keyID string) *kms.DescribeKeyOutput {
+	out := fakeDescribeKey(keyID)
+	out.KeyMetadata.KeyUsage = aws.String(kms.KeyUsageTypeEncryptDecrypt)
+	out.KeyMetadata.EncryptionAlgorithms = []*string{aws.String(kms.EncryptionAlgorithmSpecSymmetricDefault)}
+
+	return out
+}
+
 func fakeListTags() *kms.ListResourceTagsOutput {
 	truncatedTagList := false
 
@@ -379,3 +660,14 @@ func fakeGetPubKey(keyID string) *kms.GetPublicKeyOutput {
 		KeySpec:   aws.String(kms.CustomerMasterKeySpecEccSecgP256k1),
 	}
 }
+
+func fakeGetSecp256r1PubKey(keyID string) *kms.GetPublicKeyOutput {
+	asn1pubKey, _ := base64.StdEncoding.DecodeString("MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE4DXM4bE1ruxmXNw3qDQ7gbTiNQpz8FPA4D0QiN9/WPnywYRzbQ3AjSeS3KT0piIEgh9x4cWZUf6MlfSV7sVPwg==")
+
+	return &kms.GetPublicKeyOutput{
+		KeyId:     &keyID,
+		PublicKey: asn1pubKey,
+		KeyUsage:  aws.String(kms.KeyUsageTypeSignVerify),
+		KeySpec:   aws.String(kms.CustomerMasterKeySpecEccNistP256),
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type publicKeyInfo struct {
@@ -23,30 +26,40 @@ type signatureInfo struct {
 	R, S *big.Int
 }
 
-func parseKey(id string, kmsPubKey *kms.GetPublicKeyOutput, kmsDescribe *kms.DescribeKeyOutput, tags map[string]string) (*entities.Key, error) {
-	var algo *entities2.Algorithm
-	var pubKey []byte
+// ecPrivateKey is the ECPrivateKey structure of RFC 5915, the curve being given by the PKCS#8 algorithm parameters
+type ecPrivateKey struct {
+	Version    int
+	PrivateKey []byte
+	PublicKey  asn1.BitString `asn1:"optional,explicit,tag:1"`
+}
 
-	switch {
-	case *kmsPubKey.KeyUsage == kms.KeyUsageTypeSignVerify && *kmsPubKey.KeySpec == kms.CustomerMasterKeySpecEccSecgP256k1:
-		algo = &entities2.Algorithm{
-			Type:          entities2.Ecdsa,
-			EllipticCurve: entities2.Secp256k1,
-		}
+type pkcs8PrivateKey struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
 
-		val := &publicKeyInfo{}
-		_, err := asn1.Unmarshal(kmsPubKey.PublicKey, val)
-		if err != nil {
-			return nil, err
-		}
-		pubKey = val.PublicKey.Bytes
-	default:
-		return nil, fmt.Errorf("unsupported public key type returned from AWS KMS")
+var (
+	oidPublicKeyECDSA      = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveP256      = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidNamedCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+func parseKey(id string, kmsPubKey *kms.GetPublicKeyOutput, kmsDescribe *kms.DescribeKeyOutput, tags map[string]string) (*entities.Key, error) {
+	algo, err := parseAlgorithm(kmsPubKey.KeyUsage, kmsPubKey.KeySpec)
+	if err != nil {
+		return nil, err
+	}
+
+	val := &publicKeyInfo{}
+	_, err = asn1.Unmarshal(kmsPubKey.PublicKey, val)
+	if err != nil {
+		return nil, err
 	}
 
 	return &entities.Key{
 		ID:          id,
-		PublicKey:   pubKey,
+		PublicKey:   val.PublicKey.Bytes,
 		Algo:        algo,
 		Metadata:    parseMetadata(kmsDescribe),
 		Tags:        tags,
@@ -54,6 +67,43 @@ func parseKey(id string, kmsPubKey *kms.GetPublicKeyOutput, kmsDescribe *kms.Des
 	}, nil
 }
 
+// parseDeletedKey parses a key pending deletion, whose public key cannot be retrieved from AWS KMS
+func parseDeletedKey(id string, kmsDescribe *kms.DescribeKeyOutput, tags map[string]string) (*entities.Key, error) {
+	algo, err := parseAlgorithm(kmsDescribe.KeyMetadata.KeyUsage, kmsDescribe.KeyMetadata.KeySpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Key{
+		ID:          id,
+		Algo:        algo,
+		Metadata:    parseMetadata(kmsDescribe),
+		Tags:        tags,
+		Annotations: parseAnnotations(*kmsDescribe.KeyMetadata.KeyId, kmsDescribe),
+	}, nil
+}
+
+func parseAlgorithm(keyUsage, keySpec *string) (*entities2.Algorithm, error) {
+	if keyUsage == nil || keySpec == nil || *keyUsage != kms.KeyUsageTypeSignVerify {
+		return nil, fmt.Errorf("unsupported public key type returned from AWS KMS")
+	}
+
+	switch *keySpec {
+	case kms.CustomerMasterKeySpecEccSecgP256k1:
+		return &entities2.Algorithm{
+			Type:          entities2.Ecdsa,
+			EllipticCurve: entities2.Secp256k1,
+		}, nil
+	case kms.CustomerMasterKeySpecEccNistP256:
+		return &entities2.Algorithm{
+			Type:          entities2.Ecdsa,
+			EllipticCurve: entities2.Secp256r1,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type returned from AWS KMS")
+	}
+}
+
 func parseMetadata(describedKey *kms.DescribeKeyOutput) *entities.Metadata {
 	// createdAt field always provided
 	createdAt := describedKey.KeyMetadata.CreationDate
@@ -69,7 +119,7 @@ func parseMetadata(describedKey *kms.DescribeKeyOutput) *entities.Metadata {
 	}
 
 	return &entities.Metadata{
-		Version:   "1",
+		Version:   *describedKey.KeyMetadata.KeyId, // Each version of a key is a distinct KMS key the alias points to
 		Disabled:  !*describedKey.KeyMetadata.Enabled,
 		ExpireAt:  *expireAt,
 		CreatedAt: *createdAt,
@@ -125,3 +175,74 @@ func toTags(tags map[string]string) []*kms.Tag {
 
 	return keyTags
 }
+
+// encryptionAlgorithm returns the preferred encryption algorithm of a key, or an empty string if the key cannot encrypt
+func encryptionAlgorithm(metadata *kms.KeyMetadata) string {
+	if metadata.KeyUsage == nil || *metadata.KeyUsage != kms.KeyUsageTypeEncryptDecrypt {
+		return ""
+	}
+
+	for _, preferred := range []string{kms.EncryptionAlgorithmSpecSymmetricDefault, kms.EncryptionAlgorithmSpecRsaesOaepSha256} {
+		for _, algo := range metadata.EncryptionAlgorithms {
+			if algo != nil && *algo == preferred {
+				return preferred
+			}
+		}
+	}
+
+	if len(metadata.EncryptionAlgorithms) > 0 && metadata.EncryptionAlgorithms[0] != nil {
+		return *metadata.EncryptionAlgorithms[0]
+	}
+
+	return ""
+}
+
+// toPKCS8 encodes a raw ECDSA private key as the DER encoded PKCS#8 key material expected by AWS KMS import
+func toPKCS8(privKey []byte, alg *entities2.Algorithm) ([]byte, error) {
+	var curveOID asn1.ObjectIdentifier
+	var d, pubKey []byte
+
+	switch {
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
+		ecdsaKey, err := crypto.ToECDSA(privKey)
+		if err != nil {
+			return nil, err
+		}
+
+		curveOID = oidNamedCurveSecp256k1
+		d = math.PaddedBigBytes(ecdsaKey.D, 32)
+		pubKey = crypto.FromECDSAPub(&ecdsaKey.PublicKey)
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		var err error
+		d, pubKey, err = ecdsa.CreateSecp256r1(privKey)
+		if err != nil {
+			return nil, err
+		}
+
+		curveOID = oidNamedCurveP256
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm and curve combination")
+	}
+
+	ecKey, err := asn1.Marshal(ecPrivateKey{
+		Version:    1,
+		PrivateKey: d,
+		PublicKey:  asn1.BitString{Bytes: pubKey, BitLength: 8 * len(pubKey)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	curveParams, err := asn1.Marshal(curveOID)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(pkcs8PrivateKey{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: curveParams},
+		},
+		PrivateKey: ecKey,
+	})
+}
@@ -0,0 +1,450 @@
+package gcp
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"sync"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/gcp"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+)
+
+type Store struct {
+	client gcp.KmsClient
+	logger log.Logger
+
+	// activeVersions caches the version used to sign with each key, to avoid resolving it on every signature
+	activeVersions map[string]string
+	mux            sync.RWMutex
+}
+
+var _ stores.KeyStore = &Store{}
+
+func New(client gcp.KmsClient, logger log.Logger) *Store {
+	return &Store{
+		client:         client,
+		logger:         logger,
+		activeVersions: make(map[string]string),
+	}
+}
+
+func (s *Store) Info(context.Context) (*entities.Store, error) {
+	return nil, errors.ErrNotImplemented
+}
+
+func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	algorithm, err := s.keyAlgorithm(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.CreateKey(ctx, id, algorithm, attr.Tags)
+	if err != nil {
+		errMessage := "failed to create GCP key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+	s.resetActiveVersion(id)
+
+	return s.Get(ctx, id)
+}
+
+func (s *Store) Import(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
+	err := errors.NotSupportedError("import key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Get gets the latest enabled version of a key
+func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
+	key, version, err := s.getActiveVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getKey(ctx, id, key, version)
+}
+
+// GetVersion gets a version of a key, the version being the number of the Cloud KMS key version
+func (s *Store) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	logger := s.logger.With("id", id, "version", version)
+
+	key, err := s.client.GetKey(ctx, id)
+	if err != nil {
+		errMessage := "failed to get GCP key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	keyVersion, err := s.client.GetKeyVersion(ctx, id, version)
+	if err != nil {
+		errMessage := "failed to get GCP key version"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return s.getKey(ctx, id, key, keyVersion)
+}
+
+// Rotate creates a new version of the Cloud KMS key, it becomes the active version as the latest enabled one
+func (s *Store) Rotate(ctx context.Context, id string, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
+	_, err := s.client.CreateKeyVersion(ctx, id)
+	if err != nil {
+		errMessage := "failed to rotate GCP key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return s.Get(ctx, id)
+}
+
+func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
+	return s.listKeys(ctx, isActive)
+}
+
+func (s *Store) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
+	_, err := s.client.UpdateKeyLabels(ctx, id, attr.Tags)
+	if err != nil {
+		errMessage := "failed to update GCP key labels"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Delete schedules the destruction of all the versions of the key, they can be restored until the end of the destruction period
+func (s *Store) Delete(ctx context.Context, id string) error {
+	logger := s.logger.With("id", id)
+	defer s.resetActiveVersion(id)
+
+	versions, err := s.client.ListKeyVersions(ctx, id)
+	if err != nil {
+		errMessage := "failed to list GCP key versions"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	for _, version := range versions {
+		if version.State != kmspb.CryptoKeyVersion_ENABLED && version.State != kmspb.CryptoKeyVersion_DISABLED {
+			continue
+		}
+
+		_, err = s.client.DestroyKeyVersion(ctx, id, path.Base(version.Name))
+		if err != nil {
+			errMessage := "failed to schedule GCP key version destruction"
+			logger.With("version", path.Base(version.Name)).WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+	}
+
+	return nil
+}
+
+// GetDeleted gets a key whose versions are all scheduled for destruction, without its public key as Cloud KMS does not return it in this state
+func (s *Store) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	key, err := s.client.GetKey(ctx, id)
+	if err != nil {
+		errMessage := "failed to get GCP key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	versions, err := s.client.ListKeyVersions(ctx, id)
+	if err != nil {
+		errMessage := "failed to list GCP key versions"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if !isDeleted(versions) {
+		errMessage := "GCP key is not scheduled for destruction"
+		logger.Debug(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	deletedKey, err := parseKey(id, key, latestVersion(versions, kmspb.CryptoKeyVersion_DESTROY_SCHEDULED), nil)
+	if err != nil {
+		errMessage := "failed to parse key retrieved from GCP Cloud KMS"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.GCPError(errMessage)
+	}
+
+	return deletedKey, nil
+}
+
+// ListDeleted lists the keys whose versions are all scheduled for destruction
+func (s *Store) ListDeleted(ctx context.Context, _, _ uint64) ([]string, error) {
+	return s.listKeys(ctx, isDeleted)
+}
+
+// Restore cancels the scheduled destruction of the versions of the key
+func (s *Store) Restore(ctx context.Context, id string) error {
+	logger := s.logger.With("id", id)
+	defer s.resetActiveVersion(id)
+
+	versions, err := s.client.ListKeyVersions(ctx, id)
+	if err != nil {
+		errMessage := "failed to list GCP key versions"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	for _, version := range versions {
+		if version.State != kmspb.CryptoKeyVersion_DESTROY_SCHEDULED {
+			continue
+		}
+
+		_, err = s.client.RestoreKeyVersion(ctx, id, path.Base(version.Name))
+		if err != nil {
+			errMessage := "failed to restore GCP key version"
+			logger.With("version", path.Base(version.Name)).WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+	}
+
+	return nil
+}
+
+// Destroy schedules the destruction of the versions of the key not already scheduled for destruction.
+// Cloud KMS destroys them at the end of the destruction period, they can no longer be restored after that
+func (s *Store) Destroy(ctx context.Context, id string) error {
+	return s.Delete(ctx, id)
+}
+
+func (s *Store) Sign(ctx context.Context, id string, data []byte, _ *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	version, err := s.signingVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both secp256k1 and P-256 keys sign a SHA-256 sized digest
+	derSignature, err := s.client.Sign(ctx, id, version, data)
+	if err != nil {
+		// The version may have been disabled or destroyed outside of the key manager, it is resolved again on next signature
+		s.resetActiveVersion(id)
+
+		errMessage := "failed to sign using GCP key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	signature, err := parseSignature(derSignature)
+	if err != nil {
+		errMessage := "failed to parse signature from GCP"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.GCPError(errMessage)
+	}
+
+	return signature, nil
+}
+
+func (s *Store) Verify(_ context.Context, _, _, _ []byte, _ *entities2.Algorithm) error {
+	err := errors.NotSupportedError("verify signature is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Encrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("encrypt is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Decrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("decrypt is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("key export is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) getKey(ctx context.Context, id string, key *kmspb.CryptoKey, version *kmspb.CryptoKeyVersion) (*entities.Key, error) {
+	logger := s.logger.With("id", id, "version", path.Base(version.Name))
+
+	pubKey, err := s.client.GetPublicKey(ctx, id, path.Base(version.Name))
+	if err != nil {
+		errMessage := "failed to get GCP public key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	parsedKey, err := parseKey(id, key, version, pubKey)
+	if err != nil {
+		errMessage := "failed to parse key retrieved from GCP Cloud KMS"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.GCPError(errMessage)
+	}
+
+	return parsedKey, nil
+}
+
+// getActiveVersion returns the latest enabled version of a key, Cloud KMS asymmetric keys having no primary version
+func (s *Store) getActiveVersion(ctx context.Context, id string) (*kmspb.CryptoKey, *kmspb.CryptoKeyVersion, error) {
+	logger := s.logger.With("id", id)
+
+	key, err := s.client.GetKey(ctx, id)
+	if err != nil {
+		errMessage := "failed to get GCP key"
+		logger.WithError(err).Error(errMessage)
+		return nil, nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	versions, err := s.client.ListKeyVersions(ctx, id)
+	if err != nil {
+		errMessage := "failed to list GCP key versions"
+		logger.WithError(err).Error(errMessage)
+		return nil, nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	version := latestVersion(versions, kmspb.CryptoKeyVersion_ENABLED)
+	if version == nil {
+		errMessage := "GCP key has no enabled version"
+		logger.Debug(errMessage)
+		return nil, nil, errors.NotFoundError(errMessage)
+	}
+
+	return key, version, nil
+}
+
+// signingVersion returns the cached active version of a key, resolving it if unknown
+func (s *Store) signingVersion(ctx context.Context, id string) (string, error) {
+	s.mux.RLock()
+	version, ok := s.activeVersions[id]
+	s.mux.RUnlock()
+	if ok {
+		return version, nil
+	}
+
+	versions, err := s.client.ListKeyVersions(ctx, id)
+	if err != nil {
+		errMessage := "failed to list GCP key versions"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return "", errors.FromError(err).SetMessage(errMessage)
+	}
+
+	latest := latestVersion(versions, kmspb.CryptoKeyVersion_ENABLED)
+	if latest == nil {
+		errMessage := "GCP key has no enabled version"
+		s.logger.With("id", id).Debug(errMessage)
+		return "", errors.NotFoundError(errMessage)
+	}
+
+	version = path.Base(latest.Name)
+	s.setActiveVersion(id, version)
+
+	return version, nil
+}
+
+func (s *Store) setActiveVersion(id, version string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.activeVersions[id] = version
+}
+
+func (s *Store) resetActiveVersion(id string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.activeVersions, id)
+}
+
+func (s *Store) listKeys(ctx context.Context, filter func([]*kmspb.CryptoKeyVersion) bool) ([]string, error) {
+	keys, err := s.client.ListKeys(ctx)
+	if err != nil {
+		errMessage := "failed to list GCP keys"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var ids []string
+	for _, key := range keys {
+		id := path.Base(key.Name)
+
+		versions, err := s.client.ListKeyVersions(ctx, id)
+		if err != nil {
+			errMessage := "failed to list GCP key versions"
+			s.logger.With("id", id).WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		}
+
+		if filter(versions) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+func (s *Store) keyAlgorithm(alg *entities2.Algorithm) (kmspb.CryptoKeyVersion_CryptoKeyVersionAlgorithm, error) {
+	switch {
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
+		return kmspb.CryptoKeyVersion_EC_SIGN_SECP256K1_SHA256, nil
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		return kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256, nil
+	default:
+		errMessage := "invalid or not supported elliptic curve and signing algorithm for GCP key creation"
+		s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return kmspb.CryptoKeyVersion_CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED, errors.NotSupportedError(errMessage)
+	}
+}
+
+// latestVersion returns the version in the given state with the highest number, nil if none
+func latestVersion(versions []*kmspb.CryptoKeyVersion, state kmspb.CryptoKeyVersion_CryptoKeyVersionState) *kmspb.CryptoKeyVersion {
+	var latest *kmspb.CryptoKeyVersion
+	latestNumber := 0
+	for _, version := range versions {
+		number, err := strconv.Atoi(path.Base(version.Name))
+		if err != nil || version.State != state {
+			continue
+		}
+
+		if number > latestNumber {
+			latest, latestNumber = version, number
+		}
+	}
+
+	return latest
+}
+
+// isActive indicates whether a key has at least one version that is not destroyed or scheduled for destruction
+func isActive(versions []*kmspb.CryptoKeyVersion) bool {
+	for _, version := range versions {
+		switch version.State {
+		case kmspb.CryptoKeyVersion_ENABLED, kmspb.CryptoKeyVersion_DISABLED, kmspb.CryptoKeyVersion_PENDING_GENERATION:
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDeleted indicates whether a key has versions scheduled for destruction and no active version
+func isDeleted(versions []*kmspb.CryptoKeyVersion) bool {
+	return !isActive(versions) && latestVersion(versions, kmspb.CryptoKeyVersion_DESTROY_SCHEDULED) != nil
+}
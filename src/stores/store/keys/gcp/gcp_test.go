@@ -0,0 +1,262 @@
+package gcp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/gcp/mocks"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	id      = "my-key"
+	keyName = "projects/my-project/locations/global/keyRings/my-key-ring/cryptoKeys/my-key"
+)
+
+var expectedErr = errors.GCPError("error")
+
+type gcpKeyStoreTestSuite struct {
+	suite.Suite
+	mockClient *mocks.MockKmsClient
+	keyStore   stores.KeyStore
+	privKey    *ecdsa.PrivateKey
+	pubKey     *kmspb.PublicKey
+}
+
+func TestGCPKeyStore(t *testing.T) {
+	s := new(gcpKeyStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *gcpKeyStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(s.T(), err)
+	der, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	require.NoError(s.T(), err)
+
+	s.privKey = privKey
+	s.pubKey = &kmspb.PublicKey{
+		Pem:       string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		Algorithm: kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256,
+	}
+	s.mockClient = mocks.NewMockKmsClient(ctrl)
+	s.keyStore = New(s.mockClient, testutils.NewMockLogger(ctrl))
+}
+
+func (s *gcpKeyStoreTestSuite) TestCreate() {
+	ctx := context.Background()
+	attributes := testutils2.FakeAttributes()
+	algorithm := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256r1}
+	key := &kmspb.CryptoKey{Name: keyName, Labels: attributes.Tags}
+
+	s.Run("should create a new key successfully", func() {
+		s.mockClient.EXPECT().CreateKey(ctx, id, kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256, attributes.Tags).Return(key, nil)
+		s.mockClient.EXPECT().GetKey(ctx, id).Return(key, nil)
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{fakeVersion("1", kmspb.CryptoKeyVersion_ENABLED)}, nil)
+		s.mockClient.EXPECT().GetPublicKey(ctx, id, "1").Return(s.pubKey, nil)
+
+		createdKey, err := s.keyStore.Create(ctx, id, algorithm, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), id, createdKey.ID)
+		assert.Equal(s.T(), elliptic.Marshal(elliptic.P256(), s.privKey.X, s.privKey.Y), createdKey.PublicKey)
+		assert.Equal(s.T(), algorithm, createdKey.Algo)
+		assert.Equal(s.T(), attributes.Tags, createdKey.Tags)
+		assert.Equal(s.T(), "1", createdKey.Metadata.Version)
+		assert.Equal(s.T(), keyName+"/cryptoKeyVersions/1", createdKey.Annotations.GCPKeyVersionName)
+	})
+
+	s.Run("should fail with NotSupported error if the curve is not supported", func() {
+		createdKey, err := s.keyStore.Create(ctx, id, &entities.Algorithm{Type: entities.Eddsa, EllipticCurve: entities.Babyjubjub}, attributes)
+
+		assert.Nil(s.T(), createdKey)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if CreateKey fails", func() {
+		s.mockClient.EXPECT().CreateKey(ctx, id, kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256, attributes.Tags).Return(nil, expectedErr)
+
+		createdKey, err := s.keyStore.Create(ctx, id, algorithm, attributes)
+
+		assert.Nil(s.T(), createdKey)
+		assert.True(s.T(), errors.IsGCPError(err))
+	})
+}
+
+func (s *gcpKeyStoreTestSuite) TestGet() {
+	ctx := context.Background()
+	key := &kmspb.CryptoKey{Name: keyName}
+
+	s.Run("should get the latest enabled version of the key", func() {
+		s.mockClient.EXPECT().GetKey(ctx, id).Return(key, nil)
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{
+			fakeVersion("1", kmspb.CryptoKeyVersion_ENABLED),
+			fakeVersion("10", kmspb.CryptoKeyVersion_ENABLED),
+			fakeVersion("11", kmspb.CryptoKeyVersion_DISABLED),
+			fakeVersion("2", kmspb.CryptoKeyVersion_ENABLED),
+		}, nil)
+		s.mockClient.EXPECT().GetPublicKey(ctx, id, "10").Return(s.pubKey, nil)
+
+		retrievedKey, err := s.keyStore.Get(ctx, id)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "10", retrievedKey.Metadata.Version)
+		assert.False(s.T(), retrievedKey.Metadata.Disabled)
+	})
+
+	s.Run("should fail with NotFound error if the key has no enabled version", func() {
+		s.mockClient.EXPECT().GetKey(ctx, id).Return(key, nil)
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{fakeVersion("1", kmspb.CryptoKeyVersion_DESTROY_SCHEDULED)}, nil)
+
+		retrievedKey, err := s.keyStore.Get(ctx, id)
+
+		assert.Nil(s.T(), retrievedKey)
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+
+	s.Run("should fail with same error if GetKey fails", func() {
+		s.mockClient.EXPECT().GetKey(ctx, id).Return(nil, expectedErr)
+
+		retrievedKey, err := s.keyStore.Get(ctx, id)
+
+		assert.Nil(s.T(), retrievedKey)
+		assert.True(s.T(), errors.IsGCPError(err))
+	})
+}
+
+func (s *gcpKeyStoreTestSuite) TestList() {
+	ctx := context.Background()
+
+	s.Run("should list active and deleted keys", func() {
+		keys := []*kmspb.CryptoKey{{Name: keyName}, {Name: keyName + "-2"}}
+		s.mockClient.EXPECT().ListKeys(ctx).Return(keys, nil).Times(2)
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{fakeVersion("1", kmspb.CryptoKeyVersion_ENABLED)}, nil).Times(2)
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id+"-2").Return([]*kmspb.CryptoKeyVersion{fakeVersion("1", kmspb.CryptoKeyVersion_DESTROY_SCHEDULED)}, nil).Times(2)
+
+		ids, err := s.keyStore.List(ctx, 0, 0)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{id}, ids)
+
+		ids, err = s.keyStore.ListDeleted(ctx, 0, 0)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{id + "-2"}, ids)
+	})
+}
+
+func (s *gcpKeyStoreTestSuite) TestDelete() {
+	ctx := context.Background()
+
+	s.Run("should schedule the destruction of the enabled and disabled versions", func() {
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{
+			fakeVersion("1", kmspb.CryptoKeyVersion_DESTROYED),
+			fakeVersion("2", kmspb.CryptoKeyVersion_DISABLED),
+			fakeVersion("3", kmspb.CryptoKeyVersion_ENABLED),
+		}, nil)
+		s.mockClient.EXPECT().DestroyKeyVersion(ctx, id, "2").Return(&kmspb.CryptoKeyVersion{}, nil)
+		s.mockClient.EXPECT().DestroyKeyVersion(ctx, id, "3").Return(&kmspb.CryptoKeyVersion{}, nil)
+
+		err := s.keyStore.Delete(ctx, id)
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should restore the versions scheduled for destruction", func() {
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{
+			fakeVersion("1", kmspb.CryptoKeyVersion_DESTROYED),
+			fakeVersion("2", kmspb.CryptoKeyVersion_DESTROY_SCHEDULED),
+		}, nil)
+		s.mockClient.EXPECT().RestoreKeyVersion(ctx, id, "2").Return(&kmspb.CryptoKeyVersion{}, nil)
+
+		err := s.keyStore.Restore(ctx, id)
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should get a deleted key without its public key", func() {
+		version := fakeVersion("1", kmspb.CryptoKeyVersion_DESTROY_SCHEDULED)
+		version.DestroyTime = timestamppb.Now()
+		s.mockClient.EXPECT().GetKey(ctx, id).Return(&kmspb.CryptoKey{Name: keyName}, nil)
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{version}, nil)
+
+		deletedKey, err := s.keyStore.GetDeleted(ctx, id)
+
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), deletedKey.PublicKey)
+		assert.False(s.T(), deletedKey.Metadata.DeletedAt.IsZero())
+	})
+}
+
+func (s *gcpKeyStoreTestSuite) TestSign() {
+	ctx := context.Background()
+	digest := make([]byte, 32)
+
+	s.Run("should sign with the latest enabled version successfully", func() {
+		derSignature, err := ecdsa.SignASN1(rand.Reader, s.privKey, digest)
+		require.NoError(s.T(), err)
+
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{
+			fakeVersion("1", kmspb.CryptoKeyVersion_ENABLED),
+			fakeVersion("2", kmspb.CryptoKeyVersion_ENABLED),
+		}, nil)
+		s.mockClient.EXPECT().Sign(ctx, id, "2", digest).Return(derSignature, nil)
+
+		signature, err := s.keyStore.Sign(ctx, id, digest, nil)
+
+		require.NoError(s.T(), err)
+		assert.Len(s.T(), signature, 64)
+	})
+
+	s.Run("should sign with the cached version without resolving it again", func() {
+		derSignature, err := ecdsa.SignASN1(rand.Reader, s.privKey, digest)
+		require.NoError(s.T(), err)
+
+		s.mockClient.EXPECT().Sign(ctx, id, "2", digest).Return(derSignature, nil)
+
+		_, err = s.keyStore.Sign(ctx, id, digest, nil)
+
+		require.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if Sign fails and resolve the version again on next signature", func() {
+		s.mockClient.EXPECT().Sign(ctx, id, "2", digest).Return(nil, expectedErr)
+
+		signature, err := s.keyStore.Sign(ctx, id, digest, nil)
+
+		assert.Nil(s.T(), signature)
+		assert.True(s.T(), errors.IsGCPError(err))
+
+		s.mockClient.EXPECT().ListKeyVersions(ctx, id).Return([]*kmspb.CryptoKeyVersion{fakeVersion("1", kmspb.CryptoKeyVersion_ENABLED)}, nil)
+		s.mockClient.EXPECT().Sign(ctx, id, "1", digest).Return(nil, expectedErr)
+
+		_, err = s.keyStore.Sign(ctx, id, digest, nil)
+
+		assert.True(s.T(), errors.IsGCPError(err))
+	})
+}
+
+func fakeVersion(version string, state kmspb.CryptoKeyVersion_CryptoKeyVersionState) *kmspb.CryptoKeyVersion {
+	return &kmspb.CryptoKeyVersion{
+		Name:       keyName + "/cryptoKeyVersions/" + version,
+		State:      state,
+		Algorithm:  kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256,
+		CreateTime: timestamppb.Now(),
+	}
+}
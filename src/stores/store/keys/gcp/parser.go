@@ -0,0 +1,108 @@
+package gcp
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"path"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+)
+
+type publicKeyInfo struct {
+	Raw       asn1.RawContent
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+type signatureInfo struct {
+	R, S *big.Int
+}
+
+// parseKey parses a version of a Cloud KMS key, the public key being omitted when nil
+func parseKey(id string, key *kmspb.CryptoKey, version *kmspb.CryptoKeyVersion, pubKey *kmspb.PublicKey) (*entities.Key, error) {
+	algo, err := parseAlgorithm(version.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey := &entities.Key{
+		ID:       id,
+		Algo:     algo,
+		Metadata: parseMetadata(version),
+		Tags:     key.Labels,
+		Annotations: &entities.Annotation{
+			GCPKeyVersionName: version.Name,
+		},
+	}
+
+	if pubKey != nil {
+		block, _ := pem.Decode([]byte(pubKey.Pem))
+		if block == nil {
+			return nil, fmt.Errorf("invalid PEM public key returned from GCP Cloud KMS")
+		}
+
+		val := &publicKeyInfo{}
+		_, err = asn1.Unmarshal(block.Bytes, val)
+		if err != nil {
+			return nil, err
+		}
+		parsedKey.PublicKey = val.PublicKey.Bytes
+	}
+
+	return parsedKey, nil
+}
+
+func parseAlgorithm(algorithm kmspb.CryptoKeyVersion_CryptoKeyVersionAlgorithm) (*entities2.Algorithm, error) {
+	switch algorithm {
+	case kmspb.CryptoKeyVersion_EC_SIGN_SECP256K1_SHA256:
+		return &entities2.Algorithm{
+			Type:          entities2.Ecdsa,
+			EllipticCurve: entities2.Secp256k1,
+		}, nil
+	case kmspb.CryptoKeyVersion_EC_SIGN_P256_SHA256:
+		return &entities2.Algorithm{
+			Type:          entities2.Ecdsa,
+			EllipticCurve: entities2.Secp256r1,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm returned from GCP Cloud KMS")
+	}
+}
+
+func parseMetadata(version *kmspb.CryptoKeyVersion) *entities.Metadata {
+	createdAt := version.CreateTime.AsTime()
+
+	metadata := &entities.Metadata{
+		Version:   path.Base(version.Name),
+		Disabled:  version.State != kmspb.CryptoKeyVersion_ENABLED,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt, // Cannot update key versions so updatedAt = createdAt
+	}
+	if version.DestroyTime != nil {
+		metadata.DeletedAt = version.DestroyTime.AsTime()
+	}
+
+	return metadata
+}
+
+func parseSignature(derSignature []byte) ([]byte, error) {
+	val := &signatureInfo{}
+	_, err := asn1.Unmarshal(derSignature, val)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure signature size is 64
+	sig := make([]byte, 64)
+	// copy R in first half
+	copy(sig[len(sig)/2-len(val.R.Bytes()):], val.R.Bytes())
+	// copy S in second half
+	copy(sig[len(sig)-len(val.S.Bytes()):], val.S.Bytes())
+
+	return sig, nil
+}
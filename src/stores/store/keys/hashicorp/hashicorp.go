@@ -202,12 +202,16 @@ func (s *Store) Sign(_ context.Context, id string, data []byte, alg *entities2.A
 	return signature, nil
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+func (s *Store) Encrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("encryption is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+func (s *Store) Decrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("decryption is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
 }
 
 func (s *Store) isSupportedAlgo(alg *entities2.Algorithm) bool {
@@ -221,3 +225,33 @@ func (s *Store) isSupportedAlgo(alg *entities2.Algorithm) bool {
 
 	return false
 }
+
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("key export is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) GetVersion(_ context.Context, _, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("key versioning is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Rotate(_ context.Context, _ string, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
+	err := errors.NotSupportedError("key rotation is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
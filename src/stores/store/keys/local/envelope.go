@@ -0,0 +1,192 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/stores"
+)
+
+// envelopePrefix distinguishes envelope encrypted private keys from base64 encoded ones in the secret store
+const envelopePrefix = "envelope:"
+
+const dataKeySize = 32
+
+// envelope holds a private key encrypted with a data key, itself encrypted by the key encryption key
+type envelope struct {
+	KeyID      string `json:"keyId"`
+	WrappedKey []byte `json:"wrappedKey"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// CheckKeyEncryptionKey verifies that the key encryption key can wrap and unwrap data keys, by wrapping a random one,
+// and returns the algorithm of the key encryption key used to wrap them
+func CheckKeyEncryptionKey(ctx context.Context, kekStore stores.KeyStore, kekID string) (*entities.Algorithm, error) {
+	kekAlgo, err := getKeyEncryptionAlgo(ctx, kekStore, kekID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err = io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, errors.CryptoOperationError("failed to generate data key")
+	}
+
+	wrappedKey, err := kekStore.Encrypt(ctx, kekID, dataKey, kekAlgo)
+	if err != nil {
+		return nil, errors.FromError(err).SetMessage("key encryption key cannot wrap data keys")
+	}
+
+	unwrappedKey, err := kekStore.Decrypt(ctx, kekID, wrappedKey, kekAlgo)
+	if err != nil {
+		return nil, errors.FromError(err).SetMessage("key encryption key cannot unwrap data keys")
+	}
+
+	if !bytes.Equal(dataKey, unwrappedKey) {
+		return nil, errors.DependencyFailureError("key encryption key does not unwrap data keys to their original value")
+	}
+
+	return kekAlgo, nil
+}
+
+// getKeyEncryptionAlgo returns the algorithm of a key encryption key, passed explicitly to wrap and unwrap data keys
+func getKeyEncryptionAlgo(ctx context.Context, kekStore stores.KeyStore, kekID string) (*entities.Algorithm, error) {
+	kek, err := kekStore.Get(ctx, kekID)
+	if err != nil {
+		return nil, errors.FromError(err).SetMessage("failed to get key encryption key")
+	}
+
+	if kek.Algo == nil {
+		return nil, errors.InvalidParameterError("algorithm of key encryption key is unknown")
+	}
+
+	return kek.Algo, nil
+}
+
+// seal encrypts the private key with a new data key and wraps the data key with the key encryption key
+func (s *Store) seal(ctx context.Context, id string, privKey []byte) (string, error) {
+	logger := s.logger.With("id", id, "key_encryption_key", s.kekID)
+
+	dataKey := make([]byte, dataKeySize)
+	defer zero(dataKey)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		errMessage := "failed to generate data key"
+		logger.WithError(err).Error(errMessage)
+		return "", errors.CryptoOperationError(errMessage)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		errMessage := "failed to instantiate data key cipher"
+		logger.WithError(err).Error(errMessage)
+		return "", errors.CryptoOperationError(errMessage)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		errMessage := "failed to generate nonce"
+		logger.WithError(err).Error(errMessage)
+		return "", errors.CryptoOperationError(errMessage)
+	}
+
+	wrappedKey, err := s.kekStore.Encrypt(ctx, s.kekID, dataKey, s.kekAlgo)
+	if err != nil {
+		errMessage := "failed to wrap data key"
+		logger.WithError(err).Error(errMessage)
+		return "", errors.FromError(err).SetMessage(errMessage)
+	}
+
+	value, err := json.Marshal(&envelope{
+		KeyID:      s.kekID,
+		WrappedKey: wrappedKey,
+		Ciphertext: aead.Seal(nonce, nonce, privKey, []byte(id)),
+	})
+	if err != nil {
+		errMessage := "failed to encode envelope"
+		logger.WithError(err).Error(errMessage)
+		return "", errors.EncodingError(errMessage)
+	}
+
+	return envelopePrefix + string(value), nil
+}
+
+// open unwraps the data key of the envelope and decrypts the private key
+func (s *Store) open(ctx context.Context, id, value string) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	if s.kekStore == nil {
+		errMessage := "private key is envelope encrypted but no key encryption key is configured"
+		logger.Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	env := &envelope{}
+	err := json.Unmarshal([]byte(strings.TrimPrefix(value, envelopePrefix)), env)
+	if err != nil {
+		errMessage := "failed to decode envelope"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	// The key encryption key recorded in the envelope is used so that keys remain readable if the configured one changes
+	kekAlgo := s.kekAlgo
+	if env.KeyID != s.kekID {
+		kekAlgo, err = getKeyEncryptionAlgo(ctx, s.kekStore, env.KeyID)
+		if err != nil {
+			logger.With("key_encryption_key", env.KeyID).WithError(err).Error("failed to get key encryption key of envelope")
+			return nil, err
+		}
+	}
+
+	dataKey, err := s.kekStore.Decrypt(ctx, env.KeyID, env.WrappedKey, kekAlgo)
+	if err != nil {
+		errMessage := "failed to unwrap data key"
+		logger.With("key_encryption_key", env.KeyID).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+	defer zero(dataKey)
+
+	aead, err := newAEAD(dataKey)
+	if err != nil || len(env.Ciphertext) < aead.NonceSize() {
+		errMessage := "invalid envelope data key or ciphertext"
+		logger.Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	nonceSize := aead.NonceSize()
+	privKey, err := aead.Open(nil, env.Ciphertext[:nonceSize], env.Ciphertext[nonceSize:], []byte(id))
+	if err != nil {
+		errMessage := "failed to decrypt envelope encrypted private key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	return privKey, nil
+}
+
+func isEnvelope(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/bls"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/eddsa"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
@@ -17,6 +18,9 @@ import (
 type Store struct {
 	secretStore stores.SecretStore
 	db          database.Secrets
+	kekStore    stores.KeyStore
+	kekID       string
+	kekAlgo     *entities2.Algorithm
 	logger      log.Logger
 }
 
@@ -30,6 +34,19 @@ func New(secretStore stores.SecretStore, db database.Secrets, logger log.Logger)
 	}
 }
 
+// NewWithEnvelopeEncryption creates a local key store whose private keys are encrypted with a data key,
+// itself wrapped by the key encryption key kekID of kekStore using its algorithm kekAlgo
+func NewWithEnvelopeEncryption(secretStore stores.SecretStore, db database.Secrets, kekStore stores.KeyStore, kekID string, kekAlgo *entities2.Algorithm, logger log.Logger) *Store {
+	return &Store{
+		secretStore: secretStore,
+		db:          db,
+		kekStore:    kekStore,
+		kekID:       kekID,
+		kekAlgo:     kekAlgo,
+		logger:      logger,
+	}
+}
+
 func (s *Store) Get(_ context.Context, _ string) (*entities.Key, error) {
 	return nil, errors.ErrNotSupported
 }
@@ -75,52 +92,110 @@ func (s *Store) Import(ctx context.Context, id string, importedPrivKey []byte, a
 }
 
 func (s *Store) create(ctx context.Context, id string, importedPrivKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	privKey, pubKey, err := s.generateKey(id, importedPrivKey, alg)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.encodePrivKey(ctx, id, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretStore.Set(ctx, id, value, attr)
+	if err != nil && errors.IsAlreadyExistsError(err) {
+		secret, err = s.secretStore.Get(ctx, id, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Add(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKey(id, pubKey, alg, secret), nil
+}
+
+// Rotate generates a new private key and stores it as a new version of the underlying secret
+func (s *Store) Rotate(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	privKey, pubKey, err := s.generateKey(id, nil, alg)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.encodePrivKey(ctx, id, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretStore.Set(ctx, id, value, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Add(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKey(id, pubKey, alg, secret), nil
+}
+
+func (s *Store) GetVersion(_ context.Context, _, _ string) (*entities.Key, error) {
+	return nil, errors.ErrNotSupported
+}
+
+func (s *Store) generateKey(id string, importedPrivKey []byte, alg *entities2.Algorithm) (privKey, pubKey []byte, err error) {
 	logger := s.logger.With("id", id).With("signing_algorithm", alg.Type).With("curve", alg.EllipticCurve)
 
-	var privKey []byte
-	var pubKey []byte
-	var err error
 	switch {
 	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Babyjubjub:
 		privKey, pubKey, err = eddsa.CreateBabyjubjub(importedPrivKey)
 		if err != nil {
 			errMessage := "failed to generate EDDSA/Babyjujub key pair"
 			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
 		}
 	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
 		privKey, pubKey, err = ecdsa.CreateSecp256k1(importedPrivKey)
 		if err != nil {
 			errMessage := "failed to generate Secp256k1/ECDSA key pair"
 			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
+		}
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		privKey, pubKey, err = ecdsa.CreateSecp256r1(importedPrivKey)
+		if err != nil {
+			errMessage := "failed to generate Secp256r1/ECDSA key pair"
+			logger.With("error", err).Error(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
 		}
 	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Curve25519:
 		privKey, pubKey, err = eddsa.CreateED25519(importedPrivKey)
 		if err != nil {
 			errMessage := "failed to generate EDDSA/Curve25519 key pair"
 			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
+		}
+	case alg.Type == entities2.Bls && alg.EllipticCurve == entities2.Bls12381:
+		privKey, pubKey, err = bls.CreateBLS12381(importedPrivKey)
+		if err != nil {
+			errMessage := "failed to generate BLS/BLS12-381 key pair"
+			logger.With("error", err).Error(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
 		}
 	default:
 		errMessage := "invalid signing algorithm/elliptic curve combination"
 		logger.Error(errMessage)
-		return nil, errors.InvalidParameterError(errMessage)
-	}
-
-	secret, err := s.secretStore.Set(ctx, id, base64.StdEncoding.EncodeToString(privKey), attr)
-	if err != nil && errors.IsAlreadyExistsError(err) {
-		secret, err = s.secretStore.Get(ctx, id, "")
-	}
-	if err != nil {
-		return nil, err
+		return nil, nil, errors.InvalidParameterError(errMessage)
 	}
 
-	_, err = s.db.Add(ctx, secret)
-	if err != nil {
-		return nil, err
-	}
+	return privKey, pubKey, nil
+}
 
+func newKey(id string, pubKey []byte, alg *entities2.Algorithm, secret *entities.Secret) *entities.Key {
 	return &entities.Key{
 		ID:        id,
 		PublicKey: pubKey,
@@ -129,12 +204,13 @@ func (s *Store) create(ctx context.Context, id string, importedPrivKey []byte, a
 			EllipticCurve: alg.EllipticCurve,
 		},
 		Metadata: &entities.Metadata{
+			Version:   secret.Metadata.Version,
 			Disabled:  false,
 			CreatedAt: secret.Metadata.CreatedAt,
 			UpdatedAt: secret.Metadata.UpdatedAt,
 		},
 		Tags: secret.Tags,
-	}, nil
+	}
 }
 
 func (s *Store) Update(_ context.Context, _ string, _ *entities.Attributes) (*entities.Key, error) {
@@ -202,26 +278,23 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
 	logger := s.logger.With("id", id).With("type", algo.Type).With("curve", algo.EllipticCurve)
 
-	secret, err := s.secretStore.Get(ctx, id, "")
+	privkey, err := s.getPrivKey(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	privkey, err := base64.StdEncoding.DecodeString(secret.Value)
-	if err != nil {
-		errMessage := "failed to decode private key secret"
-		logger.Error(errMessage)
-		return nil, errors.DependencyFailureError(errMessage)
-	}
-
 	var signature []byte
 	switch {
 	case algo.Type == entities2.Eddsa && algo.EllipticCurve == entities2.Babyjubjub:
 		signature, err = eddsa.SignBabyjubjub(privkey, data)
 	case algo.Type == entities2.Ecdsa && algo.EllipticCurve == entities2.Secp256k1:
 		signature, err = ecdsa.SignSecp256k1(privkey, data)
+	case algo.Type == entities2.Ecdsa && algo.EllipticCurve == entities2.Secp256r1:
+		signature, err = ecdsa.SignSecp256r1(privkey, data)
 	case algo.Type == entities2.Eddsa && algo.EllipticCurve == entities2.Curve25519:
 		signature, err = eddsa.SignED25519(privkey, data)
+	case algo.Type == entities2.Bls && algo.EllipticCurve == entities2.Bls12381:
+		signature, err = bls.SignBLS12381(privkey, data)
 	default:
 		errMessage := "signing algorithm and curve combination not supported for signing"
 		logger.With("algorithm", algo.Type, "curve", algo.EllipticCurve).Error(errMessage)
@@ -241,10 +314,152 @@ func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entiti
 	return errors.ErrNotSupported
 }
 
-func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+func (s *Store) Encrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id).With("type", algo.Type).With("curve", algo.EllipticCurve)
+
+	privkey, err := s.getPlainPrivKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey []byte
+	switch {
+	case algo.Type == entities2.Ecdsa && algo.EllipticCurve == entities2.Secp256k1:
+		_, pubKey, err = ecdsa.CreateSecp256k1(privkey)
+	case algo.Type == entities2.Eddsa && algo.EllipticCurve == entities2.Curve25519:
+		_, pubKey, err = eddsa.CreateED25519(privkey)
+	default:
+		errMessage := "signing algorithm and curve combination not supported for encryption"
+		logger.Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+	if err != nil {
+		errMsg := "failed to encrypt"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.CryptoOperationError(errMsg)
+	}
+
+	return s.EncryptWithPublicKey(pubKey, data, algo)
+}
+
+// EncryptWithPublicKey encrypts data for the given public key, the private key (possibly envelope encrypted) is never accessed
+func (s *Store) EncryptWithPublicKey(pubKey, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("type", algo.Type).With("curve", algo.EllipticCurve)
+
+	var ciphertext []byte
+	var err error
+	switch {
+	case algo.Type == entities2.Ecdsa && algo.EllipticCurve == entities2.Secp256k1:
+		ciphertext, err = ecdsa.EncryptSecp256k1(pubKey, data)
+	case algo.Type == entities2.Eddsa && algo.EllipticCurve == entities2.Curve25519:
+		ciphertext, err = eddsa.EncryptED25519(pubKey, data)
+	default:
+		errMessage := "signing algorithm and curve combination not supported for encryption"
+		logger.Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	if err != nil {
+		errMsg := "failed to encrypt"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.CryptoOperationError(errMsg)
+	}
+
+	return ciphertext, nil
 }
 
-func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
-	return nil, errors.ErrNotImplemented
+func (s *Store) Decrypt(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id).With("type", algo.Type).With("curve", algo.EllipticCurve)
+
+	privkey, err := s.getPlainPrivKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var plaintext []byte
+	switch {
+	case algo.Type == entities2.Ecdsa && algo.EllipticCurve == entities2.Secp256k1:
+		plaintext, err = ecdsa.DecryptSecp256k1(privkey, data)
+	case algo.Type == entities2.Eddsa && algo.EllipticCurve == entities2.Curve25519:
+		plaintext, err = eddsa.DecryptED25519(privkey, data)
+	default:
+		errMessage := "signing algorithm and curve combination not supported for decryption"
+		logger.Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	if err != nil {
+		errMsg := "failed to decrypt"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg)
+	}
+
+	return plaintext, nil
+}
+
+func (s *Store) Export(ctx context.Context, id string) ([]byte, error) {
+	if s.kekStore != nil {
+		err := errors.NotSupportedError("export of envelope encrypted keys is not supported")
+		s.logger.With("id", id).Warn(err.Error())
+		return nil, err
+	}
+
+	return s.getPlainPrivKey(ctx, id)
+}
+
+func (s *Store) encodePrivKey(ctx context.Context, id string, privKey []byte) (string, error) {
+	if s.kekStore != nil {
+		return s.seal(ctx, id, privKey)
+	}
+
+	return base64.StdEncoding.EncodeToString(privKey), nil
+}
+
+// getPrivKey returns the private key to sign with, unwrapping it if envelope encrypted
+func (s *Store) getPrivKey(ctx context.Context, id string) ([]byte, error) {
+	secret, err := s.secretStore.Get(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if isEnvelope(secret.Value) {
+		return s.open(ctx, id, secret.Value)
+	}
+
+	return s.decodePrivKey(id, secret.Value)
+}
+
+// getPlainPrivKey returns the private key for operations other than signing, which never unwrap envelope encrypted keys
+func (s *Store) getPlainPrivKey(ctx context.Context, id string) ([]byte, error) {
+	secret, err := s.secretStore.Get(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if isEnvelope(secret.Value) {
+		errMessage := "envelope encrypted keys can only be used for signing"
+		s.logger.With("id", id).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	return s.decodePrivKey(id, secret.Value)
+}
+
+func (s *Store) decodePrivKey(id, value string) ([]byte, error) {
+	privkey, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		errMessage := "failed to decode private key secret"
+		s.logger.With("id", id).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	return privkey, nil
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	return errors.ErrNotSupported
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	return errors.ErrNotSupported
 }
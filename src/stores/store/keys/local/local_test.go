@@ -3,15 +3,17 @@ package local
 import (
 	"context"
 	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/stretchr/testify/require"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
-	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 	dbmocks "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -32,13 +34,16 @@ const (
 	privKeyECDSA             = "0xdb337ca3295e4050586793f252e641f3b3a83739018fa4cce01a81ca920e7e1c"
 	privKeyEDDSABabyJubJub   = "0x5fd633ff9f8ee36f9e3a874709406103854c0f6650cb908c010ea55eabc35191866e2a1e939a98bb32734cd6694c7ad58e3164ee215edc56307e9c59c8d3f1b4868507981bf553fd21c1d97b0c0d665cbcdb5adeed192607ca46763cb0ca03c7"
 	privKeyED25519           = "0x76d17877a7d4b7a538c149c849597c243772cb438c3a4f97645b1e6e0b12ed72f60399370d166881e555b842ba28a2e5c6d01d2964629bdd5d726d500f0cad08"
+	publicKeyBLS12381        = "0xa491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	privKeyBLS12381          = "0x263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3"
+	publicKeySecp256r1       = "0x04e035cce1b135aeec665cdc37a8343b81b4e2350a73f053c0e03d1088df7f58f9f2c184736d0dc08d2792dca4f4a62204821f71e1c59951fe8c95f495eec54fc2"
 )
 
 var expectedErr = errors.DependencyFailureError("error")
 
 type localKeyStoreTestSuite struct {
 	suite.Suite
-	keyStore        stores.KeyStore
+	keyStore        *Store
 	mockSecretDB    *dbmocks.MockSecrets
 	mockSecretStore *mocksecrets.MockSecretStore
 }
@@ -129,6 +134,40 @@ func (s *localKeyStoreTestSuite) TestCreate() {
 		assert.NotEmpty(s.T(), key.Metadata.UpdatedAt)
 	})
 
+	s.Run("should create an ECDSA/Secp256r1 key successfully", func() {
+		secret := testutils.FakeSecret()
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).Return(secret, nil)
+		s.mockSecretDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		key, err := s.keyStore.Create(ctx, id, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256r1,
+		}, attr)
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), id, key.ID)
+		assert.Len(s.T(), key.PublicKey, 65)
+		assert.Equal(s.T(), entities.Ecdsa, key.Algo.Type)
+		assert.Equal(s.T(), entities.Secp256r1, key.Algo.EllipticCurve)
+	})
+
+	s.Run("should create a BLS/BLS12-381 key successfully", func() {
+		secret := testutils.FakeSecret()
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).Return(secret, nil)
+		s.mockSecretDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		key, err := s.keyStore.Create(ctx, id, &entities.Algorithm{
+			Type:          entities.Bls,
+			EllipticCurve: entities.Bls12381,
+		}, attr)
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), id, key.ID)
+		assert.Len(s.T(), key.PublicKey, 48)
+		assert.Equal(s.T(), entities.Bls, key.Algo.Type)
+		assert.Equal(s.T(), entities.Bls12381, key.Algo.EllipticCurve)
+	})
+
 	s.Run("should fail with same error if Set fails", func() {
 		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).Return(nil, expectedErr)
 
@@ -154,6 +193,38 @@ func (s *localKeyStoreTestSuite) TestCreate() {
 	})
 }
 
+func (s *localKeyStoreTestSuite) TestRotate() {
+	ctx := context.Background()
+	attr := testutils.FakeAttributes()
+	algo := &entities.Algorithm{
+		Type:          entities.Ecdsa,
+		EllipticCurve: entities.Secp256k1,
+	}
+
+	s.Run("should rotate a key by setting a new secret version successfully", func() {
+		secret := testutils.FakeSecret()
+		secret.Metadata.Version = "2"
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).Return(secret, nil)
+		s.mockSecretDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		key, err := s.keyStore.Rotate(ctx, id, algo, attr)
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), id, key.ID)
+		assert.NotEmpty(s.T(), key.PublicKey)
+		assert.Equal(s.T(), "2", key.Metadata.Version)
+		assert.Equal(s.T(), algo, key.Algo)
+	})
+
+	s.Run("should fail with same error if Set fails", func() {
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).Return(nil, expectedErr)
+
+		_, err := s.keyStore.Rotate(ctx, id, algo, attr)
+
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
 func (s *localKeyStoreTestSuite) TestImport() {
 	ctx := context.Background()
 	attr := testutils.FakeAttributes()
@@ -221,6 +292,47 @@ func (s *localKeyStoreTestSuite) TestImport() {
 		assert.NotEmpty(s.T(), key.Metadata.UpdatedAt)
 	})
 
+	s.Run("should import an ECDSA/Secp256r1 key successfully", func() {
+		secret := testutils.FakeSecret()
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).Return(secret, nil)
+		s.mockSecretDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		key, err := s.keyStore.Import(ctx, id, hexutil.MustDecode(privKeyECDSA), &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256r1,
+		}, attr)
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), publicKeySecp256r1, hexutil.Encode(key.PublicKey))
+		assert.Equal(s.T(), entities.Ecdsa, key.Algo.Type)
+		assert.Equal(s.T(), entities.Secp256r1, key.Algo.EllipticCurve)
+	})
+
+	s.Run("should import a BLS/BLS12-381 key successfully", func() {
+		secret := testutils.FakeSecret()
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).Return(secret, nil)
+		s.mockSecretDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		key, err := s.keyStore.Import(ctx, id, hexutil.MustDecode(privKeyBLS12381), &entities.Algorithm{
+			Type:          entities.Bls,
+			EllipticCurve: entities.Bls12381,
+		}, attr)
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), publicKeyBLS12381, hexutil.Encode(key.PublicKey))
+		assert.Equal(s.T(), entities.Bls, key.Algo.Type)
+		assert.Equal(s.T(), entities.Bls12381, key.Algo.EllipticCurve)
+	})
+
+	s.Run("should fail with InvalidParameter if BLS/BLS12-381 imported key is out of range", func() {
+		_, err := s.keyStore.Import(ctx, id, hexutil.MustDecode("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), &entities.Algorithm{
+			Type:          entities.Bls,
+			EllipticCurve: entities.Bls12381,
+		}, attr)
+
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
+	})
+
 	s.Run("should fail with InvalidParameter if algo is undefined", func() {
 		_, err := s.keyStore.Create(ctx, id, &entities.Algorithm{
 			Type:          "wrongType",
@@ -312,6 +424,40 @@ func (s *localKeyStoreTestSuite) TestSign() {
 		assert.Equal(s.T(), "dDQeCkh1ao60pXAoAqiu93abipXrKoILKAi6bahMOJYGgfHdNyyCGBCxQ8gwusxkT0hutaWetgAOI5TUHYDYCw==", base64.StdEncoding.EncodeToString(signature))
 	})
 
+	s.Run("should sign with an ECDSA/Secp256r1 key successfully", func() {
+		payload := crypto.Keccak256([]byte("my data"))
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		signature, err := s.keyStore.Sign(ctx, id, payload, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256r1,
+		})
+		require.NoError(s.T(), err)
+
+		verified, err := ecdsa.VerifySecp256r1Signature(hexutil.MustDecode(publicKeySecp256r1), payload, signature)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), verified)
+	})
+
+	s.Run("should sign with a BLS/BLS12-381 key successfully", func() {
+		payload := make([]byte, 32)
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyBLS12381))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		signature, err := s.keyStore.Sign(ctx, id, payload, &entities.Algorithm{
+			Type:          entities.Bls,
+			EllipticCurve: entities.Bls12381,
+		})
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), "0xb6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55", hexutil.Encode(signature))
+	})
+
 	s.Run("should fail with InvalidParameter if algo is undefined", func() {
 		payload := []byte("my data")
 		secret := testutils.FakeSecret()
@@ -453,18 +599,261 @@ func (s *localKeyStoreTestSuite) TestDestroy() {
 
 func (s *localKeyStoreTestSuite) TestEncrypt() {
 	ctx := context.Background()
+	data := []byte("my data")
+
+	s.Run("should encrypt and decrypt with an ECDSA/Secp256k1 key successfully", func() {
+		algo := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256k1}
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil).Times(2)
+
+		ciphertext, err := s.keyStore.Encrypt(ctx, id, data, algo)
+		require.NoError(s.T(), err)
+		assert.NotEqual(s.T(), data, ciphertext)
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Encrypt(ctx, id, []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+		plaintext, err := s.keyStore.Decrypt(ctx, id, ciphertext, algo)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), data, plaintext)
+	})
+
+	s.Run("should encrypt and decrypt with an ED25519 key successfully", func() {
+		algo := &entities.Algorithm{Type: entities.Eddsa, EllipticCurve: entities.Curve25519}
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyED25519))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil).Times(2)
+
+		ciphertext, err := s.keyStore.Encrypt(ctx, id, data, algo)
+		require.NoError(s.T(), err)
+		assert.NotEqual(s.T(), data, ciphertext)
+
+		plaintext, err := s.keyStore.Decrypt(ctx, id, ciphertext, algo)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), data, plaintext)
+	})
+
+	s.Run("should encrypt with the public key and decrypt successfully", func() {
+		algo := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256k1}
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		ciphertext, err := s.keyStore.EncryptWithPublicKey(hexutil.MustDecode(publicKeyECDSA), data, algo)
+		require.NoError(s.T(), err)
+
+		plaintext, err := s.keyStore.Decrypt(ctx, id, ciphertext, algo)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), data, plaintext)
+	})
+
+	s.Run("should fail with CryptoOperationError to encrypt with an invalid public key", func() {
+		_, err := s.keyStore.EncryptWithPublicKey([]byte("invalid public key"), data, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+		assert.True(s.T(), errors.IsCryptoOperationError(err))
+	})
+
+	s.Run("should fail with NotSupportedError if algo is EDDSA/Babyjubjub", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyEDDSABabyJubJub))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		_, err := s.keyStore.Encrypt(ctx, id, data, &entities.Algorithm{
+			Type:          entities.Eddsa,
+			EllipticCurve: entities.Babyjubjub,
+		})
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if Get secret fails", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(nil, expectedErr)
+
+		_, err := s.keyStore.Encrypt(ctx, id, data, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+		assert.Equal(s.T(), expectedErr, err)
 	})
 }
 
 func (s *localKeyStoreTestSuite) TestDecrypt() {
 	ctx := context.Background()
 
-	s.Run("should return NotImplementedError", func() {
-		_, err := s.keyStore.Decrypt(ctx, id, []byte(""))
-		assert.Equal(s.T(), errors.ErrNotImplemented, err)
+	s.Run("should fail with InvalidParameterError if ciphertext is invalid", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		_, err := s.keyStore.Decrypt(ctx, id, []byte("invalid ciphertext"), &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
+	})
+
+	s.Run("should fail with same error if Get secret fails", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(nil, expectedErr)
+
+		_, err := s.keyStore.Decrypt(ctx, id, []byte("ciphertext"), &entities.Algorithm{
+			Type:          entities.Eddsa,
+			EllipticCurve: entities.Curve25519,
+		})
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
+func (s *localKeyStoreTestSuite) TestExport() {
+	ctx := context.Background()
+
+	s.Run("should export the private key successfully", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		privKey, err := s.keyStore.Export(ctx, id)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), privKeyECDSA, hexutil.Encode(privKey))
+	})
+
+	s.Run("should fail with same error if Get secret fails", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(nil, expectedErr)
+
+		_, err := s.keyStore.Export(ctx, id)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
+func (s *localKeyStoreTestSuite) TestEnvelopeEncryption() {
+	ctx := context.Background()
+	attr := testutils.FakeAttributes()
+	algo := &entities.Algorithm{
+		Type:          entities.Ecdsa,
+		EllipticCurve: entities.Secp256k1,
+	}
+	kekID := "my-kek"
+	kekAlgo := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256r1}
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	// The key encryption key store reverses the data key so that wrapping can be checked without a KMS
+	mockKEKStore := mocksecrets.NewMockKeyStore(ctrl)
+	reverse := func(_ context.Context, _ string, data []byte, _ *entities.Algorithm) ([]byte, error) {
+		out := make([]byte, len(data))
+		for i := range data {
+			out[i] = data[len(data)-1-i]
+		}
+		return out, nil
+	}
+	keyStore := NewWithEnvelopeEncryption(s.mockSecretStore, s.mockSecretDB, mockKEKStore, kekID, kekAlgo, testutils2.NewMockLogger(ctrl))
+
+	s.Run("should store the private key encrypted and sign with it successfully", func() {
+		secret := testutils.FakeSecret()
+		mockKEKStore.EXPECT().Encrypt(ctx, kekID, gomock.Any(), kekAlgo).DoAndReturn(reverse)
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), attr).DoAndReturn(
+			func(_ context.Context, _, value string, _ *entities2.Attributes) (*entities2.Secret, error) {
+				secret.Value = value
+				return secret, nil
+			})
+		s.mockSecretDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		key, err := keyStore.Import(ctx, id, hexutil.MustDecode(privKeyECDSA), algo, attr)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), publicKeyECDSA, hexutil.Encode(key.PublicKey))
+		assert.True(s.T(), strings.HasPrefix(secret.Value, envelopePrefix))
+		assert.NotContains(s.T(), secret.Value, base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA)))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+		mockKEKStore.EXPECT().Decrypt(ctx, kekID, gomock.Any(), kekAlgo).DoAndReturn(reverse)
+
+		signature, err := keyStore.Sign(ctx, id, crypto.Keccak256([]byte("my data")), algo)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "xUBOm7wht727RjpUY+KqK/NpCIOkzxX9H+dSBIWOITccTl/i5DyFvrcO3EIZTLV1gLVfCL+AOkY2pGWnIxygtQ==", base64.StdEncoding.EncodeToString(signature))
+	})
+
+	s.Run("should fail with same error if the data key cannot be wrapped", func() {
+		mockKEKStore.EXPECT().Encrypt(ctx, kekID, gomock.Any(), kekAlgo).Return(nil, expectedErr)
+
+		key, err := keyStore.Create(ctx, id, algo, attr)
+		assert.Nil(s.T(), key)
+		assert.True(s.T(), errors.IsDependencyFailureError(err))
+	})
+
+	s.Run("should fail with DependencyFailure error if the envelope was tampered with", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = envelopePrefix + `{"keyId":"my-kek","wrappedKey":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=","ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}`
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+		mockKEKStore.EXPECT().Decrypt(ctx, kekID, gomock.Any(), kekAlgo).DoAndReturn(reverse)
+
+		_, err := keyStore.Sign(ctx, id, crypto.Keccak256([]byte("my data")), algo)
+		assert.True(s.T(), errors.IsDependencyFailureError(err))
+	})
+
+	s.Run("should fail with NotSupported error when exporting an envelope encrypted key", func() {
+		_, err := keyStore.Export(ctx, id)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with NotSupported error without unwrapping the key when decrypting", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = envelopePrefix + `{"keyId":"my-kek","wrappedKey":"AAAA","ciphertext":"AAAA"}`
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		_, err := keyStore.Decrypt(ctx, id, []byte("my data"), algo)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should encrypt with the public key of an envelope encrypted key without unwrapping it", func() {
+		ciphertext, err := keyStore.EncryptWithPublicKey(hexutil.MustDecode(publicKeyECDSA), []byte("my data"), algo)
+		require.NoError(s.T(), err)
+		assert.NotEqual(s.T(), []byte("my data"), ciphertext)
+	})
+
+	s.Run("should unwrap the data key with the algorithm of the key encryption key recorded in the envelope", func() {
+		previousKEK := testutils.FakeKey()
+		previousKEK.Algo = &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256k1}
+		secret := testutils.FakeSecret()
+		secret.Value = envelopePrefix + `{"keyId":"my-previous-kek","wrappedKey":"AAAA","ciphertext":"AAAA"}`
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+		mockKEKStore.EXPECT().Get(ctx, "my-previous-kek").Return(previousKEK, nil)
+		mockKEKStore.EXPECT().Decrypt(ctx, "my-previous-kek", gomock.Any(), previousKEK.Algo).Return(nil, expectedErr)
+
+		_, err := keyStore.Sign(ctx, id, crypto.Keccak256([]byte("my data")), algo)
+		assert.True(s.T(), errors.IsDependencyFailureError(err))
+	})
+
+	s.Run("should check that the key encryption key can wrap data keys", func() {
+		kek := testutils.FakeKey()
+		kek.Algo = kekAlgo
+		mockKEKStore.EXPECT().Get(ctx, kekID).Return(kek, nil)
+		mockKEKStore.EXPECT().Encrypt(ctx, kekID, gomock.Any(), kekAlgo).DoAndReturn(reverse)
+		mockKEKStore.EXPECT().Decrypt(ctx, kekID, gomock.Any(), kekAlgo).DoAndReturn(reverse)
+
+		rAlgo, err := CheckKeyEncryptionKey(ctx, mockKEKStore, kekID)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), kekAlgo, rAlgo)
+	})
+
+	s.Run("should fail to check a key encryption key that does not support encryption", func() {
+		kek := testutils.FakeKey()
+		kek.Algo = kekAlgo
+		mockKEKStore.EXPECT().Get(ctx, kekID).Return(kek, nil)
+		mockKEKStore.EXPECT().Encrypt(ctx, kekID, gomock.Any(), kekAlgo).Return(nil, errors.NotSupportedError("error"))
+
+		_, err := CheckKeyEncryptionKey(ctx, mockKEKStore, kekID)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail to check a key encryption key that cannot be found", func() {
+		mockKEKStore.EXPECT().Get(ctx, kekID).Return(nil, errors.NotFoundError("error"))
+
+		_, err := CheckKeyEncryptionKey(ctx, mockKEKStore, kekID)
+		assert.True(s.T(), errors.IsNotFoundError(err))
 	})
 }
@@ -0,0 +1,62 @@
+package pkcs11
+
+import (
+	"encoding/asn1"
+	"fmt"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+)
+
+var (
+	oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidSecp256r1 = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+)
+
+// marshalECParams returns the DER encoded curve OID used as CKA_EC_PARAMS
+func marshalECParams(alg *entities2.Algorithm) ([]byte, error) {
+	switch {
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
+		return asn1.Marshal(oidSecp256k1)
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		return asn1.Marshal(oidSecp256r1)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm")
+	}
+}
+
+func parseECParams(ecParams []byte) (*entities2.Algorithm, error) {
+	var oid asn1.ObjectIdentifier
+	_, err := asn1.Unmarshal(ecParams, &oid)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case oid.Equal(oidSecp256k1):
+		return &entities2.Algorithm{Type: entities2.Ecdsa, EllipticCurve: entities2.Secp256k1}, nil
+	case oid.Equal(oidSecp256r1):
+		return &entities2.Algorithm{Type: entities2.Ecdsa, EllipticCurve: entities2.Secp256r1}, nil
+	default:
+		return nil, fmt.Errorf("unsupported curve %s", oid.String())
+	}
+}
+
+// parseECPoint returns the uncompressed public key from CKA_EC_POINT
+// The point is DER encoded as an OCTET STRING by compliant tokens, some tokens returning it raw
+func parseECPoint(ecPoint []byte) ([]byte, error) {
+	var point []byte
+	rest, err := asn1.Unmarshal(ecPoint, &point)
+	if err == nil && len(rest) == 0 && isUncompressedPoint(point) {
+		return point, nil
+	}
+
+	if isUncompressedPoint(ecPoint) {
+		return ecPoint, nil
+	}
+
+	return nil, fmt.Errorf("invalid uncompressed elliptic curve point")
+}
+
+func isUncompressedPoint(point []byte) bool {
+	return len(point) == 65 && point[0] == 0x04
+}
@@ -0,0 +1,218 @@
+package pkcs11
+
+import (
+	"context"
+	"time"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/pkcs11"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// Store is a key store backed by a PKCS#11 token (HSM), private keys never leave the token.
+// Keys are identified by the label of their key pair
+type Store struct {
+	client pkcs11.KeysClient
+	logger log.Logger
+}
+
+var _ stores.KeyStore = &Store{}
+
+func New(client pkcs11.KeysClient, logger log.Logger) *Store {
+	return &Store{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	ecParams, err := marshalECParams(alg)
+	if err != nil {
+		errMessage := "invalid or not supported elliptic curve and signing algorithm for PKCS#11 key creation"
+		logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	ecPoint, err := s.client.GenerateKeyPair(ctx, id, ecParams)
+	if err != nil {
+		errMessage := "failed to create PKCS#11 key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	pubKey, err := parseECPoint(ecPoint)
+	if err != nil {
+		errMessage := "failed to parse public key generated by PKCS#11 token"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.PKCS11Error(errMessage)
+	}
+
+	now := time.Now()
+	return &entities.Key{
+		ID:        id,
+		PublicKey: pubKey,
+		Algo:      alg,
+		Metadata: &entities.Metadata{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Tags: attr.Tags,
+	}, nil
+}
+
+// Import an externally created key and stores it
+// this feature is not supported by PKCS#11 key stores as private keys must be generated in the token
+// always returns errors.ErrNotSupported
+func (s *Store) Import(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
+	err := errors.NotSupportedError("import key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	ecParams, ecPoint, err := s.client.GetPublicKey(ctx, id)
+	if err != nil {
+		errMessage := "failed to get PKCS#11 key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	alg, err := parseECParams(ecParams)
+	if err != nil {
+		errMessage := "unsupported curve of PKCS#11 key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.PKCS11Error(errMessage)
+	}
+
+	pubKey, err := parseECPoint(ecPoint)
+	if err != nil {
+		errMessage := "failed to parse public key of PKCS#11 key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.PKCS11Error(errMessage)
+	}
+
+	return &entities.Key{
+		ID:        id,
+		PublicKey: pubKey,
+		Algo:      alg,
+		Metadata:  &entities.Metadata{},
+	}, nil
+}
+
+func (s *Store) GetVersion(_ context.Context, _, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("get key version is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Rotate(_ context.Context, _ string, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
+	err := errors.NotSupportedError("rotate key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
+	ids, err := s.client.ListKeys(ctx)
+	if err != nil {
+		errMessage := "failed to list PKCS#11 keys"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+// Update updates the attributes of a key
+// PKCS#11 tokens do not store tags, they are only kept by the key manager
+// always returns errors.ErrNotSupported
+func (s *Store) Update(_ context.Context, _ string, _ *entities.Attributes) (*entities.Key, error) {
+	err := errors.NotSupportedError("update key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Delete(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("delete key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("get deleted key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list deleted keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Restore(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("restore key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+// Destroy permanently removes the key pair from the token
+func (s *Store) Destroy(ctx context.Context, id string) error {
+	err := s.client.DestroyKeyPair(ctx, id)
+	if err != nil {
+		errMessage := "failed to destroy PKCS#11 key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+// Sign signs a digest with CKM_ECDSA, the signature being the concatenation of R and S
+func (s *Store) Sign(ctx context.Context, id string, data []byte, _ *entities2.Algorithm) ([]byte, error) {
+	signature, err := s.client.Sign(ctx, id, data)
+	if err != nil {
+		errMessage := "failed to sign using PKCS#11 key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return signature, nil
+}
+
+func (s *Store) Encrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("encrypt is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Decrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("decrypt is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("key export is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
@@ -0,0 +1,191 @@
+package pkcs11
+
+import (
+	"context"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/infra/pkcs11/mocks"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const id = "my-key"
+
+var expectedErr = errors.PKCS11Error("error")
+
+type pkcs11KeyStoreTestSuite struct {
+	suite.Suite
+	mockClient *mocks.MockKeysClient
+	keyStore   stores.KeyStore
+	pubKey     []byte
+}
+
+func TestPKCS11KeyStore(t *testing.T) {
+	s := new(pkcs11KeyStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *pkcs11KeyStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(s.T(), err)
+
+	s.pubKey = crypto.FromECDSAPub(&privKey.PublicKey)
+	s.mockClient = mocks.NewMockKeysClient(ctrl)
+	s.keyStore = New(s.mockClient, testutils.NewMockLogger(ctrl))
+}
+
+func (s *pkcs11KeyStoreTestSuite) TestCreate() {
+	ctx := context.Background()
+	attributes := testutils2.FakeAttributes()
+	algorithm := &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256k1}
+	ecParams, _ := asn1.Marshal(oidSecp256k1)
+
+	s.Run("should create a new key successfully", func() {
+		ecPoint, _ := asn1.Marshal(s.pubKey)
+		s.mockClient.EXPECT().GenerateKeyPair(ctx, id, ecParams).Return(ecPoint, nil)
+
+		key, err := s.keyStore.Create(ctx, id, algorithm, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), id, key.ID)
+		assert.Equal(s.T(), s.pubKey, key.PublicKey)
+		assert.Equal(s.T(), algorithm, key.Algo)
+		assert.Equal(s.T(), attributes.Tags, key.Tags)
+		assert.NotEmpty(s.T(), key.Metadata.CreatedAt)
+	})
+
+	s.Run("should fail with NotSupported error if the curve is not supported", func() {
+		key, err := s.keyStore.Create(ctx, id, &entities.Algorithm{Type: entities.Eddsa, EllipticCurve: entities.Babyjubjub}, attributes)
+
+		assert.Nil(s.T(), key)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if GenerateKeyPair fails", func() {
+		s.mockClient.EXPECT().GenerateKeyPair(ctx, id, ecParams).Return(nil, expectedErr)
+
+		key, err := s.keyStore.Create(ctx, id, algorithm, attributes)
+
+		assert.Nil(s.T(), key)
+		assert.True(s.T(), errors.IsPKCS11Error(err))
+	})
+}
+
+func (s *pkcs11KeyStoreTestSuite) TestGet() {
+	ctx := context.Background()
+	ecParams, _ := asn1.Marshal(oidSecp256r1)
+
+	s.Run("should get a key successfully", func() {
+		ecPoint, _ := asn1.Marshal(s.pubKey)
+		s.mockClient.EXPECT().GetPublicKey(ctx, id).Return(ecParams, ecPoint, nil)
+
+		key, err := s.keyStore.Get(ctx, id)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), s.pubKey, key.PublicKey)
+		assert.Equal(s.T(), entities.Secp256r1, key.Algo.EllipticCurve)
+		assert.Equal(s.T(), entities.Ecdsa, key.Algo.Type)
+	})
+
+	s.Run("should get a key successfully if the token returns a raw point", func() {
+		s.mockClient.EXPECT().GetPublicKey(ctx, id).Return(ecParams, s.pubKey, nil)
+
+		key, err := s.keyStore.Get(ctx, id)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), s.pubKey, key.PublicKey)
+	})
+
+	s.Run("should fail with PKCS11 error if the curve is not supported", func() {
+		unknownParams, _ := asn1.Marshal(asn1.ObjectIdentifier{1, 3, 132, 0, 34})
+		s.mockClient.EXPECT().GetPublicKey(ctx, id).Return(unknownParams, s.pubKey, nil)
+
+		_, err := s.keyStore.Get(ctx, id)
+
+		assert.True(s.T(), errors.IsPKCS11Error(err))
+	})
+
+	s.Run("should fail with same error if GetPublicKey fails", func() {
+		s.mockClient.EXPECT().GetPublicKey(ctx, id).Return(nil, nil, errors.NotFoundError("error"))
+
+		_, err := s.keyStore.Get(ctx, id)
+
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+}
+
+func (s *pkcs11KeyStoreTestSuite) TestList() {
+	ctx := context.Background()
+
+	s.Run("should list keys successfully", func() {
+		s.mockClient.EXPECT().ListKeys(ctx).Return([]string{id, "my-key-2"}, nil)
+
+		ids, err := s.keyStore.List(ctx, 0, 0)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{id, "my-key-2"}, ids)
+	})
+
+	s.Run("should fail with same error if ListKeys fails", func() {
+		s.mockClient.EXPECT().ListKeys(ctx).Return(nil, expectedErr)
+
+		_, err := s.keyStore.List(ctx, 0, 0)
+
+		assert.True(s.T(), errors.IsPKCS11Error(err))
+	})
+}
+
+func (s *pkcs11KeyStoreTestSuite) TestSign() {
+	ctx := context.Background()
+	digest := crypto.Keccak256([]byte("my data"))
+	signature := make([]byte, 64)
+
+	s.Run("should sign successfully", func() {
+		s.mockClient.EXPECT().Sign(ctx, id, digest).Return(signature, nil)
+
+		sig, err := s.keyStore.Sign(ctx, id, digest, nil)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), signature, sig)
+	})
+
+	s.Run("should fail with same error if Sign fails", func() {
+		s.mockClient.EXPECT().Sign(ctx, id, digest).Return(nil, expectedErr)
+
+		_, err := s.keyStore.Sign(ctx, id, digest, nil)
+
+		assert.True(s.T(), errors.IsPKCS11Error(err))
+	})
+}
+
+func (s *pkcs11KeyStoreTestSuite) TestDestroy() {
+	ctx := context.Background()
+
+	s.Run("should destroy a key successfully", func() {
+		s.mockClient.EXPECT().DestroyKeyPair(ctx, id).Return(nil)
+
+		err := s.keyStore.Destroy(ctx, id)
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if DestroyKeyPair fails", func() {
+		s.mockClient.EXPECT().DestroyKeyPair(ctx, id).Return(expectedErr)
+
+		err := s.keyStore.Destroy(ctx, id)
+
+		assert.True(s.T(), errors.IsPKCS11Error(err))
+	})
+}
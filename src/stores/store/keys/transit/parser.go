@@ -0,0 +1,129 @@
+package transit
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// parseTransitKey converts the response of a Transit key read into a key, using the latest version if version is empty
+func parseTransitKey(id, version string, data map[string]interface{}) (*entities.Key, error) {
+	keyType, _ := data[typeLabel].(string)
+	algo, err := parseAlgorithm(keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersion := fmt.Sprint(data[latestVersionLabel])
+	if _, err = strconv.Atoi(latestVersion); err != nil {
+		return nil, errors.HashicorpVaultError("invalid latest version of Transit key")
+	}
+	if version == "" {
+		version = latestVersion
+	}
+
+	versions, _ := data[keysLabel].(map[string]interface{})
+	keyVersion, ok := versions[version].(map[string]interface{})
+	if !ok {
+		return nil, errors.NotFoundError("version %s of Transit key was not found", version)
+	}
+
+	pubKey, err := parsePublicKey(keyType, keyVersion[publicKeyLabel])
+	if err != nil {
+		return nil, errors.HashicorpVaultError("failed to decode public key")
+	}
+
+	key := &entities.Key{
+		ID:        id,
+		PublicKey: pubKey,
+		Algo:      algo,
+		Metadata: &entities.Metadata{
+			Version:  version,
+			Disabled: false,
+		},
+		Tags: make(map[string]string),
+	}
+
+	key.Metadata.CreatedAt = parseCreationTime(keyVersion)
+	if latest, ok := versions[latestVersion].(map[string]interface{}); ok {
+		key.Metadata.UpdatedAt = parseCreationTime(latest)
+	}
+
+	return key, nil
+}
+
+func parseAlgorithm(keyType string) (*entities2.Algorithm, error) {
+	switch keyType {
+	case ed25519KeyType:
+		return &entities2.Algorithm{Type: entities2.Eddsa, EllipticCurve: entities2.Curve25519}, nil
+	case ecdsaP256KeyType:
+		return &entities2.Algorithm{Type: entities2.Ecdsa, EllipticCurve: entities2.Secp256r1}, nil
+	default:
+		return nil, errors.NotSupportedError("Transit key type %s is not supported", keyType)
+	}
+}
+
+// parsePublicKey decodes ED25519 public keys encoded in base64 and ECDSA public keys encoded in PEM,
+// the latter being returned uncompressed
+func parsePublicKey(keyType string, value interface{}) ([]byte, error) {
+	encoded, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("missing public key")
+	}
+
+	if keyType == ed25519KeyType {
+		return base64.StdEncoding.DecodeString(encoded)
+	}
+
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, fmt.Errorf("invalid PEM public key")
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("not an ECDSA public key")
+	}
+
+	return elliptic.Marshal(ecdsaPub.Curve, ecdsaPub.X, ecdsaPub.Y), nil
+}
+
+func parseSignature(value interface{}, keyType string) ([]byte, error) {
+	signature, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("missing signature")
+	}
+
+	if keyType == ecdsaP256KeyType {
+		return base64.RawURLEncoding.DecodeString(signatureValue(signature))
+	}
+
+	return base64.StdEncoding.DecodeString(signatureValue(signature))
+}
+
+func parseCreationTime(keyVersion map[string]interface{}) time.Time {
+	creationTime, _ := keyVersion[creationTimeLabel].(string)
+	t, _ := time.Parse(time.RFC3339Nano, creationTime)
+	return t
+}
+
+// signatureValue strips the "vault:v<version>:" prefix of Transit signatures
+func signatureValue(signature string) string {
+	parts := strings.SplitN(signature, ":", 3)
+	return parts[len(parts)-1]
+}
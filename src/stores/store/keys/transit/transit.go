@@ -0,0 +1,294 @@
+package transit
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+const (
+	typeLabel                = "type"
+	exportableLabel          = "exportable"
+	deletionAllowedLabel     = "deletion_allowed"
+	inputLabel               = "input"
+	prehashedLabel           = "prehashed"
+	hashAlgorithmLabel       = "hash_algorithm"
+	marshalingAlgorithmLabel = "marshaling_algorithm"
+	signatureLabel           = "signature"
+	keysLabel                = "keys"
+	latestVersionLabel       = "latest_version"
+	publicKeyLabel           = "public_key"
+	creationTimeLabel        = "creation_time"
+
+	ed25519KeyType   = "ed25519"
+	ecdsaP256KeyType = "ecdsa-p256"
+)
+
+// Store is a key store backed by the Transit secrets engine of Hashicorp Vault, keys are created non-exportable
+type Store struct {
+	client hashicorp.TransitClient
+	logger log.Logger
+}
+
+var _ stores.KeyStore = &Store{}
+
+func New(client hashicorp.TransitClient, logger log.Logger) *Store {
+	return &Store{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	keyType, err := s.keyType(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.CreateTransitKey(id, map[string]interface{}{
+		typeLabel:       keyType,
+		exportableLabel: false,
+	})
+	if err != nil {
+		errMessage := "failed to create Transit key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	key, err := s.getKey(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Transit keys have no tags, they are only kept by the key manager
+	key.Tags = attr.Tags
+	return key, nil
+}
+
+func (s *Store) Import(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
+	err := errors.NotSupportedError("import key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
+	return s.getKey(ctx, id, "")
+}
+
+func (s *Store) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	return s.getKey(ctx, id, version)
+}
+
+func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
+	res, err := s.client.ListTransitKeys()
+	if err != nil {
+		errMessage := "failed to list Transit keys"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if res == nil || res.Data == nil || res.Data[keysLabel] == nil {
+		return []string{}, nil
+	}
+
+	keyIds, ok := res.Data[keysLabel].([]interface{})
+	if !ok {
+		return []string{}, nil
+	}
+
+	var ids []string
+	for _, id := range keyIds {
+		ids = append(ids, id.(string))
+	}
+
+	return ids, nil
+}
+
+func (s *Store) Update(_ context.Context, _ string, _ *entities.Attributes) (*entities.Key, error) {
+	err := errors.NotSupportedError("update key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Rotate(ctx context.Context, id string, _ *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	err := s.client.RotateTransitKey(id)
+	if err != nil {
+		errMessage := "failed to rotate Transit key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	key, err := s.getKey(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	key.Tags = attr.Tags
+	return key, nil
+}
+
+func (s *Store) Delete(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("delete key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("get deleted key is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list deleted keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Restore(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("restore key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Destroy(_ context.Context, id string) error {
+	logger := s.logger.With("id", id)
+
+	// Transit refuses to delete keys unless explicitly allowed in their configuration
+	err := s.client.UpdateTransitKeyConfig(id, map[string]interface{}{
+		deletionAllowedLabel: true,
+	})
+	if err != nil {
+		errMessage := "failed to allow deletion of Transit key"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = s.client.DeleteTransitKey(id)
+	if err != nil {
+		errMessage := "failed to permanently delete Transit key"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *Store) Sign(_ context.Context, id string, data []byte, alg *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	keyType, err := s.keyType(alg)
+	if err != nil {
+		return nil, err
+	}
+
+	req := map[string]interface{}{
+		inputLabel: base64.StdEncoding.EncodeToString(data),
+	}
+	if keyType == ecdsaP256KeyType {
+		if len(data) != 32 {
+			errMessage := "data to sign with ECDSA/Secp256r1 must be a 32 bytes digest"
+			logger.Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+
+		// The digest is signed as is and the signature is returned as the concatenation of R and S
+		req[prehashedLabel] = true
+		req[hashAlgorithmLabel] = "sha2-256"
+		req[marshalingAlgorithmLabel] = "jws"
+	}
+
+	res, err := s.client.TransitSign(id, req)
+	if err != nil {
+		errMessage := "failed to sign using Transit key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	signature, err := parseSignature(res.Data[signatureLabel], keyType)
+	if err != nil {
+		errMessage := "failed to decode signature from Hashicorp Vault"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.HashicorpVaultError(errMessage)
+	}
+
+	return signature, nil
+}
+
+func (s *Store) Encrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("encryption is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Decrypt(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("decryption is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("key export is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable key is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) getKey(_ context.Context, id, version string) (*entities.Key, error) {
+	logger := s.logger.With("id", id, "version", version)
+
+	res, err := s.client.GetTransitKey(id)
+	if err != nil {
+		errMessage := "failed to get Transit key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if res == nil || res.Data == nil {
+		errMessage := "Transit key was not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	key, err := parseTransitKey(id, version, res.Data)
+	if err != nil {
+		logger.WithError(err).Error("failed to parse Transit key")
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *Store) keyType(alg *entities2.Algorithm) (string, error) {
+	switch {
+	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Curve25519:
+		return ed25519KeyType, nil
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256r1:
+		return ecdsaP256KeyType, nil
+	default:
+		errMessage := "invalid or not supported elliptic curve and signing algorithm for Transit keys"
+		s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type).Error(errMessage)
+		return "", errors.NotSupportedError(errMessage)
+	}
+}
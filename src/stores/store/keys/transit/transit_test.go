@@ -0,0 +1,304 @@
+package transit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	ecdsacrypto "github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp/mocks"
+	testutils2 "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/golang/mock/gomock"
+	hashicorp "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const id = "my-key"
+
+var expectedErr = errors.HashicorpVaultError("error")
+
+var (
+	p256Algo    = &entities.Algorithm{Type: entities.Ecdsa, EllipticCurve: entities.Secp256r1}
+	ed25519Algo = &entities.Algorithm{Type: entities.Eddsa, EllipticCurve: entities.Curve25519}
+)
+
+type transitKeyStoreTestSuite struct {
+	suite.Suite
+	mockVault *mocks.MockTransitClient
+	keyStore  stores.KeyStore
+	p256Key   *ecdsa.PrivateKey
+	edKey     ed25519.PrivateKey
+}
+
+func TestTransitKeyStore(t *testing.T) {
+	s := new(transitKeyStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *transitKeyStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.mockVault = mocks.NewMockTransitClient(ctrl)
+	s.keyStore = New(s.mockVault, testutils2.NewMockLogger(ctrl))
+
+	var err error
+	s.p256Key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(s.T(), err)
+	_, s.edKey, err = ed25519.GenerateKey(rand.Reader)
+	require.NoError(s.T(), err)
+}
+
+func (s *transitKeyStoreTestSuite) TestCreate() {
+	ctx := context.Background()
+	attributes := testutils.FakeAttributes()
+
+	s.Run("should create a non-exportable ECDSA/Secp256r1 key successfully", func() {
+		s.mockVault.EXPECT().CreateTransitKey(id, map[string]interface{}{
+			typeLabel:       ecdsaP256KeyType,
+			exportableLabel: false,
+		}).Return(nil)
+		s.mockVault.EXPECT().GetTransitKey(id).Return(s.p256Secret(), nil)
+
+		key, err := s.keyStore.Create(ctx, id, p256Algo, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), id, key.ID)
+		assert.Equal(s.T(), elliptic.Marshal(elliptic.P256(), s.p256Key.X, s.p256Key.Y), key.PublicKey)
+		assert.Equal(s.T(), p256Algo, key.Algo)
+		assert.Equal(s.T(), "2", key.Metadata.Version)
+		assert.Equal(s.T(), "2021-07-02T10:00:00Z", key.Metadata.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		assert.Equal(s.T(), attributes.Tags, key.Tags)
+	})
+
+	s.Run("should create an EDDSA/Curve25519 key successfully", func() {
+		s.mockVault.EXPECT().CreateTransitKey(id, map[string]interface{}{
+			typeLabel:       ed25519KeyType,
+			exportableLabel: false,
+		}).Return(nil)
+		s.mockVault.EXPECT().GetTransitKey(id).Return(s.ed25519Secret(), nil)
+
+		key, err := s.keyStore.Create(ctx, id, ed25519Algo, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []byte(s.edKey.Public().(ed25519.PublicKey)), key.PublicKey)
+		assert.Equal(s.T(), ed25519Algo, key.Algo)
+		assert.Equal(s.T(), "1", key.Metadata.Version)
+	})
+
+	s.Run("should fail with NotSupported error if the curve is not offered by Transit", func() {
+		key, err := s.keyStore.Create(ctx, id, testutils.FakeAlgorithm(), attributes)
+
+		assert.Nil(s.T(), key)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if CreateTransitKey fails", func() {
+		s.mockVault.EXPECT().CreateTransitKey(id, gomock.Any()).Return(expectedErr)
+
+		key, err := s.keyStore.Create(ctx, id, p256Algo, attributes)
+
+		assert.Nil(s.T(), key)
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *transitKeyStoreTestSuite) TestGetVersion() {
+	ctx := context.Background()
+
+	s.Run("should get a previous version of a key successfully", func() {
+		s.mockVault.EXPECT().GetTransitKey(id).Return(s.p256Secret(), nil)
+
+		key, err := s.keyStore.GetVersion(ctx, id, "1")
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "1", key.Metadata.Version)
+		assert.Equal(s.T(), "2021-07-01T10:00:00Z", key.Metadata.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+		assert.Equal(s.T(), "2021-07-02T10:00:00Z", key.Metadata.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	})
+
+	s.Run("should fail with NotFound error if the version does not exist", func() {
+		s.mockVault.EXPECT().GetTransitKey(id).Return(s.p256Secret(), nil)
+
+		_, err := s.keyStore.GetVersion(ctx, id, "3")
+
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+}
+
+func (s *transitKeyStoreTestSuite) TestList() {
+	ctx := context.Background()
+
+	s.Run("should list all key ids successfully", func() {
+		s.mockVault.EXPECT().ListTransitKeys().Return(&hashicorp.Secret{
+			Data: map[string]interface{}{keysLabel: []interface{}{"my-key1", "my-key2"}},
+		}, nil)
+
+		ids, err := s.keyStore.List(ctx, 0, 0)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"my-key1", "my-key2"}, ids)
+	})
+
+	s.Run("should return an empty list if there are no keys", func() {
+		s.mockVault.EXPECT().ListTransitKeys().Return(nil, nil)
+
+		ids, err := s.keyStore.List(ctx, 0, 0)
+
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), ids)
+	})
+}
+
+func (s *transitKeyStoreTestSuite) TestRotate() {
+	ctx := context.Background()
+	attributes := testutils.FakeAttributes()
+
+	s.Run("should rotate a key successfully", func() {
+		s.mockVault.EXPECT().RotateTransitKey(id).Return(nil)
+		s.mockVault.EXPECT().GetTransitKey(id).Return(s.p256Secret(), nil)
+
+		key, err := s.keyStore.Rotate(ctx, id, p256Algo, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "2", key.Metadata.Version)
+	})
+
+	s.Run("should fail with same error if RotateTransitKey fails", func() {
+		s.mockVault.EXPECT().RotateTransitKey(id).Return(expectedErr)
+
+		_, err := s.keyStore.Rotate(ctx, id, p256Algo, attributes)
+
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *transitKeyStoreTestSuite) TestSign() {
+	ctx := context.Background()
+
+	s.Run("should sign a digest with an ECDSA/Secp256r1 key successfully", func() {
+		digest := sha256.Sum256([]byte("my data"))
+		r, sig, err := ecdsa.Sign(rand.Reader, s.p256Key, digest[:])
+		require.NoError(s.T(), err)
+		rs := make([]byte, 64)
+		r.FillBytes(rs[:32])
+		sig.FillBytes(rs[32:])
+
+		s.mockVault.EXPECT().TransitSign(id, map[string]interface{}{
+			inputLabel:               base64.StdEncoding.EncodeToString(digest[:]),
+			prehashedLabel:           true,
+			hashAlgorithmLabel:       "sha2-256",
+			marshalingAlgorithmLabel: "jws",
+		}).Return(&hashicorp.Secret{
+			Data: map[string]interface{}{signatureLabel: "vault:v2:" + base64.RawURLEncoding.EncodeToString(rs)},
+		}, nil)
+
+		signature, err := s.keyStore.Sign(ctx, id, digest[:], p256Algo)
+
+		require.NoError(s.T(), err)
+		verified, err := ecdsacrypto.VerifySecp256r1Signature(elliptic.Marshal(elliptic.P256(), s.p256Key.X, s.p256Key.Y), digest[:], signature)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), verified)
+	})
+
+	s.Run("should sign a payload with an EDDSA/Curve25519 key successfully", func() {
+		payload := []byte("my data")
+
+		s.mockVault.EXPECT().TransitSign(id, map[string]interface{}{
+			inputLabel: base64.StdEncoding.EncodeToString(payload),
+		}).Return(&hashicorp.Secret{
+			Data: map[string]interface{}{signatureLabel: "vault:v1:" + base64.StdEncoding.EncodeToString(ed25519.Sign(s.edKey, payload))},
+		}, nil)
+
+		signature, err := s.keyStore.Sign(ctx, id, payload, ed25519Algo)
+
+		require.NoError(s.T(), err)
+		assert.True(s.T(), ed25519.Verify(s.edKey.Public().(ed25519.PublicKey), payload, signature))
+	})
+
+	s.Run("should fail with InvalidParameter error if the ECDSA payload is not a digest", func() {
+		_, err := s.keyStore.Sign(ctx, id, []byte("my data"), p256Algo)
+
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
+	})
+
+	s.Run("should fail with same error if TransitSign fails", func() {
+		s.mockVault.EXPECT().TransitSign(id, gomock.Any()).Return(nil, expectedErr)
+
+		_, err := s.keyStore.Sign(ctx, id, []byte("my data"), ed25519Algo)
+
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *transitKeyStoreTestSuite) TestDestroy() {
+	ctx := context.Background()
+
+	s.Run("should allow deletion and delete the key successfully", func() {
+		s.mockVault.EXPECT().UpdateTransitKeyConfig(id, map[string]interface{}{deletionAllowedLabel: true}).Return(nil)
+		s.mockVault.EXPECT().DeleteTransitKey(id).Return(nil)
+
+		err := s.keyStore.Destroy(ctx, id)
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if UpdateTransitKeyConfig fails", func() {
+		s.mockVault.EXPECT().UpdateTransitKeyConfig(id, gomock.Any()).Return(expectedErr)
+
+		err := s.keyStore.Destroy(ctx, id)
+
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *transitKeyStoreTestSuite) TestExport() {
+	_, err := s.keyStore.Export(context.Background(), id)
+	assert.True(s.T(), errors.IsNotSupportedError(err))
+}
+
+func (s *transitKeyStoreTestSuite) p256Secret() *hashicorp.Secret {
+	der, err := x509.MarshalPKIXPublicKey(&s.p256Key.PublicKey)
+	require.NoError(s.T(), err)
+	pubKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	return &hashicorp.Secret{
+		Data: map[string]interface{}{
+			typeLabel:          ecdsaP256KeyType,
+			latestVersionLabel: json.Number("2"),
+			keysLabel: map[string]interface{}{
+				"1": map[string]interface{}{creationTimeLabel: "2021-07-01T10:00:00Z", publicKeyLabel: pubKey},
+				"2": map[string]interface{}{creationTimeLabel: "2021-07-02T10:00:00Z", publicKeyLabel: pubKey},
+			},
+		},
+	}
+}
+
+func (s *transitKeyStoreTestSuite) ed25519Secret() *hashicorp.Secret {
+	return &hashicorp.Secret{
+		Data: map[string]interface{}{
+			typeLabel:          ed25519KeyType,
+			latestVersionLabel: json.Number("1"),
+			keysLabel: map[string]interface{}{
+				"1": map[string]interface{}{
+					creationTimeLabel: "2021-07-01T10:00:00Z",
+					publicKeyLabel:    base64.StdEncoding.EncodeToString(s.edKey.Public().(ed25519.PublicKey)),
+				},
+			},
+		},
+	}
+}
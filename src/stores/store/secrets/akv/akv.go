@@ -130,3 +130,15 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
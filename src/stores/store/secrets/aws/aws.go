@@ -180,3 +180,15 @@ func (s *Store) listPaginated(ctx context.Context, maxResults int64, nextToken s
 
 	return secretNamesList, listOutput.NextToken, nil
 }
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
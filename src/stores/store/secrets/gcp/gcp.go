@@ -0,0 +1,151 @@
+package gcp
+
+import (
+	"context"
+	"path"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/gcp"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type Store struct {
+	client gcp.SecretManagerClient
+	logger log.Logger
+}
+
+var _ stores.SecretStore = &Store{}
+
+func New(client gcp.SecretManagerClient, logger log.Logger) *Store {
+	return &Store{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (s *Store) Info(context.Context) (*entities.Store, error) {
+	return nil, errors.ErrNotImplemented
+}
+
+// Set creates the secret if it does not exist, updating its labels otherwise, and adds the value as a new version
+func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	logger := s.logger.With("id", id)
+
+	_, err := s.client.CreateSecret(ctx, id, attr.Tags)
+	if err != nil && errors.IsAlreadyExistsError(err) {
+		_, err = s.client.UpdateSecretLabels(ctx, id, attr.Tags)
+		if err != nil {
+			errMessage := "failed to set existing GCP secret labels"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		}
+	} else if err != nil {
+		errMessage := "failed to create GCP secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	version, err := s.client.AddSecretVersion(ctx, id, []byte(value))
+	if err != nil {
+		errMessage := "failed to add GCP secret version"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return formatGCPSecret(id, value, attr.Tags, version), nil
+}
+
+// Get gets a version of a secret, the latest one if version is empty
+func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
+	logger := s.logger.With("id", id, "version", version)
+
+	secret, err := s.client.GetSecret(ctx, id)
+	if err != nil {
+		errMessage := "failed to get GCP secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	secretVersion, err := s.client.GetSecretVersion(ctx, id, version)
+	if err != nil {
+		errMessage := "failed to get GCP secret version"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	value, err := s.client.AccessSecretVersion(ctx, id, path.Base(secretVersion.Name))
+	if err != nil {
+		errMessage := "failed to access GCP secret version"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return formatGCPSecret(id, string(value), secret.Labels, secretVersion), nil
+}
+
+func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
+	secrets, err := s.client.ListSecrets(ctx)
+	if err != nil {
+		errMessage := "failed to list GCP secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var ids []string
+	for _, secret := range secrets {
+		ids = append(ids, path.Base(secret.Name))
+	}
+
+	return ids, nil
+}
+
+// Delete is not supported as Secret Manager has no soft deletion, the secret is only deleted from the database
+func (s *Store) Delete(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("delete secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Secret, error) {
+	err := errors.NotSupportedError("get deleted secret is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list deleted secret is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Restore(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("restore secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+// Destroy permanently deletes the secret and all its versions
+func (s *Store) Destroy(ctx context.Context, id string) error {
+	err := s.client.DeleteSecret(ctx, id)
+	if err != nil {
+		errMessage := "failed to permanently delete GCP secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
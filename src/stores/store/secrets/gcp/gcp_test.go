@@ -0,0 +1,159 @@
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/gcp/mocks"
+	testutils2 "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	id         = "my-secret"
+	secretName = "projects/my-project/secrets/my-secret"
+)
+
+var expectedErr = errors.GCPError("error")
+
+type gcpSecretStoreTestSuite struct {
+	suite.Suite
+	mockClient  *mocks.MockSecretManagerClient
+	secretStore stores.SecretStore
+}
+
+func TestGCPSecretStore(t *testing.T) {
+	s := new(gcpSecretStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *gcpSecretStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.mockClient = mocks.NewMockSecretManagerClient(ctrl)
+	s.secretStore = New(s.mockClient, testutils2.NewMockLogger(ctrl))
+}
+
+func (s *gcpSecretStoreTestSuite) TestSet() {
+	ctx := context.Background()
+	value := "my-value"
+	attributes := testutils.FakeAttributes()
+	version := fakeVersion("1")
+
+	s.Run("should set a new secret successfully", func() {
+		s.mockClient.EXPECT().CreateSecret(ctx, id, attributes.Tags).Return(&secretmanagerpb.Secret{Name: secretName}, nil)
+		s.mockClient.EXPECT().AddSecretVersion(ctx, id, []byte(value)).Return(version, nil)
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), value, secret.Value)
+		assert.Equal(s.T(), attributes.Tags, secret.Tags)
+		assert.Equal(s.T(), "1", secret.Metadata.Version)
+		assert.False(s.T(), secret.Metadata.Disabled)
+	})
+
+	s.Run("should add a version to an existing secret successfully", func() {
+		s.mockClient.EXPECT().CreateSecret(ctx, id, attributes.Tags).Return(nil, errors.AlreadyExistsError("error"))
+		s.mockClient.EXPECT().UpdateSecretLabels(ctx, id, attributes.Tags).Return(&secretmanagerpb.Secret{Name: secretName}, nil)
+		s.mockClient.EXPECT().AddSecretVersion(ctx, id, []byte(value)).Return(fakeVersion("2"), nil)
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "2", secret.Metadata.Version)
+	})
+
+	s.Run("should fail with same error if CreateSecret fails", func() {
+		s.mockClient.EXPECT().CreateSecret(ctx, id, attributes.Tags).Return(nil, expectedErr)
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		assert.Nil(s.T(), secret)
+		assert.True(s.T(), errors.IsGCPError(err))
+	})
+}
+
+func (s *gcpSecretStoreTestSuite) TestGet() {
+	ctx := context.Background()
+	tags := testutils.FakeTags()
+
+	s.Run("should get the latest version of a secret successfully", func() {
+		s.mockClient.EXPECT().GetSecret(ctx, id).Return(&secretmanagerpb.Secret{Name: secretName, Labels: tags}, nil)
+		s.mockClient.EXPECT().GetSecretVersion(ctx, id, "").Return(fakeVersion("2"), nil)
+		s.mockClient.EXPECT().AccessSecretVersion(ctx, id, "2").Return([]byte("my-value"), nil)
+
+		secret, err := s.secretStore.Get(ctx, id, "")
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "my-value", secret.Value)
+		assert.Equal(s.T(), tags, secret.Tags)
+		assert.Equal(s.T(), "2", secret.Metadata.Version)
+	})
+
+	s.Run("should fail with same error if GetSecretVersion fails", func() {
+		s.mockClient.EXPECT().GetSecret(ctx, id).Return(&secretmanagerpb.Secret{Name: secretName}, nil)
+		s.mockClient.EXPECT().GetSecretVersion(ctx, id, "3").Return(nil, errors.NotFoundError("error"))
+
+		secret, err := s.secretStore.Get(ctx, id, "3")
+
+		assert.Nil(s.T(), secret)
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+}
+
+func (s *gcpSecretStoreTestSuite) TestList() {
+	ctx := context.Background()
+
+	s.Run("should list secret IDs successfully", func() {
+		s.mockClient.EXPECT().ListSecrets(ctx).Return([]*secretmanagerpb.Secret{{Name: secretName}, {Name: secretName + "-2"}}, nil)
+
+		ids, err := s.secretStore.List(ctx, 0, 0)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{id, id + "-2"}, ids)
+	})
+}
+
+func (s *gcpSecretStoreTestSuite) TestDestroy() {
+	ctx := context.Background()
+
+	s.Run("should not support deleting a secret", func() {
+		err := s.secretStore.Delete(ctx, id)
+
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should destroy a secret successfully", func() {
+		s.mockClient.EXPECT().DeleteSecret(ctx, id).Return(nil)
+
+		err := s.secretStore.Destroy(ctx, id)
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if DeleteSecret fails", func() {
+		s.mockClient.EXPECT().DeleteSecret(ctx, id).Return(expectedErr)
+
+		err := s.secretStore.Destroy(ctx, id)
+
+		assert.True(s.T(), errors.IsGCPError(err))
+	})
+}
+
+func fakeVersion(version string) *secretmanagerpb.SecretVersion {
+	return &secretmanagerpb.SecretVersion{
+		Name:       secretName + "/versions/" + version,
+		State:      secretmanagerpb.SecretVersion_ENABLED,
+		CreateTime: timestamppb.Now(),
+	}
+}
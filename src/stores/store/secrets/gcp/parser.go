@@ -0,0 +1,29 @@
+package gcp
+
+import (
+	"path"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+)
+
+func formatGCPSecret(id, value string, labels map[string]string, version *secretmanagerpb.SecretVersion) *entities.Secret {
+	createdAt := version.CreateTime.AsTime()
+
+	metadata := &entities.Metadata{
+		Version:   path.Base(version.Name),
+		Disabled:  version.State != secretmanagerpb.SecretVersion_ENABLED,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt, // Versions cannot be updated so updatedAt = createdAt
+	}
+	if version.DestroyTime != nil {
+		metadata.DeletedAt = version.DestroyTime.AsTime()
+	}
+
+	return &entities.Secret{
+		ID:       id,
+		Value:    value,
+		Tags:     labels,
+		Metadata: metadata,
+	}
+}
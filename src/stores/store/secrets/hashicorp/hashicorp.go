@@ -228,3 +228,15 @@ func (s *Store) listVersions(ctx context.Context, id string, isDeleted bool) ([]
 
 	return versionList, nil
 }
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
@@ -0,0 +1,168 @@
+package kvv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+const (
+	valueLabel     = "value"
+	tagsLabel      = "tags"
+	createdAtLabel = "created_at"
+
+	// KV version 1 keeps a single value per secret
+	secretVersion = "1"
+)
+
+// Store is a secret store backed by a Hashicorp Vault KV version 1 mount, which neither versions nor soft deletes secrets
+type Store struct {
+	client hashicorp.Kvv1Client
+	logger log.Logger
+}
+
+var _ stores.SecretStore = &Store{}
+
+func New(client hashicorp.Kvv1Client, logger log.Logger) *Store {
+	return &Store{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (s *Store) Set(_ context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	logger := s.logger.With("id", id)
+
+	existing, err := s.client.ReadKvv1Secret(id)
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to get Hashicorp secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Writing an existing secret would overwrite its only version
+	if existing != nil {
+		err = errors.NotSupportedError("new versions of an existing secret are not supported by KV version 1")
+		logger.Warn(err.Error())
+		return nil, err
+	}
+
+	createdAt := time.Now().UTC()
+	err = s.client.SetKvv1Secret(id, map[string]interface{}{
+		valueLabel:     value,
+		tagsLabel:      attr.Tags,
+		createdAtLabel: createdAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		errMessage := "failed to create Hashicorp secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return formatSecret(id, value, attr.Tags, createdAt), nil
+}
+
+func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, error) {
+	logger := s.logger.With("id", id, "version", version)
+
+	if version != "" && version != secretVersion {
+		err := errors.NotSupportedError("secret versions are not supported by KV version 1")
+		logger.Warn(err.Error())
+		return nil, err
+	}
+
+	res, err := s.client.ReadKvv1Secret(id)
+	if err != nil {
+		errMessage := "failed to get Hashicorp secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	} else if res == nil || res.Data == nil {
+		errMessage := "Hashicorp secret not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	secret, err := parseSecret(id, res.Data)
+	if err != nil {
+		errMessage := "failed to parse Hashicorp secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.HashicorpVaultError(errMessage)
+	}
+
+	return secret, nil
+}
+
+func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
+	res, err := s.client.ListKvv1Secrets()
+	if err != nil {
+		errMessage := "failed to list Hashicorp secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if res == nil || res.Data == nil || res.Data["keys"] == nil {
+		return []string{}, nil
+	}
+
+	keysInterface := res.Data["keys"].([]interface{})
+	keysStr := make([]string, len(keysInterface))
+	for i, key := range keysInterface {
+		keysStr[i] = key.(string)
+	}
+
+	return keysStr, nil
+}
+
+func (s *Store) Delete(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("soft delete of secrets is not supported by KV version 1")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Secret, error) {
+	err := errors.NotSupportedError("get deleted secret is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list deleted secret is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Restore(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("restore of secrets is not supported by KV version 1")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Destroy(_ context.Context, id string) error {
+	logger := s.logger.With("id", id)
+
+	err := s.client.DeleteKvv1Secret(id)
+	if err != nil {
+		errMessage := "failed to destroy Hashicorp secret"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
@@ -0,0 +1,176 @@
+package kvv1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp/mocks"
+	testutils2 "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/golang/mock/gomock"
+	hashicorp "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const id = "my-secret"
+
+var expectedErr = errors.HashicorpVaultError("error")
+
+type kvv1SecretStoreTestSuite struct {
+	suite.Suite
+	mockVault   *mocks.MockKvv1Client
+	secretStore stores.SecretStore
+}
+
+func TestKvv1SecretStore(t *testing.T) {
+	s := new(kvv1SecretStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *kvv1SecretStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.mockVault = mocks.NewMockKvv1Client(ctrl)
+	s.secretStore = New(s.mockVault, testutils2.NewMockLogger(ctrl))
+}
+
+func (s *kvv1SecretStoreTestSuite) TestSet() {
+	ctx := context.Background()
+	value := "my-value"
+	attributes := testutils.FakeAttributes()
+
+	s.Run("should set a new secret successfully", func() {
+		s.mockVault.EXPECT().ReadKvv1Secret(id).Return(nil, nil)
+		s.mockVault.EXPECT().SetKvv1Secret(id, gomock.Any()).DoAndReturn(func(_ string, data map[string]interface{}) error {
+			assert.Equal(s.T(), value, data[valueLabel])
+			assert.Equal(s.T(), attributes.Tags, data[tagsLabel])
+			assert.NotEmpty(s.T(), data[createdAtLabel])
+			return nil
+		})
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), value, secret.Value)
+		assert.Equal(s.T(), attributes.Tags, secret.Tags)
+		assert.Equal(s.T(), "1", secret.Metadata.Version)
+	})
+
+	s.Run("should fail with NotSupported error if the secret already exists", func() {
+		s.mockVault.EXPECT().ReadKvv1Secret(id).Return(&hashicorp.Secret{Data: map[string]interface{}{valueLabel: "old-value"}}, nil)
+
+		_, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if SetKvv1Secret fails", func() {
+		s.mockVault.EXPECT().ReadKvv1Secret(id).Return(nil, nil)
+		s.mockVault.EXPECT().SetKvv1Secret(id, gomock.Any()).Return(expectedErr)
+
+		_, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *kvv1SecretStoreTestSuite) TestGet() {
+	ctx := context.Background()
+
+	s.Run("should get a secret successfully", func() {
+		s.mockVault.EXPECT().ReadKvv1Secret(id).Return(&hashicorp.Secret{
+			Data: map[string]interface{}{
+				valueLabel:     "my-value",
+				tagsLabel:      map[string]interface{}{"tag1": "tagValue1"},
+				createdAtLabel: "2021-07-01T10:00:00Z",
+			},
+		}, nil)
+
+		secret, err := s.secretStore.Get(ctx, id, "")
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "my-value", secret.Value)
+		assert.Equal(s.T(), map[string]string{"tag1": "tagValue1"}, secret.Tags)
+		assert.Equal(s.T(), "1", secret.Metadata.Version)
+		assert.Equal(s.T(), 2021, secret.Metadata.CreatedAt.Year())
+	})
+
+	s.Run("should get a secret not written by the key manager as JSON", func() {
+		s.mockVault.EXPECT().ReadKvv1Secret(id).Return(&hashicorp.Secret{
+			Data: map[string]interface{}{"username": "admin", "password": "pwd"},
+		}, nil)
+
+		secret, err := s.secretStore.Get(ctx, id, "1")
+
+		require.NoError(s.T(), err)
+		assert.JSONEq(s.T(), `{"username":"admin","password":"pwd"}`, secret.Value)
+	})
+
+	s.Run("should fail with NotSupported error if a version other than 1 is requested", func() {
+		_, err := s.secretStore.Get(ctx, id, "2")
+
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with NotFound error if the secret does not exist", func() {
+		s.mockVault.EXPECT().ReadKvv1Secret(id).Return(nil, nil)
+
+		_, err := s.secretStore.Get(ctx, id, "")
+
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+}
+
+func (s *kvv1SecretStoreTestSuite) TestList() {
+	ctx := context.Background()
+
+	s.Run("should list secrets successfully", func() {
+		s.mockVault.EXPECT().ListKvv1Secrets().Return(&hashicorp.Secret{
+			Data: map[string]interface{}{"keys": []interface{}{"my-secret1", "my-secret2"}},
+		}, nil)
+
+		ids, err := s.secretStore.List(ctx, 0, 0)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"my-secret1", "my-secret2"}, ids)
+	})
+
+	s.Run("should return an empty list if the mount is empty", func() {
+		s.mockVault.EXPECT().ListKvv1Secrets().Return(nil, nil)
+
+		ids, err := s.secretStore.List(ctx, 0, 0)
+
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), ids)
+	})
+}
+
+func (s *kvv1SecretStoreTestSuite) TestDeleteRestoreDestroy() {
+	ctx := context.Background()
+
+	s.Run("should fail with NotSupported error on delete and restore", func() {
+		assert.True(s.T(), errors.IsNotSupportedError(s.secretStore.Delete(ctx, id)))
+		assert.True(s.T(), errors.IsNotSupportedError(s.secretStore.Restore(ctx, id)))
+	})
+
+	s.Run("should destroy a secret successfully", func() {
+		s.mockVault.EXPECT().DeleteKvv1Secret(id).Return(nil)
+
+		err := s.secretStore.Destroy(ctx, id)
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if DeleteKvv1Secret fails", func() {
+		s.mockVault.EXPECT().DeleteKvv1Secret(id).Return(expectedErr)
+
+		err := s.secretStore.Destroy(ctx, id)
+
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
@@ -0,0 +1,49 @@
+package kvv1
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// parseSecret reads secrets written by the key manager, other secrets of the mount are returned as their JSON encoded data
+func parseSecret(id string, data map[string]interface{}) (*entities.Secret, error) {
+	value, ok := data[valueLabel].(string)
+	if !ok {
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return formatSecret(id, string(encoded), nil, time.Time{}), nil
+	}
+
+	var tags map[string]string
+	if tagsI, ok := data[tagsLabel].(map[string]interface{}); ok {
+		tags = make(map[string]string)
+		for k, v := range tagsI {
+			tags[k], _ = v.(string)
+		}
+	}
+
+	var createdAt time.Time
+	if createdAtStr, ok := data[createdAtLabel].(string); ok {
+		createdAt, _ = time.Parse(time.RFC3339Nano, createdAtStr)
+	}
+
+	return formatSecret(id, value, tags, createdAt), nil
+}
+
+func formatSecret(id, value string, tags map[string]string, createdAt time.Time) *entities.Secret {
+	return &entities.Secret{
+		ID:    id,
+		Value: value,
+		Tags:  tags,
+		Metadata: &entities.Metadata{
+			Version:   secretVersion,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		},
+	}
+}
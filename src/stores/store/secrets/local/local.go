@@ -0,0 +1,128 @@
+package local
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/local"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// Store is a secret store persisting secrets encrypted on the local filesystem, without any external vault
+type Store struct {
+	client local.SecretsClient
+	logger log.Logger
+}
+
+var _ stores.SecretStore = &Store{}
+
+func New(client local.SecretsClient, logger log.Logger) *Store {
+	return &Store{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	secret, err := s.client.SetSecret(ctx, id, value, attr.Tags)
+	if err != nil {
+		errMessage := "failed to create local secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return secret, nil
+}
+
+func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
+	secret, err := s.client.GetSecret(ctx, id, version)
+	if err != nil {
+		errMessage := "failed to get local secret"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return secret, nil
+}
+
+func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
+	ids, err := s.client.ListSecrets(ctx)
+	if err != nil {
+		errMessage := "failed to list local secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+func (s *Store) Enable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("enable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) Disable(_ context.Context, _ string) error {
+	err := errors.NotSupportedError("disable secret is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+// Delete soft-deletes all the versions of a secret
+func (s *Store) Delete(ctx context.Context, id string) error {
+	err := s.client.DeleteSecret(ctx, id)
+	if err != nil {
+		errMessage := "failed to delete local secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *Store) GetDeleted(ctx context.Context, id string) (*entities.Secret, error) {
+	secret, err := s.client.GetDeletedSecret(ctx, id)
+	if err != nil {
+		errMessage := "failed to get deleted local secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return secret, nil
+}
+
+func (s *Store) ListDeleted(ctx context.Context, _, _ uint64) ([]string, error) {
+	ids, err := s.client.ListDeletedSecrets(ctx)
+	if err != nil {
+		errMessage := "failed to list deleted local secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+func (s *Store) Restore(ctx context.Context, id string) error {
+	err := s.client.RestoreSecret(ctx, id)
+	if err != nil {
+		errMessage := "failed to restore local secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+// Destroy permanently removes all the versions of a deleted secret
+func (s *Store) Destroy(ctx context.Context, id string) error {
+	err := s.client.DestroySecret(ctx, id)
+	if err != nil {
+		errMessage := "failed to destroy local secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
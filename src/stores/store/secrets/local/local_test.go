@@ -0,0 +1,114 @@
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/local/mocks"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const id = "my-secret"
+
+var expectedErr = errors.DependencyFailureError("error")
+
+type localSecretStoreTestSuite struct {
+	suite.Suite
+	mockClient  *mocks.MockSecretsClient
+	secretStore stores.SecretStore
+}
+
+func TestLocalSecretStore(t *testing.T) {
+	s := new(localSecretStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *localSecretStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.mockClient = mocks.NewMockSecretsClient(ctrl)
+	s.secretStore = New(s.mockClient, testutils.NewMockLogger(ctrl))
+}
+
+func (s *localSecretStoreTestSuite) TestSet() {
+	ctx := context.Background()
+	attributes := testutils2.FakeAttributes()
+	secret := testutils2.FakeSecret()
+
+	s.Run("should set a new secret successfully", func() {
+		s.mockClient.EXPECT().SetSecret(ctx, id, secret.Value, attributes.Tags).Return(secret, nil)
+
+		result, err := s.secretStore.Set(ctx, id, secret.Value, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), secret, result)
+	})
+
+	s.Run("should fail with same error if SetSecret fails", func() {
+		s.mockClient.EXPECT().SetSecret(ctx, id, secret.Value, attributes.Tags).Return(nil, expectedErr)
+
+		_, err := s.secretStore.Set(ctx, id, secret.Value, attributes)
+
+		assert.True(s.T(), errors.IsDependencyFailureError(err))
+	})
+}
+
+func (s *localSecretStoreTestSuite) TestGet() {
+	ctx := context.Background()
+	secret := testutils2.FakeSecret()
+
+	s.Run("should get a secret version successfully", func() {
+		s.mockClient.EXPECT().GetSecret(ctx, id, "2").Return(secret, nil)
+
+		result, err := s.secretStore.Get(ctx, id, "2")
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), secret, result)
+	})
+
+	s.Run("should fail with NotFound error if secret is not found", func() {
+		s.mockClient.EXPECT().GetSecret(ctx, id, "").Return(nil, errors.NotFoundError("error"))
+
+		_, err := s.secretStore.Get(ctx, id, "")
+
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+}
+
+func (s *localSecretStoreTestSuite) TestDeleteRestoreDestroy() {
+	ctx := context.Background()
+
+	s.Run("should delete, restore and destroy a secret successfully", func() {
+		s.mockClient.EXPECT().DeleteSecret(ctx, id).Return(nil)
+		s.mockClient.EXPECT().RestoreSecret(ctx, id).Return(nil)
+		s.mockClient.EXPECT().DestroySecret(ctx, id).Return(nil)
+
+		assert.NoError(s.T(), s.secretStore.Delete(ctx, id))
+		assert.NoError(s.T(), s.secretStore.Restore(ctx, id))
+		assert.NoError(s.T(), s.secretStore.Destroy(ctx, id))
+	})
+
+	s.Run("should list deleted secrets successfully", func() {
+		s.mockClient.EXPECT().ListDeletedSecrets(ctx).Return([]string{id}, nil)
+
+		ids, err := s.secretStore.ListDeleted(ctx, 0, 0)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{id}, ids)
+	})
+
+	s.Run("should fail with same error if DestroySecret fails", func() {
+		s.mockClient.EXPECT().DestroySecret(ctx, id).Return(errors.StatusConflictError("error"))
+
+		err := s.secretStore.Destroy(ctx, id)
+
+		assert.True(s.T(), errors.IsStatusConflictError(err))
+	})
+}
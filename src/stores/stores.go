@@ -4,17 +4,18 @@ import (
 	"context"
 
 	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 //go:generate mockgen -source=stores.go -destination=mock/stores.go -package=mock
 
 type Stores interface {
-	// CreateEthereum creates an ethereum store
-	CreateEthereum(_ context.Context, name, keyStore string, allowedTenants []string, userInfo *auth.UserInfo) error
+	// CreateEthereum creates an ethereum store, deriving its accounts from a seed if hdWallet is set
+	CreateEthereum(_ context.Context, name, keyStore string, hdWallet *entities.HDWallet, allowedTenants []string, userInfo *auth.UserInfo) error
 
-	// CreateKey creates a key store
-	CreateKey(_ context.Context, name, vault, secretStore string, allowedTenants []string, userInfo *auth.UserInfo) error
+	// CreateKey creates a key store, encrypting the private keys of a local key store if envelopeEncryption is set
+	CreateKey(_ context.Context, name, vault, secretStore string, envelopeEncryption *entities.EnvelopeEncryption, allowedTenants []string, userInfo *auth.UserInfo) error
 
 	// CreateSecret creates a secret store
 	CreateSecret(_ context.Context, name, vault string, allowedTenants []string, userInfo *auth.UserInfo) error
@@ -37,6 +38,9 @@ type Stores interface {
 	// Ethereum get ethereum store by name
 	Ethereum(ctx context.Context, storeName string, userInfo *auth.UserInfo) (EthStore, error)
 
+	// Validator get validator store by key store name
+	Validator(ctx context.Context, storeName string, userInfo *auth.UserInfo) (ValidatorStore, error)
+
 	// EthereumByAddr gets ethereum store by address
 	EthereumByAddr(ctx context.Context, addr common.Address, userInfo *auth.UserInfo) (EthStore, error)
 
@@ -0,0 +1,26 @@
+package stores
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+//go:generate mockgen -source=validators.go -destination=mock/validators.go -package=mock
+
+type ValidatorStore interface {
+	// List lists the BLS12-381 validator keys of the store
+	List(ctx context.Context) ([]*entities.Key, error)
+
+	// SignBlock signs the signing root of a block proposal, if it is not slashable given the signing history of the validator
+	SignBlock(ctx context.Context, id string, block *entities.SignedBlock) ([]byte, error)
+
+	// SignAttestation signs the signing root of an attestation, if it is not slashable given the signing history of the validator
+	SignAttestation(ctx context.Context, id string, attestation *entities.SignedAttestation) ([]byte, error)
+
+	// ImportSlashingProtection imports the signing history of a set of validators
+	ImportSlashingProtection(ctx context.Context, interchange *entities.SlashingProtectionInterchange) error
+
+	// ExportSlashingProtection exports the signing history of all the validators of the store
+	ExportSlashingProtection(ctx context.Context) (*entities.SlashingProtectionInterchange, error)
+}
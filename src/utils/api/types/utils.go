@@ -26,7 +26,7 @@ type VerifyTypedDataRequest struct {
 type VerifyKeySignatureRequest struct {
 	Data             []byte `json:"data" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
 	Signature        []byte `json:"signature" validate:"required" example:"tjThYhKSFSKKvsR8Pji6EJ+FYAcf8TNUdAQnM7MSwZEEaPvFhpr1SuGpX5uOcYUrb3pBA8cLk8xcbKtvZ56qWA==" swaggertype:"string"`
-	Curve            string `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1" swaggertype:"string"`
-	SigningAlgorithm string `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa"`
+	Curve            string `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1,secp256r1,curve25519,bls12381" swaggertype:"string"`
+	SigningAlgorithm string `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa,bls"`
 	PublicKey        []byte `json:"publicKey" validate:"required" example:"Cjix/fS3WdqKGKabagBNYwcClan5aImoFpnjSF0cqJs=" swaggertype:"string"`
 }
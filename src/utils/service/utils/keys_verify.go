@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"github.com/consensys/quorum-key-manager/pkg/crypto/bls"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/eddsa"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
@@ -15,10 +16,14 @@ func (u *Utilities) Verify(pubKey, data, sig []byte, algo *entities.Algorithm) e
 	switch {
 	case algo.EllipticCurve == entities.Secp256k1 && algo.Type == entities.Ecdsa:
 		verified, err = ecdsa.VerifySecp256k1Signature(pubKey, data, sig)
+	case algo.EllipticCurve == entities.Secp256r1 && algo.Type == entities.Ecdsa:
+		verified, err = ecdsa.VerifySecp256r1Signature(pubKey, data, sig)
 	case algo.EllipticCurve == entities.Babyjubjub && algo.Type == entities.Eddsa:
 		verified, err = eddsa.VerifyBabyJubJubSignature(pubKey, data, sig)
 	case algo.EllipticCurve == entities.Curve25519 && algo.Type == entities.Eddsa:
 		verified, err = eddsa.VerifyED25519Signature(pubKey, data, sig)
+	case algo.EllipticCurve == entities.Bls12381 && algo.Type == entities.Bls:
+		verified, err = bls.VerifyBLS12381Signature(pubKey, data, sig)
 	default:
 		errMessage := "unsupported signing algorithm and elliptic curve combination"
 		logger.Error(errMessage)
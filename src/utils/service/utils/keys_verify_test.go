@@ -3,6 +3,7 @@ package utils
 import (
 	"testing"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/bls"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/eddsa"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
@@ -93,6 +94,70 @@ func TestKeysVerifyMessage_ecdsa256k1(t *testing.T) {
 	})
 }
 
+func TestKeysVerifyMessage_ecdsa256r1(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+
+	connector := New(logger)
+	privKey, pubKey, _ := ecdsa.CreateSecp256r1(nil)
+	_, pubKey2, _ := ecdsa.CreateSecp256r1(nil)
+	data := crypto.Keccak256([]byte("my data to sign"))
+	signature, err := ecdsa.SignSecp256r1(privKey, data)
+	require.NoError(t, err)
+
+	algo := &entities.Algorithm{
+		Type:          entities.Ecdsa,
+		EllipticCurve: entities.Secp256r1,
+	}
+
+	t.Run("should verify message successfully", func(t *testing.T) {
+		err := connector.Verify(pubKey, data, signature, algo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to verify no corresponding signature", func(t *testing.T) {
+		invalidSig, _ := ecdsa.SignSecp256r1(privKey, crypto.Keccak256([]byte("invalid data")))
+		err := connector.Verify(pubKey, data, invalidSig, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail to verify no corresponding public key", func(t *testing.T) {
+		err := connector.Verify(pubKey2, data, signature, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail to verify no corresponding key type", func(t *testing.T) {
+		err := connector.Verify(pubKey, data, signature, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail verify invalid public key size", func(t *testing.T) {
+		err := connector.Verify(invalidPublicKey, data, signature, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail verify invalid signature format", func(t *testing.T) {
+		err := connector.Verify(pubKey, data, invalidSignature, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
 func TestKeysVerifyMessage_eddsaBabyJubJub(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -241,6 +306,60 @@ func TestKeysVerifyMessage_ed25519(t *testing.T) {
 	})
 }
 
+func TestKeysVerifyMessage_bls12381(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+
+	connector := New(logger)
+	privKey, pubKey, _ := bls.CreateBLS12381(nil)
+	_, pubKey2, _ := bls.CreateBLS12381(nil)
+	data := crypto.Keccak256([]byte("my data to sign"))
+	signature, err := bls.SignBLS12381(privKey, data)
+	require.NoError(t, err)
+
+	algo := &entities.Algorithm{
+		Type:          entities.Bls,
+		EllipticCurve: entities.Bls12381,
+	}
+
+	t.Run("should verify message successfully", func(t *testing.T) {
+		err := connector.Verify(pubKey, data, signature, algo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to verify no corresponding signature", func(t *testing.T) {
+		invalidSig, _ := bls.SignBLS12381(privKey, crypto.Keccak256([]byte("invalid data")))
+		err := connector.Verify(pubKey, data, invalidSig, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail to verify no corresponding public key", func(t *testing.T) {
+		err := connector.Verify(pubKey2, data, signature, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail to verify invalid signature size", func(t *testing.T) {
+		err := connector.Verify(pubKey, data, invalidSignature, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail to verify invalid public key size", func(t *testing.T) {
+		err := connector.Verify(invalidPublicKey, data, signature, algo)
+
+		require.Error(t, err)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
 func TestKeysVerifyMessage_errors(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -32,6 +32,12 @@ func (h *VaultsHandler) Register(ctx context.Context, mnfs []entities.Manifest)
 			err = h.CreateAzure(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
 		case entities.AWSVaultType:
 			err = h.CreateAWS(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
+		case entities.GCPVaultType:
+			err = h.CreateGCP(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
+		case entities.PKCS11VaultType:
+			err = h.CreatePKCS11(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
+		case entities.LocalVaultType:
+			err = h.CreateLocal(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
 		default:
 			return errors.InvalidFormatError("invalid vault type")
 		}
@@ -88,3 +94,48 @@ func (h *VaultsHandler) CreateAWS(ctx context.Context, name string, allowedTenan
 
 	return nil
 }
+
+func (h *VaultsHandler) CreateGCP(ctx context.Context, name string, allowedTenants []string, specs interface{}) error {
+	config := &entities.GCPConfig{}
+	err := json.UnmarshalYAML(specs, config)
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
+	err = h.vaults.CreateGCP(ctx, name, config, allowedTenants, h.userInfo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *VaultsHandler) CreatePKCS11(ctx context.Context, name string, allowedTenants []string, specs interface{}) error {
+	config := &entities.PKCS11Config{}
+	err := json.UnmarshalYAML(specs, config)
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
+	err = h.vaults.CreatePKCS11(ctx, name, config, allowedTenants, h.userInfo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *VaultsHandler) CreateLocal(ctx context.Context, name string, allowedTenants []string, specs interface{}) error {
+	config := &entities.LocalConfig{}
+	err := json.UnmarshalYAML(specs, config)
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
+	err = h.vaults.CreateLocal(ctx, name, config, allowedTenants, h.userInfo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
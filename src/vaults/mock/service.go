@@ -77,6 +77,48 @@ func (mr *MockVaultsMockRecorder) CreateAWS(ctx, name, config, allowedTenants, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAWS", reflect.TypeOf((*MockVaults)(nil).CreateAWS), ctx, name, config, allowedTenants, userInfo)
 }
 
+// CreateGCP mocks base method
+func (m *MockVaults) CreateGCP(ctx context.Context, name string, config *entities0.GCPConfig, allowedTenants []string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGCP", ctx, name, config, allowedTenants, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGCP indicates an expected call of CreateGCP
+func (mr *MockVaultsMockRecorder) CreateGCP(ctx, name, config, allowedTenants, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGCP", reflect.TypeOf((*MockVaults)(nil).CreateGCP), ctx, name, config, allowedTenants, userInfo)
+}
+
+// CreatePKCS11 mocks base method
+func (m *MockVaults) CreatePKCS11(ctx context.Context, name string, config *entities0.PKCS11Config, allowedTenants []string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePKCS11", ctx, name, config, allowedTenants, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePKCS11 indicates an expected call of CreatePKCS11
+func (mr *MockVaultsMockRecorder) CreatePKCS11(ctx, name, config, allowedTenants, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePKCS11", reflect.TypeOf((*MockVaults)(nil).CreatePKCS11), ctx, name, config, allowedTenants, userInfo)
+}
+
+// CreateLocal mocks base method
+func (m *MockVaults) CreateLocal(ctx context.Context, name string, config *entities0.LocalConfig, allowedTenants []string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocal", ctx, name, config, allowedTenants, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLocal indicates an expected call of CreateLocal
+func (mr *MockVaultsMockRecorder) CreateLocal(ctx, name, config, allowedTenants, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocal", reflect.TypeOf((*MockVaults)(nil).CreateLocal), ctx, name, config, allowedTenants, userInfo)
+}
+
 // Get mocks base method
 func (m *MockVaults) Get(ctx context.Context, name string, userInfo *entities.UserInfo) (*entities0.Vault, error) {
 	m.ctrl.T.Helper()
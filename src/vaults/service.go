@@ -19,6 +19,15 @@ type Vaults interface {
 	// CreateAWS creates an AWS KMS client
 	CreateAWS(ctx context.Context, name string, config *entities.AWSConfig, allowedTenants []string, userInfo *auth.UserInfo) error
 
+	// CreateGCP creates a Cloud KMS and Secret Manager client
+	CreateGCP(ctx context.Context, name string, config *entities.GCPConfig, allowedTenants []string, userInfo *auth.UserInfo) error
+
+	// CreatePKCS11 creates a client of a PKCS#11 token
+	CreatePKCS11(ctx context.Context, name string, config *entities.PKCS11Config, allowedTenants []string, userInfo *auth.UserInfo) error
+
+	// CreateLocal creates a local encrypted file vault
+	CreateLocal(ctx context.Context, name string, config *entities.LocalConfig, allowedTenants []string, userInfo *auth.UserInfo) error
+
 	// Get gets a valut by name
 	Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.Vault, error)
 }
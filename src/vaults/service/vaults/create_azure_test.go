@@ -0,0 +1,116 @@
+package vaults
+
+import (
+	"context"
+	"testing"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAzure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	vault := New(roles, logger)
+
+	ctx := context.Background()
+	vaultName := "akv-vault"
+	allowedTenantID := "allowed_akv_tenant"
+	allowedTenants := []string{allowedTenantID}
+	userInfo := &entities2.UserInfo{
+		Tenant: allowedTenantID,
+	}
+
+	t.Run("should create AKV vault client with client secret successfully", func(t *testing.T) {
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:    "quorumkeymanager",
+			TenantID:     "17255fb0-373b-4a1a-bd47-d211ab86df81",
+			ClientID:     "8c925036-dd6f-4a1e-a315-5e6fab4f2f09",
+			ClientSecret: "my-secret",
+		}, allowedTenants, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should create AKV vault client with system-assigned managed identity successfully", func(t *testing.T) {
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:       "quorumkeymanager",
+			ManagedIdentity: true,
+		}, allowedTenants, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should create AKV vault client with user-assigned managed identity successfully", func(t *testing.T) {
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:       "quorumkeymanager",
+			ClientID:        "8c925036-dd6f-4a1e-a315-5e6fab4f2f09",
+			ManagedIdentity: true,
+		}, allowedTenants, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should create AKV vault client with workload identity successfully", func(t *testing.T) {
+		t.Setenv("AZURE_TENANT_ID", "17255fb0-373b-4a1a-bd47-d211ab86df81")
+		t.Setenv("AZURE_CLIENT_ID", "8c925036-dd6f-4a1e-a315-5e6fab4f2f09")
+		t.Setenv("AZURE_FEDERATED_TOKEN_FILE", "/var/run/secrets/azure/tokens/azure-identity-token")
+
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:        "quorumkeymanager",
+			WorkloadIdentity: true,
+		}, allowedTenants, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to create AKV vault client with workload identity if the federated token path is missing", func(t *testing.T) {
+		t.Setenv("AZURE_FEDERATED_TOKEN_FILE", "")
+
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:        "quorumkeymanager",
+			TenantID:         "17255fb0-373b-4a1a-bd47-d211ab86df81",
+			ClientID:         "8c925036-dd6f-4a1e-a315-5e6fab4f2f09",
+			WorkloadIdentity: true,
+		}, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create AKV vault client if the certificate file does not exist", func(t *testing.T) {
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:       "quorumkeymanager",
+			TenantID:        "17255fb0-373b-4a1a-bd47-d211ab86df81",
+			ClientID:        "8c925036-dd6f-4a1e-a315-5e6fab4f2f09",
+			CertificatePath: "/not/found/client.pfx",
+		}, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create AKV vault client if no authentication method is set", func(t *testing.T) {
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{VaultName: "quorumkeymanager"}, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create AKV vault client if several authentication methods are set", func(t *testing.T) {
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:       "quorumkeymanager",
+			TenantID:        "17255fb0-373b-4a1a-bd47-d211ab86df81",
+			ClientID:        "8c925036-dd6f-4a1e-a315-5e6fab4f2f09",
+			ClientSecret:    "my-secret",
+			ManagedIdentity: true,
+		}, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create AKV vault client with client secret if the tenant ID is missing", func(t *testing.T) {
+		err := vault.CreateAzure(ctx, vaultName, &entities.AzureConfig{
+			VaultName:    "quorumkeymanager",
+			ClientID:     "8c925036-dd6f-4a1e-a315-5e6fab4f2f09",
+			ClientSecret: "my-secret",
+		}, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,27 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/gcp/client"
+)
+
+func (c *Vaults) CreateGCP(_ context.Context, name string, config *entities.GCPConfig, allowedTenants []string, _ *auth.UserInfo) error {
+	logger := c.logger.With("name", name)
+	logger.Debug("creating gcp vault client")
+
+	cli, err := client.New(client.NewConfig(config), logger)
+	if err != nil {
+		errMessage := "failed to instantiate GCP client"
+		logger.WithError(err).Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	c.createVault(name, entities.GCPVaultType, allowedTenants, cli)
+
+	logger.Info("gcp vault created successfully")
+	return nil
+}
@@ -0,0 +1,43 @@
+package vaults
+
+import (
+	"context"
+	"testing"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGCP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	vault := New(roles, logger)
+
+	ctx := context.Background()
+	vaultName := "gcp-vault"
+	allowedTenants := []string{"tenant_id_1"}
+	userInfo := &entities2.UserInfo{
+		Tenant: "tenant_id_1",
+	}
+
+	t.Run("should create GCP vault client with the application default credentials successfully", func(t *testing.T) {
+		cfg := &entities.GCPConfig{Project: "my-project", KeyRing: "my-key-ring"}
+
+		err := vault.CreateGCP(ctx, vaultName, cfg, allowedTenants, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to create GCP vault client if the credentials file cannot be read", func(t *testing.T) {
+		cfg := &entities.GCPConfig{Project: "my-project", CredentialsPath: "/invalid/credentials.json"}
+
+		err := vault.CreateGCP(ctx, vaultName, cfg, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+}
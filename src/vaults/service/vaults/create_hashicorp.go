@@ -16,6 +16,20 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 	logger := c.logger.With("name", name)
 	logger.Debug("creating hashicorp vault client")
 
+	switch config.Engine {
+	case "", entities.HashicorpPluginEngine, entities.HashicorpTransitEngine:
+	default:
+		errMessage := "invalid Hashicorp engine, must be one of quorum-plugin or transit"
+		logger.Error(errMessage, "engine", config.Engine)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	if config.KVVersion < 0 || config.KVVersion > 2 {
+		errMessage := "invalid Hashicorp KV engine version, must be 1 or 2"
+		logger.Error(errMessage, "kv_version", config.KVVersion)
+		return errors.InvalidParameterError(errMessage)
+	}
+
 	cli, err := client.NewClient(client.NewConfig(config))
 	if err != nil {
 		errMessage := "failed to instantiate Hashicorp client"
@@ -27,6 +41,13 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 		logger.Warn("skipping certs verification will make your connection insecure and is not recommended in production")
 	}
 
+	hasToken := config.Token != "" || config.TokenPath != ""
+	if (config.AppRole != nil && (config.Kubernetes != nil || hasToken)) || (config.Kubernetes != nil && hasToken) {
+		errMessage := "only one of token, token_path, approle or kubernetes authentication can be specified"
+		logger.Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
 	if config.Token != "" {
 		cli.SetToken(config.Token)
 	} else if config.TokenPath != "" {
@@ -63,6 +84,29 @@ func (c *Vaults) CreateHashicorp(_ context.Context, name string, config *entitie
 				return errors.InvalidFormatError(errMessage)
 			}
 		}
+	} else if config.AppRole != nil || config.Kubernetes != nil {
+		var method token.LoginMethod
+		if config.AppRole != nil {
+			method = token.NewAppRoleLogin(config.AppRole)
+		} else {
+			method = token.NewKubernetesLogin(config.Kubernetes)
+		}
+
+		// The first login is synchronous so that invalid credentials are reported when the vault is created
+		loginWatcher := token.NewLoginWatcher(cli, method, logger)
+		err = loginWatcher.Login()
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			err := loginWatcher.Start(context.Background())
+			if err != nil {
+				logger.WithError(err).Error("login watcher has exited with errors")
+			} else {
+				logger.Warn("login watcher has exited gracefully")
+			}
+		}()
 	}
 
 	c.createVault(name, entities.HashicorpVaultType, allowedTenants, cli)
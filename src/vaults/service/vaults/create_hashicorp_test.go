@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	entities2 "github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/auth/mock"
 	"github.com/consensys/quorum-key-manager/src/entities"
@@ -32,4 +33,47 @@ func TestCreateHashicorp(t *testing.T) {
 		err := vault.CreateHashicorp(ctx, vaultName, cfg, allowedTenants, userInfo)
 		assert.NoError(t, err)
 	})
+	t.Run("should create Hashicorp vault client using the transit engine successfully", func(t *testing.T) {
+		userInfo := &entities2.UserInfo{
+			Tenant: "tenant_id_1",
+		}
+		err := vault.CreateHashicorp(ctx, vaultName, &entities.HashicorpConfig{Engine: entities.HashicorpTransitEngine}, allowedTenants, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameter error if the engine is unknown", func(t *testing.T) {
+		userInfo := &entities2.UserInfo{
+			Tenant: "tenant_id_1",
+		}
+		err := vault.CreateHashicorp(ctx, vaultName, &entities.HashicorpConfig{Engine: "kv"}, allowedTenants, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+	t.Run("should fail with InvalidParameter error if several authentication methods are specified", func(t *testing.T) {
+		userInfo := &entities2.UserInfo{
+			Tenant: "tenant_id_1",
+		}
+		err := vault.CreateHashicorp(ctx, vaultName, &entities.HashicorpConfig{
+			TokenPath: "/vault/token/.root",
+			AppRole:   &entities.HashicorpAppRoleAuth{RoleID: "my-role-id", SecretID: "my-secret-id"},
+		}, allowedTenants, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail to create Hashicorp vault client if AppRole login fails", func(t *testing.T) {
+		userInfo := &entities2.UserInfo{
+			Tenant: "tenant_id_1",
+		}
+		err := vault.CreateHashicorp(ctx, vaultName, &entities.HashicorpConfig{
+			Address: "http://127.0.0.1:1",
+			AppRole: &entities.HashicorpAppRoleAuth{RoleID: "my-role-id", SecretID: "my-secret-id"},
+		}, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+	t.Run("should fail with InvalidParameter error if the KV version is invalid", func(t *testing.T) {
+		userInfo := &entities2.UserInfo{
+			Tenant: "tenant_id_1",
+		}
+		err := vault.CreateHashicorp(ctx, vaultName, &entities.HashicorpConfig{KVVersion: 3}, allowedTenants, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
 }
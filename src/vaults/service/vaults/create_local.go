@@ -0,0 +1,27 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/local/client"
+)
+
+func (c *Vaults) CreateLocal(_ context.Context, name string, config *entities.LocalConfig, allowedTenants []string, _ *auth.UserInfo) error {
+	logger := c.logger.With("name", name, "path", config.Path)
+	logger.Debug("creating local vault client")
+
+	cli, err := client.New(client.NewConfig(config))
+	if err != nil {
+		errMessage := "failed to instantiate local vault client"
+		logger.WithError(err).Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	c.createVault(name, entities.LocalVaultType, allowedTenants, cli)
+
+	logger.Info("local vault created successfully")
+	return nil
+}
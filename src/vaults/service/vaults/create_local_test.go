@@ -0,0 +1,52 @@
+package vaults
+
+import (
+	"context"
+	"testing"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateLocal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	vault := New(roles, logger)
+
+	ctx := context.Background()
+	vaultName := "local-vault"
+	allowedTenants := []string{"tenant_id_1"}
+	userInfo := &entities2.UserInfo{
+		Tenant: "tenant_id_1",
+	}
+
+	t.Run("should create local vault successfully", func(t *testing.T) {
+		cfg := &entities.LocalConfig{Path: t.TempDir(), Passphrase: "my-passphrase"}
+
+		err := vault.CreateLocal(ctx, vaultName, cfg, allowedTenants, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to create local vault client if no master key is specified", func(t *testing.T) {
+		cfg := &entities.LocalConfig{Path: t.TempDir()}
+
+		err := vault.CreateLocal(ctx, vaultName, cfg, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create local vault client if the master key is wrong", func(t *testing.T) {
+		path := t.TempDir()
+		err := vault.CreateLocal(ctx, vaultName, &entities.LocalConfig{Path: path, Passphrase: "my-passphrase"}, allowedTenants, userInfo)
+		assert.NoError(t, err)
+
+		err = vault.CreateLocal(ctx, vaultName, &entities.LocalConfig{Path: path, Passphrase: "wrong-passphrase"}, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,27 @@
+package vaults
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/pkcs11/client"
+)
+
+func (c *Vaults) CreatePKCS11(_ context.Context, name string, config *entities.PKCS11Config, allowedTenants []string, _ *auth.UserInfo) error {
+	logger := c.logger.With("name", name, "module_path", config.ModulePath)
+	logger.Debug("creating pkcs11 vault client")
+
+	cli, err := client.New(client.NewConfig(config))
+	if err != nil {
+		errMessage := "failed to instantiate PKCS#11 client"
+		logger.WithError(err).Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	c.createVault(name, entities.PKCS11VaultType, allowedTenants, cli)
+
+	logger.Info("pkcs11 vault created successfully")
+	return nil
+}
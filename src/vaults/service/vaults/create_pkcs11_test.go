@@ -0,0 +1,43 @@
+package vaults
+
+import (
+	"context"
+	"testing"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePKCS11(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	vault := New(roles, logger)
+
+	ctx := context.Background()
+	vaultName := "pkcs11-vault"
+	allowedTenants := []string{"tenant_id_1"}
+	userInfo := &entities2.UserInfo{
+		Tenant: "tenant_id_1",
+	}
+
+	t.Run("should fail to create PKCS#11 vault client if no PIN is specified", func(t *testing.T) {
+		cfg := &entities.PKCS11Config{ModulePath: "/usr/lib/softhsm/libsofthsm2.so", TokenLabel: "qkm"}
+
+		err := vault.CreatePKCS11(ctx, vaultName, cfg, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create PKCS#11 vault client if the module cannot be loaded", func(t *testing.T) {
+		cfg := &entities.PKCS11Config{ModulePath: "/invalid/module.so", TokenLabel: "qkm", PIN: "1234"}
+
+		err := vault.CreatePKCS11(ctx, vaultName, cfg, allowedTenants, userInfo)
+		assert.Error(t, err)
+	})
+}
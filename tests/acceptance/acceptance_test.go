@@ -14,6 +14,8 @@ import (
 	"github.com/consensys/quorum-key-manager/src/auth/service/roles"
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/hashicorp/client"
+	policypg "github.com/consensys/quorum-key-manager/src/policies/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/policies/service/policies"
 	"github.com/consensys/quorum-key-manager/src/stores/connectors/ethereum"
 	"github.com/consensys/quorum-key-manager/src/stores/connectors/keys"
 	"github.com/consensys/quorum-key-manager/src/stores/connectors/secrets"
@@ -168,3 +170,15 @@ func (s *acceptanceTestSuite) TestAliases() {
 
 	suite.Run(s.T(), testSuite)
 }
+
+func (s *acceptanceTestSuite) TestPolicies() {
+	aliasService := aliases.New(aliaspg.NewAlias(s.env.postgresClient), aliaspg.NewRegistry(s.env.postgresClient), roles.New(s.env.logger), s.env.logger)
+	spendingRepository := policypg.NewSpending(s.env.postgresClient)
+
+	testSuite := new(policiesTestSuite)
+	testSuite.env = s.env
+	testSuite.spendingsDB = spendingRepository
+	testSuite.policyService = policies.New(policypg.NewPolicy(s.env.postgresClient), spendingRepository, aliasService, roles.New(s.env.logger), s.env.logger)
+
+	suite.Run(s.T(), testSuite)
+}
@@ -0,0 +1,128 @@
+package acceptancetests
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/policies"
+	"github.com/consensys/quorum-key-manager/src/policies/database"
+	"github.com/consensys/quorum-key-manager/src/policies/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type policiesTestSuite struct {
+	suite.Suite
+	env             *IntegrationEnvironment
+	policyService   policies.Policies
+	spendingsDB     database.Spendings
+	user            *authtypes.UserInfo
+	tenantUser      *authtypes.UserInfo
+	account         string
+	storeName       string
+	spendingAccount string
+}
+
+func (s *policiesTestSuite) SetupSuite() {
+	policyPermissions := []authtypes.Permission{authtypes.ReadPolicy, authtypes.WritePolicy, authtypes.DeletePolicy}
+	s.user = authtypes.NewWildcardUser()
+	s.user.Permissions = append(s.user.Permissions, policyPermissions...)
+	s.tenantUser = authtypes.NewWildcardUser()
+	s.tenantUser.Permissions = append(s.tenantUser.Permissions, policyPermissions...)
+	s.tenantUser.Tenant = "tenantOne"
+	s.account = "0x664895b5fE3ddf049d2Fb508cfA03923859763C6"
+	s.storeName = "acceptance_policies_store"
+	s.spendingAccount = ethcommon.BigToAddress(big.NewInt(time.Now().UnixNano())).Hex()
+}
+
+func (s *policiesTestSuite) TestUpdate() {
+	ctx := context.Background()
+
+	s.Run("should clear the stores and accounts of a policy", func() {
+		name := "acceptance-policy-" + time.Now().Format("150405.000000")
+		_, err := s.policyService.Create(ctx, &entities.Policy{
+			Name:     name,
+			Stores:   []string{s.storeName},
+			Accounts: []string{s.account},
+			Rules:    &entities.Rules{MaxValue: big.NewInt(1000)},
+		}, s.user)
+		require.NoError(s.T(), err)
+
+		_, err = s.policyService.Update(ctx, &entities.Policy{Name: name, Rules: &entities.Rules{MaxValue: big.NewInt(2000)}}, s.user)
+		require.NoError(s.T(), err)
+
+		policy, err := s.policyService.Get(ctx, name, s.user)
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), policy.Stores)
+		assert.Empty(s.T(), policy.Accounts)
+		assert.Equal(s.T(), big.NewInt(2000), policy.Rules.MaxValue)
+
+		err = s.policyService.Delete(ctx, name, s.user)
+		require.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with ForbiddenError to manage policies as a tenant user", func() {
+		policy, err := s.policyService.Create(ctx, &entities.Policy{Name: "tenant-policy", Rules: &entities.Rules{}}, s.tenantUser)
+
+		assert.Nil(s.T(), policy)
+		assert.True(s.T(), errors.IsForbiddenError(err))
+
+		err = s.policyService.Delete(ctx, "tenant-policy", s.tenantUser)
+
+		assert.True(s.T(), errors.IsForbiddenError(err))
+	})
+}
+
+func (s *policiesTestSuite) TestSpendings() {
+	ctx := context.Background()
+	day := time.Now().UTC().Format("2006-01-02")
+	limit := big.NewInt(10000)
+
+	s.Run("should only count once the value of transactions sharing a nonce", func() {
+		added, err := s.spendingsDB.Add(ctx, s.spendingAccount, day, "1337", 1, big.NewInt(6000), limit)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), added)
+
+		// Speed-up of the same transaction
+		added, err = s.spendingsDB.Add(ctx, s.spendingAccount, day, "1337", 1, big.NewInt(6000), limit)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), added)
+
+		spent, err := s.spendingsDB.FindOne(ctx, s.spendingAccount, day)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), big.NewInt(6000), spent)
+
+		counted, err := s.spendingsDB.FindNonce(ctx, s.spendingAccount, "1337", 1)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), big.NewInt(6000), counted)
+	})
+
+	s.Run("should count the value of a replacement above the replaced one", func() {
+		added, err := s.spendingsDB.Add(ctx, s.spendingAccount, day, "1337", 1, big.NewInt(7000), limit)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), added)
+
+		spent, err := s.spendingsDB.FindOne(ctx, s.spendingAccount, day)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), big.NewInt(7000), spent)
+	})
+
+	s.Run("should not count a transaction of another nonce exceeding the limit", func() {
+		added, err := s.spendingsDB.Add(ctx, s.spendingAccount, day, "1337", 2, big.NewInt(3001), limit)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), added)
+
+		spent, err := s.spendingsDB.FindOne(ctx, s.spendingAccount, day)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), big.NewInt(7000), spent)
+
+		counted, err := s.spendingsDB.FindNonce(ctx, s.spendingAccount, "1337", 2)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), big.NewInt(0), counted)
+	})
+}
@@ -158,6 +158,30 @@ func (s *ethTestSuite) TestGet() {
 	})
 }
 
+func (s *ethTestSuite) TestEnableDisable() {
+	ctx := context.Background()
+	account, err := s.store.Create(ctx, s.newID("my-account-enable"), &entities.Attributes{
+		Tags: testutils.FakeTags(),
+	})
+	require.NoError(s.T(), err)
+
+	s.Run("should disable and enable an Ethereum Account successfully", func() {
+		err := s.store.Disable(ctx, account.Address)
+		require.NoError(s.T(), err)
+
+		retrievedAccount, err := s.db.Get(ctx, account.Address.Hex())
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrievedAccount.Metadata.Disabled)
+
+		err = s.store.Enable(ctx, account.Address)
+		require.NoError(s.T(), err)
+
+		retrievedAccount, err = s.db.Get(ctx, account.Address.Hex())
+		require.NoError(s.T(), err)
+		assert.False(s.T(), retrievedAccount.Metadata.Disabled)
+	})
+}
+
 func (s *ethTestSuite) TestList() {
 	ctx := context.Background()
 	tags := testutils.FakeTags()
@@ -340,13 +364,13 @@ func (s *ethTestSuite) TestSignPrivate() {
 	require.NoError(s.T(), err)
 
 	s.Run("should sign a transaction successfully", func() {
-		signedRaw, err := s.store.SignPrivate(ctx, account.Address, tx)
+		signedRaw, err := s.store.SignPrivate(ctx, account.Address, big.NewInt(1), tx)
 		require.NoError(s.T(), err)
 		assert.NotEmpty(s.T(), signedRaw)
 	})
 
 	s.Run("should fail with NotFoundError if account is not found", func() {
-		signedRaw, err := s.store.SignPrivate(ctx, ethcommon.HexToAddress("invalidAddress"), tx)
+		signedRaw, err := s.store.SignPrivate(ctx, ethcommon.HexToAddress("invalidAddress"), big.NewInt(1), tx)
 		require.Empty(s.T(), signedRaw)
 		assert.True(s.T(), errors.IsNotFoundError(err))
 	})
@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"time"
+
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/utils"
 
@@ -331,6 +333,51 @@ func (s *keysTestSuite) TestUpdate() {
 	})
 }
 
+func (s *keysTestSuite) TestEnableDisable() {
+	ctx := context.Background()
+	id := s.newID("my-key-enable")
+	_, err := s.store.Create(ctx, id, &entities2.Algorithm{
+		Type:          entities2.Ecdsa,
+		EllipticCurve: entities2.Secp256k1,
+	}, &entities.Attributes{
+		Tags: testutils.FakeTags(),
+	})
+	require.NoError(s.T(), err)
+
+	s.Run("should disable and enable a key successfully", func() {
+		err := s.store.Disable(ctx, id)
+		require.NoError(s.T(), err)
+
+		key, err := s.db.Get(ctx, id)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), key.Metadata.Disabled)
+
+		err = s.store.Enable(ctx, id)
+		require.NoError(s.T(), err)
+
+		key, err = s.db.Get(ctx, id)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), key.Metadata.Disabled)
+	})
+
+	s.Run("should clear the expiration date of a key", func() {
+		key, err := s.db.Get(ctx, id)
+		require.NoError(s.T(), err)
+
+		key.Metadata.ExpireAt = time.Now().Add(time.Hour)
+		_, err = s.db.Update(ctx, key)
+		require.NoError(s.T(), err)
+
+		key.Metadata.ExpireAt = time.Time{}
+		_, err = s.db.Update(ctx, key)
+		require.NoError(s.T(), err)
+
+		key, err = s.db.Get(ctx, id)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), key.Metadata.ExpireAt.IsZero())
+	})
+}
+
 func (s *keysTestSuite) TestSignVerify() {
 	ctx := context.Background()
 	tags := testutils.FakeTags()
@@ -170,6 +170,33 @@ func (s *secretsTestSuite) TestGet() {
 	})
 }
 
+func (s *secretsTestSuite) TestEnableDisable() {
+	ctx := context.Background()
+	id := s.newID("my-secret-enable")
+
+	secret, err := s.store.Set(ctx, id, "my-secret-value", &entities.Attributes{
+		Tags: testutils.FakeTags(),
+	})
+	require.NoError(s.T(), err)
+	version := secret.Metadata.Version
+
+	s.Run("should disable and enable a secret successfully", func() {
+		err := s.store.Disable(ctx, id)
+		require.NoError(s.T(), err)
+
+		retrievedSecret, err := s.db.Get(ctx, id, version)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), retrievedSecret.Metadata.Disabled)
+
+		err = s.store.Enable(ctx, id)
+		require.NoError(s.T(), err)
+
+		retrievedSecret, err = s.db.Get(ctx, id, version)
+		require.NoError(s.T(), err)
+		assert.False(s.T(), retrievedSecret.Metadata.Disabled)
+	})
+}
+
 func (s *secretsTestSuite) TestDelete() {
 	ctx := context.Background()
 	id := s.newID("my-secret-delete")
@@ -623,6 +623,48 @@ func (s *keysTestSuite) TestSignVerify() {
 	})
 }
 
+func (s *keysTestSuite) TestEncryptDecrypt() {
+	data := []byte("my data to encrypt")
+
+	for _, request := range []*types.CreateKeyRequest{
+		{Curve: "secp256k1", SigningAlgorithm: "ecdsa"},
+		{Curve: "curve25519", SigningAlgorithm: "eddsa"},
+	} {
+		request := request
+		s.RunT(fmt.Sprintf("should encrypt and decrypt a payload successfully: %s/%s", request.Curve, request.SigningAlgorithm), func() {
+			keyID := fmt.Sprintf("my-key-encrypt-%s", common.RandString(10))
+			key, err := s.env.client.CreateKey(s.env.ctx, s.storeName, keyID, request)
+			// Ignoring not supported errors
+			if err != nil {
+				httpError, ok := err.(*client.ResponseError)
+				require.True(s.T(), ok)
+				assert.Equal(s.T(), http.StatusNotImplemented, httpError.StatusCode)
+				return
+			}
+			defer s.queueToDelete(key)
+
+			ciphertext, err := s.env.client.EncryptKey(s.env.ctx, s.storeName, key.ID, &types.EncryptBase64PayloadRequest{Data: data})
+			// Ignoring not supported errors
+			if err != nil {
+				httpError, ok := err.(*client.ResponseError)
+				require.True(s.T(), ok)
+				assert.Equal(s.T(), http.StatusNotImplemented, httpError.StatusCode)
+				return
+			}
+
+			ciphertextB, err := base64.StdEncoding.DecodeString(ciphertext)
+			require.NoError(s.T(), err)
+
+			plaintext, err := s.env.client.DecryptKey(s.env.ctx, s.storeName, key.ID, &types.DecryptBase64PayloadRequest{Data: ciphertextB})
+			require.NoError(s.T(), err)
+
+			plaintextB, err := base64.StdEncoding.DecodeString(plaintext)
+			require.NoError(s.T(), err)
+			assert.Equal(s.T(), data, plaintextB)
+		})
+	}
+}
+
 func (s *keysTestSuite) queueToDelete(keyR *types.KeyResponse) {
 	s.deleteQueue.Add(1)
 	go func() {